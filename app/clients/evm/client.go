@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/url"
 	"syscall"
 	"time"
 
@@ -28,10 +30,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/retry"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -50,15 +55,16 @@ type Client struct {
 	chainId uint64
 }
 
-// NewClient creates new instance of an EVM client
-func NewClient(c config.Evm, chainId uint64) *Client {
+// NewClient creates new instance of an EVM client. The outcome of every HTTP request is reported to
+// the health service, if provided
+func NewClient(c config.Evm, chainId uint64, health service.Health) *Client {
 	logger := config.GetLoggerFor(fmt.Sprintf("EVM Client"))
 	if c.BlockConfirmations < 1 {
 		logger.Fatalf("BlockConfirmations should be a positive number")
 	}
 
 	var client client.Core
-	client, err := ethclient.Dial(c.NodeUrl)
+	client, err := dial(c.NodeUrl, chainId, health)
 	if err != nil {
 		logger.Warnf("Failed to initialize Client with Chain Id [%v]. Error [%s]", chainId, err)
 	}
@@ -70,6 +76,22 @@ func NewClient(c config.Evm, chainId uint64) *Client {
 		chainId,
 	}
 }
+func dial(nodeUrl string, chainId uint64, health service.Health) (*ethclient.Client, error) {
+	parsedUrl, err := url.Parse(nodeUrl)
+	if err != nil || health == nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") {
+		return ethclient.Dial(nodeUrl)
+	}
+
+	// Only the host is used in the name, as the path of the node URL may contain an API key
+	name := fmt.Sprintf(constants.HealthEvmClientFormat, chainId, parsedUrl.Host)
+	httpClient := &http.Client{Transport: httpHelper.NewHealthTransport(http.DefaultTransport, health, name)}
+	rpcClient, err := rpc.DialOptions(context.Background(), nodeUrl, rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
 func (ec *Client) GetChainID() uint64 {
 	return ec.chainId
 }
@@ -230,17 +252,17 @@ func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error
 	return logs, nil
 }
 
-func (ec *Client) WaitForConfirmations(raw types.Log) error {
+func (ec *Client) WaitForConfirmations(ctx context.Context, raw types.Log) error {
 	target := raw.BlockNumber + ec.config.BlockConfirmations
 	for {
-		currentBlockNumber, err := ec.BlockNumber(context.Background())
+		currentBlockNumber, err := ec.BlockNumber(ctx)
 		if err != nil {
 			ec.logger.Errorf("[%s] Failed retrieving block number.", raw.TxHash.String())
 			return err
 		}
 
 		if target <= currentBlockNumber {
-			receipt, err := ec.TransactionReceipt(context.Background(), raw.TxHash)
+			receipt, err := ec.TransactionReceipt(ctx, raw.TxHash)
 			if errors.Is(ethereum.NotFound, err) {
 				ec.logger.Infof("[%s] EVM TX went into an uncle block.", raw.TxHash.String())
 				return err
@@ -257,7 +279,12 @@ func (ec *Client) WaitForConfirmations(raw types.Log) error {
 
 			return nil
 		}
-		time.Sleep(time.Second * 5)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second * 5):
+		}
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 )
 
@@ -38,6 +40,28 @@ type ClientPool struct {
 	clientsConfigs []config.Evm
 	retries        int
 	logger         *log.Entry
+	maxLogsBlocks  int64
+	// The effective block range of eth_getLogs queries per client, narrowed down when the RPC provider rejects
+	// a query due to the count or the size of the results. Zero means that the range of the query is not narrowed
+	logsRanges map[client.EVM]int64
+	logsMutex  sync.Mutex
+}
+
+// The results count under which a query is considered small and the effective block range of the client is doubled
+const logsRangeGrowThreshold = 1000
+
+// Substrings of the errors, returned by RPC providers when an eth_getLogs query has too many results or too large
+// response. For example Infura, Alchemy and the public Polygon RPCs
+var logsRangeErrors = []string{
+	"query returned more than",
+	"too many results",
+	"response size exceeded",
+	"response size should not",
+	"block range is too wide",
+	"block range too large",
+	"exceed maximum block range",
+	"range is too large",
+	"logs matched by query exceeds",
 }
 
 func validateWebsocketUrl(wsUrl string, logger *log.Entry) error {
@@ -82,7 +106,7 @@ func checkIfNodeURLIsValid(nodeURL string) error {
 	return nil
 }
 
-func NewClientPool(c config.EvmPool, chainId uint64) (*ClientPool, error) {
+func NewClientPool(c config.EvmPool, chainId uint64, health service.Health) (*ClientPool, error) {
 	logger := config.GetLoggerFor("EVM Client Pool")
 	nodeURLs := c.NodeUrls
 	clients := make([]client.EVM, 0, len(nodeURLs))
@@ -99,11 +123,11 @@ func NewClientPool(c config.EvmPool, chainId uint64) (*ClientPool, error) {
 		}
 		err := checkIfNodeURLIsValid(nodeURL)
 		if err == nil {
-			clients = append([]client.EVM{NewClient(configEvm, chainId)}, clients...)
+			clients = append([]client.EVM{NewClient(configEvm, chainId, health)}, clients...)
 			clientsConfigs = append([]config.Evm{configEvm}, clientsConfigs...)
 		} else {
 			invalidUrls++
-			clients = append(clients, NewClient(configEvm, chainId))
+			clients = append(clients, NewClient(configEvm, chainId, health))
 			clientsConfigs = append(clientsConfigs, configEvm)
 		}
 	}
@@ -119,6 +143,8 @@ func NewClientPool(c config.EvmPool, chainId uint64) (*ClientPool, error) {
 		clientsConfigs: clientsConfigs,
 		retries:        retry,
 		logger:         logger,
+		maxLogsBlocks:  c.MaxLogsBlocks,
+		logsRanges:     make(map[client.EVM]int64),
 	}, nil
 }
 
@@ -338,9 +364,14 @@ func (cp *ClientPool) RetryBlockNumber() (uint64, error) {
 	return result.(uint64), nil
 }
 
+// RetryFilterLogs returns the logs from the input query. If the RPC provider rejects the query due to too many results,
+// the block range is bisected until accepted. The effective range is remembered per client and grown back on small responses
 func (cp *ClientPool) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
 	operation := func(c client.EVM) (interface{}, error) {
-		return c.RetryFilterLogs(query)
+		if query.FromBlock == nil || query.ToBlock == nil {
+			return c.RetryFilterLogs(query)
+		}
+		return cp.filterLogsInRanges(c, query)
 	}
 
 	result, err := cp.retryOperation(operation)
@@ -381,9 +412,9 @@ func (cp *ClientPool) WaitForTransactionCallback(hex string, onSuccess, onRevert
 	cp.clients[0].WaitForTransactionCallback(hex, onSuccess, onRevert, onError)
 }
 
-func (cp *ClientPool) WaitForConfirmations(raw types.Log) error {
+func (cp *ClientPool) WaitForConfirmations(ctx context.Context, raw types.Log) error {
 	operation := func(c client.EVM) (interface{}, error) {
-		return nil, c.WaitForConfirmations(raw)
+		return nil, c.WaitForConfirmations(ctx, raw)
 	}
 
 	_, err := cp.retryOperation(operation)
@@ -415,3 +446,76 @@ func (cp *ClientPool) BlockConfirmations() uint64 {
 func (cp *ClientPool) GetBlockTimestamp(blockNumber *big.Int) uint64 {
 	return cp.clients[0].GetBlockTimestamp(blockNumber)
 }
+
+func (cp *ClientPool) filterLogsInRanges(c client.EVM, query ethereum.FilterQuery) ([]types.Log, error) {
+	from := query.FromBlock.Int64()
+	to := query.ToBlock.Int64()
+	maxRange := cp.maxLogsBlocks
+	if maxRange == 0 || maxRange > to-from+1 {
+		maxRange = to - from + 1
+	}
+
+	size := cp.getLogsRange(c)
+	if size == 0 || size > maxRange {
+		size = maxRange
+	}
+
+	logs := make([]types.Log, 0)
+	for from <= to {
+		end := from + size - 1
+		if end > to {
+			end = to
+		}
+
+		rangeQuery := query
+		rangeQuery.FromBlock = big.NewInt(from)
+		rangeQuery.ToBlock = big.NewInt(end)
+		result, err := c.RetryFilterLogs(rangeQuery)
+		if err != nil {
+			if !isLogsRangeError(err) || end == from {
+				return nil, err
+			}
+
+			size = (end - from + 1) / 2
+			cp.setLogsRange(c, size)
+			cp.logger.Debugf("Query for logs in [%d-%d] was rejected, narrowing the range to [%d] blocks. Error: [%s]", from, end, size, err)
+			continue
+		}
+
+		logs = append(logs, result...)
+		if end-from+1 == size && size < maxRange && len(result) < logsRangeGrowThreshold {
+			size *= 2
+			if size >= maxRange {
+				size = maxRange
+				cp.setLogsRange(c, 0)
+			} else {
+				cp.setLogsRange(c, size)
+			}
+		}
+		from = end + 1
+	}
+
+	return logs, nil
+}
+
+func (cp *ClientPool) getLogsRange(c client.EVM) int64 {
+	cp.logsMutex.Lock()
+	defer cp.logsMutex.Unlock()
+	return cp.logsRanges[c]
+}
+
+func (cp *ClientPool) setLogsRange(c client.EVM, size int64) {
+	cp.logsMutex.Lock()
+	defer cp.logsMutex.Unlock()
+	cp.logsRanges[c] = size
+}
+
+func isLogsRangeError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, e := range logsRangeErrors {
+		if strings.Contains(message, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -55,6 +55,7 @@ func setupCP() {
 		clientsConfigs: clientConfigs,
 		logger:         config.GetLoggerFor("client_pool_test_logger"),
 		retries:        retries,
+		logsRanges:     make(map[client.EVM]int64),
 	}
 }
 
@@ -78,8 +79,8 @@ func TestNewClientPool(t *testing.T) {
 		MaxLogsBlocks:      configEvmPool.MaxLogsBlocks,
 	}
 
-	client := NewClient(configEvm, 256)
-	clientPool, err := NewClientPool(configEvmPool, 256)
+	client := NewClient(configEvm, 256, nil)
+	clientPool, err := NewClientPool(configEvmPool, 256, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 6, clientPool.retries)
 
@@ -98,7 +99,7 @@ func TestNewClientPool_ContainsNonWorkingURL(t *testing.T) {
 		MaxLogsBlocks:      10,
 	}
 
-	clientPool, err := NewClientPool(configEvmPool, 256)
+	clientPool, err := NewClientPool(configEvmPool, 256, nil)
 	assert.NoError(t, err)
 	// Note: NewClientPool has check inside that pings each one of the provided Urls
 	// and shifts the working ones at the beginning of the slice
@@ -115,7 +116,7 @@ func TestNewClientPool_PanicsWhenNoWorkingURLIsFound(t *testing.T) {
 		PollingInterval:    5,
 		MaxLogsBlocks:      10,
 	}
-	_, err := NewClientPool(configEvmPool, 256)
+	_, err := NewClientPool(configEvmPool, 256, nil)
 	assert.Error(t, err)
 }
 
@@ -253,7 +254,7 @@ func TestClientPool_WaitForConfirmations(t *testing.T) {
 		BlockNumber: big.NewInt(20),
 	}, nil)
 
-	err := cp.WaitForConfirmations(log)
+	err := cp.WaitForConfirmations(context.Background(), log)
 	assert.Nil(t, err)
 }
 
@@ -269,7 +270,7 @@ func TestClientPool_WaitForConfirmations_MovedFromOriginalBlock(t *testing.T) {
 		BlockNumber: big.NewInt(20),
 	}, nil)
 
-	err := cp.WaitForConfirmations(log)
+	err := cp.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, errors.New("moved from original block"), err)
 }
 
@@ -281,7 +282,7 @@ func TestClientPool_WaitForConfirmations_TransactionReceipt_EthereumNotFound(t *
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(20), nil)
 	mocks.MEVMCoreClient.On("TransactionReceipt", context.Background(), log.TxHash).Return(&types.Receipt{}, ethereum.NotFound)
 
-	err := cp.WaitForConfirmations(log)
+	err := cp.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, ethereum.NotFound, err)
 }
 
@@ -293,7 +294,7 @@ func TestClientPool_WaitForConfirmations_TransactionReceipt_OtherError(t *testin
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(20), nil)
 	mocks.MEVMCoreClient.On("TransactionReceipt", context.Background(), log.TxHash).Return(&types.Receipt{}, errors.New("some-error"))
 
-	err := cp.WaitForConfirmations(log)
+	err := cp.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, errors.New("some-error"), err)
 }
 
@@ -302,7 +303,7 @@ func TestClientPool_WaitForConfirmations_BlockNumberFails(t *testing.T) {
 
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(0), errors.New("some-error"))
 
-	err := cp.WaitForConfirmations(types.Log{})
+	err := cp.WaitForConfirmations(context.Background(), types.Log{})
 	assert.NotNil(t, err)
 	mocks.MEVMCoreClient.AssertNotCalled(t, "TransactionReceipt", context.Background(), mock.Anything)
 }
@@ -509,3 +510,74 @@ func TestClientPool_CallContract(t *testing.T) {
 	assert.Equal(t, expectedResult, actualResult)
 	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "CallContract", 3)
 }
+
+func logsQuery(from, to int64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{FromBlock: big.NewInt(from), ToBlock: big.NewInt(to)}
+}
+
+func TestClientPool_RetryFilterLogs(t *testing.T) {
+	setupCP()
+	expectedLogs := []types.Log{{BlockNumber: 5}}
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 100)).Return(expectedLogs, nil)
+
+	logs, err := cp.RetryFilterLogs(logsQuery(1, 100))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedLogs, logs)
+	assert.Equal(t, int64(0), cp.logsRanges[c])
+}
+
+func TestClientPool_RetryFilterLogs_BisectsRejectedRange(t *testing.T) {
+	setupCP()
+	rangeErr := errors.New("query returned more than 10000 results")
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 100)).Return(nil, rangeErr)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 50)).Return([]types.Log{{BlockNumber: 10}}, nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(51, 100)).Return([]types.Log{{BlockNumber: 60}}, nil)
+
+	logs, err := cp.RetryFilterLogs(logsQuery(1, 100))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{{BlockNumber: 10}, {BlockNumber: 60}}, logs)
+	// The range is grown back after the first small response
+	assert.Equal(t, int64(0), cp.logsRanges[c])
+}
+
+func TestClientPool_RetryFilterLogs_RemembersNarrowedRange(t *testing.T) {
+	setupCP()
+	cp.logsRanges[c] = 10
+	logs := make([]types.Log, logsRangeGrowThreshold)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 10)).Return(logs, nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(11, 20)).Return(logs, nil)
+
+	result, err := cp.RetryFilterLogs(logsQuery(1, 20))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2*logsRangeGrowThreshold)
+	assert.Equal(t, int64(10), cp.logsRanges[c])
+}
+
+func TestClientPool_RetryFilterLogs_GrowsRangeOnSmallResponses(t *testing.T) {
+	setupCP()
+	cp.logsRanges[c] = 10
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 10)).Return([]types.Log{}, nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(11, 30)).Return([]types.Log{}, nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(31, 70)).Return([]types.Log{}, nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(71, 100)).Return([]types.Log{}, nil)
+
+	_, err := cp.RetryFilterLogs(logsQuery(1, 100))
+
+	assert.Nil(t, err)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "FilterLogs", 4)
+	assert.Equal(t, int64(80), cp.logsRanges[c])
+}
+
+func TestClientPool_RetryFilterLogs_OtherErrorFails(t *testing.T) {
+	setupCP()
+	expectedErr := errors.New("connection refused")
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery(1, 100)).Return(nil, expectedErr)
+
+	_, err := cp.RetryFilterLogs(logsQuery(1, 100))
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "FilterLogs", retries)
+}
@@ -208,7 +208,7 @@ func Test_WaitForConfirmations(t *testing.T) {
 		BlockNumber: big.NewInt(20),
 	}, nil)
 
-	err := c.WaitForConfirmations(log)
+	err := c.WaitForConfirmations(context.Background(), log)
 	assert.Nil(t, err)
 }
 
@@ -224,7 +224,7 @@ func Test_WaitForConfirmations_MovedFromOriginalBlock(t *testing.T) {
 		BlockNumber: big.NewInt(20),
 	}, nil)
 
-	err := c.WaitForConfirmations(log)
+	err := c.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, errors.New("moved from original block"), err)
 }
 
@@ -236,7 +236,7 @@ func Test_WaitForConfirmations_TransactionReceipt_EthereumNotFound(t *testing.T)
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(20), nil)
 	mocks.MEVMCoreClient.On("TransactionReceipt", context.Background(), log.TxHash).Return(&types.Receipt{}, ethereum.NotFound)
 
-	err := c.WaitForConfirmations(log)
+	err := c.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, ethereum.NotFound, err)
 }
 
@@ -248,7 +248,7 @@ func Test_WaitForConfirmations_TransactionReceipt_OtherError(t *testing.T) {
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(20), nil)
 	mocks.MEVMCoreClient.On("TransactionReceipt", context.Background(), log.TxHash).Return(&types.Receipt{}, errors.New("some-error"))
 
-	err := c.WaitForConfirmations(log)
+	err := c.WaitForConfirmations(context.Background(), log)
 	assert.Error(t, errors.New("some-error"), err)
 }
 
@@ -257,7 +257,7 @@ func Test_WaitForConfirmations_BlockNumberFails(t *testing.T) {
 
 	mocks.MEVMCoreClient.On("BlockNumber", context.Background()).Return(uint64(0), errors.New("some-error"))
 
-	err := c.WaitForConfirmations(types.Log{})
+	err := c.WaitForConfirmations(context.Background(), types.Log{})
 	assert.NotNil(t, err)
 	mocks.MEVMCoreClient.AssertNotCalled(t, "TransactionReceipt", context.Background(), mock.Anything)
 }
@@ -17,22 +17,32 @@
 package hedera
 
 import (
+	"errors"
 	"fmt"
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
 )
 
 // Node struct holding the hedera.Client. Used to interact with Hedera consensus nodes
 type Node struct {
 	client   *hedera.Client
 	maxRetry int
+	health   service.Health
 	logger   *log.Entry
 }
 
-// NewNodeClient creates new instance of hedera.Client based on the provided client configuration
-func NewNodeClient(cfg config.Hedera) *Node {
+// NewNodeClient creates new instance of hedera.Client based on the provided client configuration.
+// The operator signs through the HSM, if its key label is configured.
+// The outcome of every executed transaction or query is reported to the health service, if provided
+func NewNodeClient(cfg config.Hedera, mirrorNodeAddress string, hsm client.Hsm, health service.Health) *Node {
 	var client *hedera.Client
 	switch cfg.Network {
 	case "mainnet":
@@ -53,22 +63,36 @@ func NewNodeClient(cfg config.Hedera) *Node {
 	} else {
 		log.Debugf("Setting default node rpc urls for [%s].", cfg.Network)
 	}
+	if mirrorNodeAddress != "" {
+		client.SetMirrorNetwork([]string{mirrorNodeAddress})
+	}
 
 	accID, err := hedera.AccountIDFromString(cfg.Operator.AccountId)
 	if err != nil {
 		log.Fatalf("Invalid Operator AccountId provided: [%s]", cfg.Operator.AccountId)
 	}
 
-	privateKey, err := hedera.PrivateKeyFromString(cfg.Operator.PrivateKey)
-	if err != nil {
-		log.Fatalf("Invalid Operator PrivateKey provided: [%s]", cfg.Operator.PrivateKey)
+	if cfg.Operator.KeyLabel != "" {
+		if hsm == nil {
+			log.Fatalf("Operator KeyLabel [%s] provided without HSM configuration.", cfg.Operator.KeyLabel)
+		}
+		publicKey, signer, err := NewHsmOperator(hsm, cfg.Operator.KeyLabel)
+		if err != nil {
+			log.Fatalf("Failed to load Operator HSM key [%s]. Error: [%s]", cfg.Operator.KeyLabel, err)
+		}
+		client.SetOperatorWith(accID, publicKey, signer)
+	} else {
+		privateKey, err := hedera.PrivateKeyFromString(cfg.Operator.PrivateKey)
+		if err != nil {
+			log.Fatalf("Invalid Operator PrivateKey provided: [%s]", cfg.Operator.PrivateKey)
+		}
+		client.SetOperator(accID, privateKey)
 	}
 
-	client.SetOperator(accID, privateKey)
-
 	return &Node{
 		client:   client,
 		maxRetry: cfg.MaxRetry,
+		health:   health,
 		logger:   config.GetLoggerFor("Hedera Node Client"),
 	}
 }
@@ -140,6 +164,7 @@ func (hc Node) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byt
 	)
 
 	response, err := tx.Execute(hc.GetClient())
+	hc.reportCall(err)
 
 	if err != nil {
 		return nil, err
@@ -168,6 +193,7 @@ func (hc Node) SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.Transac
 		tx.GetNodeAccountIDs(),
 	)
 	response, err := tx.Execute(hc.GetClient())
+	hc.reportCall(err)
 
 	return &response, err
 }
@@ -218,11 +244,27 @@ func (hc Node) SubmitScheduledNftTransferTransaction(
 }
 
 func (hc Node) TransactionReceiptQuery(transactionID hedera.TransactionID, nodeAccIds []hedera.AccountID) (hedera.TransactionReceipt, error) {
-	return hedera.NewTransactionReceiptQuery().
+	receipt, err := hedera.NewTransactionReceiptQuery().
 		SetTransactionID(transactionID).
 		SetNodeAccountIDs(nodeAccIds).
 		SetMaxRetry(hc.maxRetry).
 		Execute(hc.GetClient())
+	hc.reportCall(err)
+
+	return receipt, err
+}
+
+func (hc Node) SubscribeToTopic(topicId hedera.TopicID, startTime time.Time, onNext func(hedera.TopicMessage), onError func(error)) (hedera.SubscriptionHandle, error) {
+	return hedera.NewTopicMessageQuery().
+		SetTopicID(topicId).
+		SetStartTime(startTime).
+		SetErrorHandler(func(stat status.Status) {
+			onError(stat.Err())
+		}).
+		SetCompletionHandler(func() {
+			onError(errors.New("topic subscription completed"))
+		}).
+		Subscribe(hc.GetClient(), onNext)
 }
 
 func (hc Node) SubmitScheduledNftApproveTransaction(
@@ -279,12 +321,14 @@ func (hc Node) submitScheduledTransaction(signedTransaction hedera.ITransaction,
 		SetScheduleMemo(memo)
 
 	response, err := scheduledTx.Execute(hc.GetClient())
+	hc.reportCall(err)
 
 	return &response, err
 }
 
 func (hc Node) checkTransactionReceipt(txResponse hedera.TransactionResponse) (*hedera.TransactionReceipt, error) {
 	receipt, err := txResponse.GetReceipt(hc.client)
+	hc.reportCall(err)
 	if err != nil {
 		return nil, err
 	}
@@ -295,3 +339,9 @@ func (hc Node) checkTransactionReceipt(txResponse hedera.TransactionResponse) (*
 
 	return &receipt, err
 }
+
+func (hc Node) reportCall(err error) {
+	if hc.health != nil {
+		hc.health.ReportCall(constants.HealthHederaNodeClient, err)
+	}
+}
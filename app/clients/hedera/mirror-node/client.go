@@ -17,10 +17,12 @@
 package mirror_node
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -28,14 +30,17 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
 	mirrorNodeHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/mirror-node"
 	timestampHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	mirrorNodeModel "github.com/limechain/hedera-eth-bridge-validator/app/model/mirror-node"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 )
@@ -55,7 +60,9 @@ type Client struct {
 	logger                       *log.Entry
 }
 
-func NewClient(mirrorNode config.MirrorNode) *Client {
+// NewClient creates new instance of a Mirror Node client. The outcome of every request is reported to
+// the health service, if provided
+func NewClient(mirrorNode config.MirrorNode, health service.Health) *Client {
 	loggerInstance := config.GetLoggerFor("Mirror Node Client")
 	rp := mirrorNode.RetryPolicy
 	retryClient := retryablehttp.NewClient()
@@ -64,13 +71,18 @@ func NewClient(mirrorNode config.MirrorNode) *Client {
 	retryClient.RetryWaitMax = time.Duration(rp.MaxWait) * time.Second
 	retryClient.RetryWaitMin = time.Duration(rp.MinWait) * time.Second
 
+	httpClient := retryClient.StandardClient()
+	if health != nil {
+		httpClient.Transport = httpHelper.NewHealthTransport(httpClient.Transport, health, constants.HealthMirrorNodeClient)
+	}
+
 	return &Client{
 		mirrorAPIAddress:             mirrorNode.ApiAddress,
 		pollingInterval:              mirrorNode.PollingInterval,
 		queryMaxLimit:                mirrorNode.QueryMaxLimit,
 		queryDefaultLimit:            mirrorNode.QueryDefaultLimit,
 		fullHederaGetHbarUsdPriceUrl: strings.Join([]string{mirrorNode.ApiAddress, TransactionsGetHBARUsdPrice}, ""),
-		httpClient:                   retryClient.StandardClient(),
+		httpClient:                   httpClient,
 		logger:                       loggerInstance,
 	}
 }
@@ -91,10 +103,14 @@ func (c Client) GetHBARUsdPrice() (price decimal.Decimal, err error) {
 }
 
 func (c Client) GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error) {
-	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=credit&timestamp=gt:%s&order=asc&transactiontype=tokenmint",
-		accountId.String(),
-		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionsByQuery(accountTokenMintTransactionsQuery(accountId, from))
+}
+
+// IterateAccountTokenMintTransactionsAfterTimestampString returns an iterator over all TokenMint transactions for the
+// specified account after the given timestamp, following the `links.next` cursor of every page
+func (c Client) IterateAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", accountTokenMintTransactionsQuery(accountId, from))
+	return c.transactionPages(query)
 }
 
 func (c Client) GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error) {
@@ -102,10 +118,14 @@ func (c Client) GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.A
 }
 
 func (c Client) GetAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error) {
-	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&timestamp=gt:%s&order=asc&transactiontype=tokenburn",
-		accountId.String(),
-		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionsByQuery(accountTokenBurnTransactionsQuery(accountId, from))
+}
+
+// IterateAccountTokenBurnTransactionsAfterTimestampString returns an iterator over all TokenBurn transactions for the
+// specified account after the given timestamp, following the `links.next` cursor of every page
+func (c Client) IterateAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", accountTokenBurnTransactionsQuery(accountId, from))
+	return c.transactionPages(query)
 }
 
 func (c Client) GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error) {
@@ -113,37 +133,50 @@ func (c Client) GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.A
 }
 
 func (c Client) GetAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error) {
-	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=debit&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
-		accountId.String(),
-		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionsByQuery(accountDebitTransactionsQuery(accountId, from))
+}
+
+// IterateAccountDebitTransactionsAfterTimestampString returns an iterator over all outgoing Transfers for the
+// specified account after the given timestamp, following the `links.next` cursor of every page
+func (c Client) IterateAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", accountDebitTransactionsQuery(accountId, from))
+	return c.transactionPages(query)
 }
 
 func (c Client) GetAccountCreditTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error) {
-	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=credit&result=success&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
-		accountId.String(),
-		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionsByQuery(accountCreditTransactionsQuery(accountId, from))
 }
 
 func (c Client) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error) {
 	return c.GetAccountCreditTransactionsAfterTimestampString(accountId, timestampHelper.String(from))
 }
 
+// IterateAccountCreditTransactionsAfterTimestamp returns an iterator over all incoming Transfers for the specified
+// account after the given timestamp, following the `links.next` cursor of every page
+func (c Client) IterateAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) *pagination.Pages[transaction.Transaction] {
+	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", accountCreditTransactionsQuery(accountId, timestampHelper.String(from)))
+	return c.transactionPages(query)
+}
+
 // GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
 func (c Client) GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]transaction.Transaction, error) {
-	transactions, err := c.GetAccountCreditTransactionsAfterTimestamp(accountId, from)
-	if err != nil {
-		return nil, err
-	}
+	pages := c.IterateAccountCreditTransactionsAfterTimestamp(accountId, from)
 
 	var res []transaction.Transaction
-	for _, t := range transactions.Transactions {
-		ts, err := timestampHelper.FromString(t.ConsensusTimestamp)
+	for pages.HasNext() {
+		transactions, err := pages.Next()
 		if err != nil {
 			return nil, err
 		}
-		if ts < to {
+
+		for _, t := range transactions {
+			ts, err := timestampHelper.FromString(t.ConsensusTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			if ts >= to {
+				return res, nil
+			}
 			res = append(res, t)
 		}
 	}
@@ -152,12 +185,14 @@ func (c Client) GetAccountCreditTransactionsBetween(accountId hedera.AccountID,
 
 // GetMessagesAfterTimestamp returns all Topic messages after the given timestamp
 func (c Client) GetMessagesAfterTimestamp(topicId hedera.TopicID, from int64, limit int64) ([]message.Message, error) {
-	messagesQuery := fmt.Sprintf("/%s/messages?timestamp=gt:%s&limit=%d",
-		topicId.String(),
-		timestampHelper.String(from),
-		limit)
+	return c.getTopicMessagesByQuery(messagesAfterTimestampQuery(topicId, from, limit))
+}
 
-	return c.getTopicMessagesByQuery(messagesQuery)
+// IterateMessagesAfterTimestamp returns an iterator over all Topic messages after the given timestamp, requesting
+// pages of up to `limit` messages and following the `links.next` cursor of every page
+func (c Client) IterateMessagesAfterTimestamp(topicId hedera.TopicID, from int64, limit int64) *pagination.Pages[message.Message] {
+	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "topics", messagesAfterTimestampQuery(topicId, from, limit))
+	return c.messagePages(query)
 }
 
 // GetMessageBySequenceNumber returns message from given topic with provided sequence number
@@ -205,32 +240,52 @@ func (c Client) QueryMaxLimit() int64 {
 
 // GetMessagesForTopicBetween returns all Topic messages for the specified topic between timestamp `from` and `to` excluded
 func (c Client) GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]message.Message, error) {
-	transactionsDownloadQuery := fmt.Sprintf("/%s/messages?timestamp=gt:%s",
-		topicId.String(),
-		timestampHelper.String(from))
-	msgs, err := c.getTopicMessagesByQuery(transactionsDownloadQuery)
-	if err != nil {
-		return nil, err
-	}
+	pages := c.IterateMessagesAfterTimestamp(topicId, from, c.queryMaxLimit)
 
-	// TODO refactor into 1 function (reuse code above)
 	var res []message.Message
-	for _, m := range msgs {
-		ts, err := timestampHelper.FromString(m.ConsensusTimestamp)
+	for pages.HasNext() {
+		msgs, err := pages.Next()
 		if err != nil {
 			return nil, err
 		}
-		if ts < to {
+
+		for _, m := range msgs {
+			ts, err := timestampHelper.FromString(m.ConsensusTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			if ts >= to {
+				return res, nil
+			}
 			res = append(res, m)
 		}
 	}
 	return res, nil
 }
 
-// GetNftTransactions returns the nft transactions for tokenID and serialNum
+// GetNftTransactions returns all the nft transactions for tokenID and serialNum, following the `links.next` cursor
+// of every page
 func (c Client) GetNftTransactions(tokenID string, serialNum int64) (transaction.NftTransactionsResponse, error) {
 	query := fmt.Sprintf("%stokens/%s/nfts/%d/transactions", c.mirrorAPIAddress, tokenID, serialNum)
 
+	pages := pagination.New(query, func(url string) ([]transaction.NftTransaction, string, error) {
+		response, err := c.getNftTransactionsPage(url)
+		if err != nil {
+			return nil, "", err
+		}
+		next, err := c.nextPageUrl(response.Links.Next)
+		return response.Transactions, next, err
+	})
+
+	transactions, err := pages.All()
+	if err != nil {
+		return transaction.NftTransactionsResponse{}, err
+	}
+
+	return transaction.NftTransactionsResponse{Transactions: transactions}, nil
+}
+
+func (c Client) getNftTransactionsPage(query string) (transaction.NftTransactionsResponse, error) {
 	httpResponse, err := c.get(query)
 	if err != nil {
 		return transaction.NftTransactionsResponse{}, err
@@ -456,18 +511,14 @@ func (c Client) TopicExists(topicID hedera.TopicID) bool {
 }
 
 func (c Client) GetTransactionsAfterTimestamp(accountId hedera.AccountID, startTimestamp int64, transactionType string) ([]transaction.Transaction, error) {
-	query := fmt.Sprintf("?account.id=%s&transactionType=%s&timestamp=gte:%s&limit=%d",
+	query := fmt.Sprintf("%stransactions?account.id=%s&transactionType=%s&timestamp=gte:%s&limit=%d",
+		c.mirrorAPIAddress,
 		accountId,
 		transactionType,
 		timestampHelper.String(startTimestamp),
 		c.queryDefaultLimit)
 
-	resp, err := c.getTransactionsByQuery(query)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp.Transactions, nil
+	return c.transactionPages(query).All()
 }
 
 func (c Client) query(query, entityID string) bool {
@@ -492,10 +543,15 @@ func (c Client) query(query, entityID string) bool {
 }
 
 // WaitForTransaction Polls the transaction at intervals. Depending on the
-// result, the corresponding `onSuccess` and `onFailure` functions are called
-func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
+// result, the corresponding `onSuccess` and `onFailure` functions are called.
+// Polling stops without calling either of them once the context is cancelled
+func (c Client) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
 	go func() {
 		for {
+			if ctx.Err() != nil {
+				c.logger.Warnf("[%s] Stopped monitoring TX. Error: [%s]", txId, ctx.Err())
+				return
+			}
 			response, err := c.GetTransaction(txId)
 			if response != nil && response.IsNotFound() {
 				continue
@@ -524,7 +580,10 @@ func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
 				return
 			}
 			c.logger.Tracef("Pinged Mirror Node for TX [%s]. No update", txId)
-			time.Sleep(c.pollingInterval * time.Second)
+			select {
+			case <-ctx.Done():
+			case <-time.After(c.pollingInterval * time.Second):
+			}
 		}
 	}()
 	c.logger.Debugf("Added new TX [%s] for monitoring", txId)
@@ -602,7 +661,57 @@ func (c Client) getAndParse(query string) (*transaction.Response, error) {
 
 func (c Client) getTopicMessagesByQuery(query string) ([]message.Message, error) {
 	messagesQuery := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "topics", query)
-	response, e := c.get(messagesQuery)
+	messages, e := c.getMessagesPage(messagesQuery)
+	if e != nil {
+		return nil, e
+	}
+	return messages.Messages, nil
+}
+
+// transactionPages returns an iterator over the transactions returned by the given query
+func (c Client) transactionPages(query string) *pagination.Pages[transaction.Transaction] {
+	return pagination.New(query, func(url string) ([]transaction.Transaction, string, error) {
+		response, err := c.getAndParse(url)
+		if err != nil {
+			return nil, "", err
+		}
+		next, err := c.nextPageUrl(response.Links.Next)
+		return response.Transactions, next, err
+	})
+}
+
+// messagePages returns an iterator over the topic messages returned by the given query
+func (c Client) messagePages(query string) *pagination.Pages[message.Message] {
+	return pagination.New(query, func(url string) ([]message.Message, string, error) {
+		response, err := c.getMessagesPage(url)
+		if err != nil {
+			return nil, "", err
+		}
+		next, err := c.nextPageUrl(response.Links.Next)
+		return response.Messages, next, err
+	})
+}
+
+// nextPageUrl resolves the `links.next` hyperlink, which is relative to the Mirror Node host, against the configured API address
+func (c Client) nextPageUrl(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	base, err := url.Parse(c.mirrorAPIAddress)
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next page link [%s]. Error: [%s]", next, err)
+	}
+
+	return base.ResolveReference(ref).String(), nil
+}
+
+func (c Client) getMessagesPage(query string) (*message.Messages, error) {
+	response, e := c.get(query)
 	if e != nil {
 		return nil, e
 	}
@@ -616,7 +725,38 @@ func (c Client) getTopicMessagesByQuery(query string) ([]message.Message, error)
 	if e != nil {
 		return nil, e
 	}
-	return messages.Messages, nil
+	return messages, nil
+}
+
+func accountTokenMintTransactionsQuery(accountId hedera.AccountID, from string) string {
+	return fmt.Sprintf("?account.id=%s&type=credit&timestamp=gt:%s&order=asc&transactiontype=tokenmint",
+		accountId.String(),
+		from)
+}
+
+func accountTokenBurnTransactionsQuery(accountId hedera.AccountID, from string) string {
+	return fmt.Sprintf("?account.id=%s&timestamp=gt:%s&order=asc&transactiontype=tokenburn",
+		accountId.String(),
+		from)
+}
+
+func accountDebitTransactionsQuery(accountId hedera.AccountID, from string) string {
+	return fmt.Sprintf("?account.id=%s&type=debit&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
+		accountId.String(),
+		from)
+}
+
+func accountCreditTransactionsQuery(accountId hedera.AccountID, from string) string {
+	return fmt.Sprintf("?account.id=%s&type=credit&result=success&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
+		accountId.String(),
+		from)
+}
+
+func messagesAfterTimestampQuery(topicId hedera.TopicID, from int64, limit int64) string {
+	return fmt.Sprintf("/%s/messages?timestamp=gt:%s&limit=%d",
+		topicId.String(),
+		timestampHelper.String(from),
+		limit)
 }
 
 func readResponseBody(response *http.Response) ([]byte, error) {
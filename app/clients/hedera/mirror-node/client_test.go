@@ -80,12 +80,18 @@ func setup() {
 
 func Test_NewClient(t *testing.T) {
 	setup()
-	newClient := NewClient(mirrorNodeCfg)
+	newClient := NewClient(mirrorNodeCfg, nil)
 	assert.Equal(t, c.mirrorAPIAddress, newClient.mirrorAPIAddress)
 	assert.Equal(t, c.pollingInterval, newClient.pollingInterval)
 	assert.Equal(t, c.logger, newClient.logger)
 }
 
+func Test_NewClient_WithHealth(t *testing.T) {
+	setup()
+	newClient := NewClient(mirrorNodeCfg, mocks.MHealthService)
+	assert.IsType(t, &httpHelper.HealthTransport{}, newClient.httpClient.(*http.Client).Transport)
+}
+
 func Test_GetAccountTokenMintTransactionsAfterTimestamp_ThrowsError(t *testing.T) {
 	setup()
 	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
@@ -278,6 +284,128 @@ func Test_GetAccountCreditTransactionsBetween(t *testing.T) {
 	assert.Equal(t, expected.Transactions[0].ConsensusTimestamp, response[0].ConsensusTimestamp)
 }
 
+func Test_GetAccountCreditTransactionsBetween_FollowsNextLink(t *testing.T) {
+	setup()
+	c.mirrorAPIAddress = "https://mirror.node/api/v1/"
+	next := "/api/v1/transactions?account.id=0.0.1&timestamp=gt:1631092491.483966000"
+
+	firstPage, err := httpHelper.EncodeBodyContent(transaction.Response{
+		Transactions: []transaction.Transaction{{ConsensusTimestamp: "1631092491.483966000"}},
+		Links:        transaction.Pagination{Next: next},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondPage, err := httpHelper.EncodeBodyContent(transaction.Response{
+		Transactions: []transaction.Transaction{
+			{ConsensusTimestamp: "1631092492.483966000"},
+			{ConsensusTimestamp: "1631092493.483966000"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks.MHTTPClient.On("Get", "https://mirror.node/api/v1/transactions"+accountCreditTransactionsQuery(accountId, "0.000000000")).
+		Return(&http.Response{StatusCode: 200, Body: firstPage}, nil)
+	mocks.MHTTPClient.On("Get", "https://mirror.node"+next).
+		Return(&http.Response{StatusCode: 200, Body: secondPage}, nil)
+
+	response, err := c.GetAccountCreditTransactionsBetween(accountId, 0, 1631092493483966000)
+	assert.Nil(t, err)
+	assert.Len(t, response, 2)
+	assert.Equal(t, "1631092492.483966000", response[1].ConsensusTimestamp)
+}
+
+func Test_IterateMessagesAfterTimestamp_RetriesFailedPage(t *testing.T) {
+	setup()
+	next := "/api/v1/topics/0.0.1/messages?limit=1&timestamp=gt:1631092491.483966000"
+
+	firstPage, err := httpHelper.EncodeBodyContent(message.Messages{
+		Messages: []message.Message{{ConsensusTimestamp: "1631092491.483966000"}},
+		Links:    message.Links{Next: next},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondPage, err := httpHelper.EncodeBodyContent(message.Messages{
+		Messages: []message.Message{{ConsensusTimestamp: "1631092492.483966000"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks.MHTTPClient.On("Get", mirrorAPIAddress+"topics"+messagesAfterTimestampQuery(topicId, 0, queryDefaultLimit)).
+		Return(&http.Response{StatusCode: 200, Body: firstPage}, nil)
+	mocks.MHTTPClient.On("Get", next).Return(nil, errors.New("some-error")).Once()
+	mocks.MHTTPClient.On("Get", next).Return(&http.Response{StatusCode: 200, Body: secondPage}, nil)
+
+	pages := c.IterateMessagesAfterTimestamp(topicId, 0, queryDefaultLimit)
+
+	messages, err := pages.Next()
+	assert.Nil(t, err)
+	assert.Len(t, messages, 1)
+
+	_, err = pages.Next()
+	assert.Error(t, err)
+	assert.True(t, pages.HasNext())
+
+	messages, err = pages.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "1631092492.483966000", messages[0].ConsensusTimestamp)
+	assert.False(t, pages.HasNext())
+}
+
+func Test_IterateAccountDebitTransactionsAfterTimestampString_FollowsNextLink(t *testing.T) {
+	setup()
+	c.mirrorAPIAddress = "https://mirror.node/api/v1/"
+	next := "/api/v1/transactions?account.id=0.0.1&type=debit&timestamp=gt:1631092491.483966000"
+
+	firstPage, err := httpHelper.EncodeBodyContent(transaction.Response{
+		Transactions: []transaction.Transaction{{ConsensusTimestamp: "1631092491.483966000"}},
+		Links:        transaction.Pagination{Next: next},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondPage, err := httpHelper.EncodeBodyContent(transaction.Response{
+		Transactions: []transaction.Transaction{{ConsensusTimestamp: "1631092492.483966000"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks.MHTTPClient.On("Get", "https://mirror.node/api/v1/transactions"+accountDebitTransactionsQuery(accountId, "1")).
+		Return(&http.Response{StatusCode: 200, Body: firstPage}, nil)
+	mocks.MHTTPClient.On("Get", "https://mirror.node"+next).
+		Return(&http.Response{StatusCode: 200, Body: secondPage}, nil)
+
+	transactions, err := c.IterateAccountDebitTransactionsAfterTimestampString(accountId, "1").All()
+	assert.Nil(t, err)
+	assert.Len(t, transactions, 2)
+	assert.Equal(t, "1631092492.483966000", transactions[1].ConsensusTimestamp)
+}
+
+func Test_IterateAccountTokenMintTransactionsAfterTimestampString(t *testing.T) {
+	setup()
+	mocks.MHTTPClient.On("Get", mirrorAPIAddress+"transactions"+accountTokenMintTransactionsQuery(accountId, "1")).
+		Return(nil, errors.New("some-error"))
+
+	transactions, err := c.IterateAccountTokenMintTransactionsAfterTimestampString(accountId, "1").All()
+	assert.Error(t, err)
+	assert.Nil(t, transactions)
+}
+
+func Test_IterateAccountTokenBurnTransactionsAfterTimestampString(t *testing.T) {
+	setup()
+	mocks.MHTTPClient.On("Get", mirrorAPIAddress+"transactions"+accountTokenBurnTransactionsQuery(accountId, "1")).
+		Return(nil, errors.New("some-error"))
+
+	transactions, err := c.IterateAccountTokenBurnTransactionsAfterTimestampString(accountId, "1").All()
+	assert.Error(t, err)
+	assert.Nil(t, transactions)
+}
+
 func Test_QueryDefaultLimit(t *testing.T) {
 	setup()
 
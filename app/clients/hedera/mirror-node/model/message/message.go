@@ -43,5 +43,10 @@ type (
 	// Topic Messages are queried
 	Messages struct {
 		Messages []Message
+		Links    Links `json:"links"`
+	}
+	// Links holds the hyperlink to the next page of results, if any
+	Links struct {
+		Next string `json:"next"`
 	}
 )
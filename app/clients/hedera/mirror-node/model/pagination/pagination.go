@@ -0,0 +1,70 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pagination
+
+// Fetcher retrieves the page at the given URL and returns its items together with
+// the URL of the next page, which is empty once the last page is reached
+type Fetcher[T any] func(url string) (items []T, next string, err error)
+
+// Pages lazily walks a Mirror Node list query by following the `links.next` cursor
+// returned with every page
+type Pages[T any] struct {
+	next  string
+	fetch Fetcher[T]
+}
+
+// New creates an iterator which starts from the given URL
+func New[T any](url string, fetch Fetcher[T]) *Pages[T] {
+	return &Pages[T]{
+		next:  url,
+		fetch: fetch,
+	}
+}
+
+// HasNext returns whether there is another page to be fetched
+func (p *Pages[T]) HasNext() bool {
+	return p.next != ""
+}
+
+// Next fetches the next page. If the fetch fails, the cursor is not moved and
+// the same page is requested again on the following call
+func (p *Pages[T]) Next() ([]T, error) {
+	if !p.HasNext() {
+		return nil, nil
+	}
+
+	items, next, err := p.fetch(p.next)
+	if err != nil {
+		return nil, err
+	}
+	p.next = next
+
+	return items, nil
+}
+
+// All walks the remaining pages and returns their items
+func (p *Pages[T]) All() ([]T, error) {
+	var res []T
+	for p.HasNext() {
+		items, err := p.Next()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, items...)
+	}
+	return res, nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fetchFrom(pages map[string][]int, links map[string]string) Fetcher[int] {
+	return func(url string) ([]int, string, error) {
+		items, ok := pages[url]
+		if !ok {
+			return nil, "", errors.New("not found")
+		}
+		return items, links[url], nil
+	}
+}
+
+func Test_All(t *testing.T) {
+	pages := New("first", fetchFrom(
+		map[string][]int{"first": {1, 2}, "second": {3}},
+		map[string]string{"first": "second"}))
+
+	items, err := pages.All()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.False(t, pages.HasNext())
+}
+
+func Test_All_Fails(t *testing.T) {
+	pages := New("first", fetchFrom(
+		map[string][]int{"first": {1, 2}},
+		map[string]string{"first": "second"}))
+
+	items, err := pages.All()
+
+	assert.Error(t, err)
+	assert.Nil(t, items)
+}
+
+func Test_Next_KeepsCursorOnError(t *testing.T) {
+	calls := 0
+	pages := New("first", func(url string) ([]int, string, error) {
+		calls++
+		if calls == 1 {
+			return nil, "", errors.New("some-error")
+		}
+		return []int{1}, "", nil
+	})
+
+	_, err := pages.Next()
+	assert.Error(t, err)
+	assert.True(t, pages.HasNext())
+
+	items, err := pages.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1}, items)
+	assert.False(t, pages.HasNext())
+}
+
+func Test_Next_NoMorePages(t *testing.T) {
+	pages := New("", fetchFrom(nil, nil))
+
+	items, err := pages.Next()
+
+	assert.Nil(t, err)
+	assert.Nil(t, items)
+	assert.False(t, pages.HasNext())
+}
@@ -62,6 +62,7 @@ type (
 	// account transactions are queried
 	Response struct {
 		Transactions         []Transaction
+		Links                Pagination `json:"links"`
 		mirrorNodeErr.Status `json:"_status"`
 	}
 	// Schedule struct used by the Hedera Mirror node REST API to return information
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	hsmClient "github.com/limechain/hedera-eth-bridge-validator/app/clients/hsm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+// NewHsmOperator returns the public key and the transaction signer of an operator, whose Ed25519 or
+// ECDSA secp256k1 key is kept in the HSM. ECDSA signatures are over the keccak256 hash of the message,
+// as the ones of hedera.PrivateKey
+func NewHsmOperator(hsm client.Hsm, keyLabel string) (hedera.PublicKey, hedera.TransactionSigner, error) {
+	logger := config.GetLoggerFor("Hedera HSM Operator")
+
+	publicKey, err := hsm.PublicKey(keyLabel)
+	if err != nil {
+		return hedera.PublicKey{}, nil, err
+	}
+
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		operatorKey, err := hedera.PublicKeyFromBytesEd25519(key)
+		if err != nil {
+			return hedera.PublicKey{}, nil, err
+		}
+		return operatorKey, func(message []byte) []byte {
+			signature, err := hsm.SignEdDSA(keyLabel, message)
+			if err != nil {
+				logger.Errorf("Failed to sign transaction with key [%s]. Error: [%s]", keyLabel, err)
+			}
+			return signature
+		}, nil
+	case *ecdsa.PublicKey:
+		operatorKey, err := hedera.PublicKeyFromBytesECDSA(crypto.CompressPubkey(key))
+		if err != nil {
+			return hedera.PublicKey{}, nil, err
+		}
+		return operatorKey, func(message []byte) []byte {
+			signature, err := hsm.SignECDSA(keyLabel, crypto.Keccak256(message))
+			if err != nil {
+				logger.Errorf("Failed to sign transaction with key [%s]. Error: [%s]", keyLabel, err)
+			}
+			return signature
+		}, nil
+	default:
+		return hedera.PublicKey{}, nil, hsmClient.ErrUnsupportedKeyType
+	}
+}
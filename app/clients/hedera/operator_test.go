@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	hsmClient "github.com/limechain/hedera-eth-bridge-validator/app/clients/hsm"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyLabel  = "operator-key"
+	message   = []byte("transaction-body")
+	signature = []byte("signature")
+)
+
+func Test_NewHsmOperator_Ed25519(t *testing.T) {
+	mocks.Setup()
+	publicKey, _, _ := ed25519.GenerateKey(nil)
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return(publicKey, nil)
+	mocks.MHsmClient.On("SignEdDSA", keyLabel, message).Return(signature, nil)
+
+	operatorKey, signer, err := NewHsmOperator(mocks.MHsmClient, keyLabel)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(publicKey), operatorKey.BytesRaw())
+	assert.Equal(t, signature, signer(message))
+}
+
+func Test_NewHsmOperator_ECDSA(t *testing.T) {
+	mocks.Setup()
+	key, _ := crypto.GenerateKey()
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return(&key.PublicKey, nil)
+	mocks.MHsmClient.On("SignECDSA", keyLabel, crypto.Keccak256(message)).Return(signature, nil)
+
+	operatorKey, signer, err := NewHsmOperator(mocks.MHsmClient, keyLabel)
+
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.CompressPubkey(&key.PublicKey), operatorKey.BytesRaw())
+	assert.Equal(t, signature, signer(message))
+}
+
+func Test_NewHsmOperator_SignFails(t *testing.T) {
+	mocks.Setup()
+	publicKey, _, _ := ed25519.GenerateKey(nil)
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return(publicKey, nil)
+	mocks.MHsmClient.On("SignEdDSA", keyLabel, message).Return(nil, errors.New("some-error"))
+
+	_, signer, err := NewHsmOperator(mocks.MHsmClient, keyLabel)
+
+	assert.Nil(t, err)
+	assert.Nil(t, signer(message))
+}
+
+func Test_NewHsmOperator_KeyNotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return(nil, hsmClient.ErrKeyNotFound)
+
+	_, signer, err := NewHsmOperator(mocks.MHsmClient, keyLabel)
+
+	assert.Nil(t, signer)
+	assert.Equal(t, hsmClient.ErrKeyNotFound, err)
+}
+
+func Test_NewHsmOperator_UnsupportedKey(t *testing.T) {
+	mocks.Setup()
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return("key", nil)
+
+	_, signer, err := NewHsmOperator(mocks.MHsmClient, keyLabel)
+
+	assert.Nil(t, signer)
+	assert.Equal(t, hsmClient.ErrUnsupportedKeyType, err)
+}
+
+func Test_NewHsmOperator_SoftHsm(t *testing.T) {
+	client := hsmClient.NewClient(helper.SetupSoftHsm(t))
+	defer client.Close()
+
+	for _, label := range []string{helper.SoftHsmEd25519KeyLabel, helper.SoftHsmSecp256k1KeyLabel} {
+		operatorKey, signer, err := NewHsmOperator(client, label)
+		assert.Nil(t, err)
+
+		publicKey, _ := client.PublicKey(label)
+		switch key := publicKey.(type) {
+		case ed25519.PublicKey:
+			assert.True(t, ed25519.Verify(key, message, signer(message)))
+		case *ecdsa.PublicKey:
+			assert.True(t, crypto.VerifySignature(crypto.FromECDSAPub(key), crypto.Keccak256(message), signer(message)))
+		}
+
+		hederaClient := hedera.ClientForTestnet()
+		hederaClient.SetOperatorWith(hedera.AccountID{Account: 1001}, operatorKey, signer)
+		tx, err := hedera.NewTopicMessageSubmitTransaction().
+			SetTopicID(hedera.TopicID{Topic: 1002}).
+			SetMessage(message).
+			FreezeWith(hederaClient)
+		assert.Nil(t, err)
+		tx, err = tx.SignWithOperator(hederaClient)
+		assert.Nil(t, err)
+
+		signatures, err := tx.GetSignatures()
+		assert.Nil(t, err)
+		assert.NotEmpty(t, signatures)
+		for _, bySigner := range signatures {
+			for key, signature := range bySigner {
+				assert.Equal(t, operatorKey.String(), key.String())
+				assert.NotEmpty(t, signature)
+			}
+		}
+	}
+}
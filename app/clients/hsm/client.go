@@ -0,0 +1,241 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hsm
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/miekg/pkcs11"
+	log "github.com/sirupsen/logrus"
+)
+
+// CKM_EDDSA of PKCS#11 v3.0, which is not defined by github.com/miekg/pkcs11
+const ckmEdDSA = 0x00001057
+
+var (
+	secp256k1Oid = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	ed25519Oid   = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+	ErrKeyNotFound        = errors.New("key-not-found")
+	ErrUnsupportedKeyType = errors.New("unsupported-key-type")
+
+	secp256k1HalfN = new(big.Int).Rsh(ethCrypto.S256().Params().N, 1)
+)
+
+// Client holds a logged in session to a PKCS#11 token. The session is shared by all signers,
+// so operations on it are serialised
+type Client struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	mutex   sync.Mutex
+	logger  *log.Entry
+}
+
+// NewClient loads the PKCS#11 module and logs in to the token with the configured label
+func NewClient(cfg config.Hsm) *Client {
+	ctx := pkcs11.New(cfg.ModulePath)
+	if ctx == nil {
+		log.Fatalf("Failed to load PKCS#11 module [%s].", cfg.ModulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		log.Fatalf("Failed to initialize PKCS#11 module [%s]. Error: [%s]", cfg.ModulePath, err)
+	}
+
+	slot, err := findSlot(ctx, cfg.TokenLabel)
+	if err != nil {
+		log.Fatalf("Failed to find PKCS#11 token [%s]. Error: [%s]", cfg.TokenLabel, err)
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		log.Fatalf("Failed to open session to PKCS#11 token [%s]. Error: [%s]", cfg.TokenLabel, err)
+	}
+	if err = ctx.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil {
+		log.Fatalf("Failed to login to PKCS#11 token [%s]. Error: [%s]", cfg.TokenLabel, err)
+	}
+
+	return &Client{
+		ctx:     ctx,
+		session: session,
+		logger:  config.GetLoggerFor("HSM Client"),
+	}
+}
+
+// SignECDSA signs the digest with the secp256k1 key, returning the concatenated `r` and `s` of the signature.
+// `s` is normalised to the lower half of the curve order, as HSMs do not enforce it
+func (c *Client) SignECDSA(keyLabel string, digest []byte) ([]byte, error) {
+	signature, err := c.sign(keyLabel, pkcs11.CKM_ECDSA, digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != 64 {
+		return nil, fmt.Errorf("invalid ECDSA signature length [%d]", len(signature))
+	}
+
+	return toLowS(signature), nil
+}
+
+// SignEdDSA signs the message with the Ed25519 key
+func (c *Client) SignEdDSA(keyLabel string, message []byte) ([]byte, error) {
+	return c.sign(keyLabel, ckmEdDSA, message)
+}
+
+// PublicKey returns the public key of the key pair, either *ecdsa.PublicKey or ed25519.PublicKey
+func (c *Client) PublicKey(keyLabel string) (crypto.PublicKey, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key, err := c.findKey(pkcs11.CKO_PUBLIC_KEY, keyLabel)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := c.ctx.GetAttributeValue(c.session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	point := attributes[1].Value
+	var octets []byte
+	if rest, err := asn1.Unmarshal(point, &octets); err == nil && len(rest) == 0 {
+		point = octets
+	}
+
+	switch curve := parseCurve(attributes[0].Value); {
+	case curve.Equal(secp256k1Oid):
+		return ethCrypto.UnmarshalPubkey(point)
+	case curve.Equal(ed25519Oid) && len(point) == ed25519.PublicKeySize:
+		return ed25519.PublicKey(point), nil
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+}
+
+// Close logs out of the token and unloads the PKCS#11 module
+func (c *Client) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.ctx.Logout(c.session); err != nil {
+		c.logger.Errorf("Failed to logout. Error: [%s]", err)
+	}
+	if err := c.ctx.CloseSession(c.session); err != nil {
+		c.logger.Errorf("Failed to close session. Error: [%s]", err)
+	}
+	if err := c.ctx.Finalize(); err != nil {
+		c.logger.Errorf("Failed to finalize module. Error: [%s]", err)
+	}
+	c.ctx.Destroy()
+}
+
+func (c *Client) sign(keyLabel string, mechanism uint, data []byte) ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key, err := c.findKey(pkcs11.CKO_PRIVATE_KEY, keyLabel)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.ctx.SignInit(c.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, key); err != nil {
+		c.logger.Errorf("Failed to initialise signing with key [%s]. Error: [%s]", keyLabel, err)
+		return nil, err
+	}
+	signature, err := c.ctx.Sign(c.session, data)
+	if err != nil {
+		c.logger.Errorf("Failed to sign with key [%s]. Error: [%s]", keyLabel, err)
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func (c *Client) findKey(class uint, keyLabel string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+	}
+	if err := c.ctx.FindObjectsInit(c.session, template); err != nil {
+		return 0, err
+	}
+	objects, _, err := c.ctx.FindObjects(c.session, 1)
+	if finalErr := c.ctx.FindObjectsFinal(c.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(objects) == 0 {
+		return 0, ErrKeyNotFound
+	}
+
+	return objects[0], nil
+}
+
+func findSlot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, err
+		}
+		if strings.TrimSpace(info.Label) == tokenLabel {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no token with label [%s]", tokenLabel)
+}
+
+// toLowS replaces `s` of the signature with `N - s` if it is in the upper half of the curve order,
+// which keeps the signature valid while making it acceptable by EVM contracts
+func toLowS(signature []byte) []byte {
+	s := new(big.Int).SetBytes(signature[32:])
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s.Sub(ethCrypto.S256().Params().N, s)
+		s.FillBytes(signature[32:])
+	}
+	return signature
+}
+
+// parseCurve returns the OID of the curve from the DER encoded CKA_EC_PARAMS. Ed25519 keys may specify
+// their curve by the `edwards25519` name instead
+func parseCurve(params []byte) asn1.ObjectIdentifier {
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err == nil {
+		return oid
+	}
+
+	var name string
+	if _, err := asn1.UnmarshalWithParams(params, &name, "printable"); err == nil && name == "edwards25519" {
+		return ed25519Oid
+	}
+
+	return nil
+}
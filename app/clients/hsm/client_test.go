@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hsm
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+var digest = ethCrypto.Keccak256([]byte("message"))
+
+func Test_SignECDSA(t *testing.T) {
+	client := setupClient(t)
+
+	publicKey, err := client.PublicKey(helper.SoftHsmSecp256k1KeyLabel)
+	assert.Nil(t, err)
+	assert.IsType(t, &ecdsa.PublicKey{}, publicKey)
+
+	signature, err := client.SignECDSA(helper.SoftHsmSecp256k1KeyLabel, digest)
+
+	assert.Nil(t, err)
+	assert.True(t, ethCrypto.VerifySignature(ethCrypto.FromECDSAPub(publicKey.(*ecdsa.PublicKey)), digest, signature))
+}
+
+func Test_SignEdDSA(t *testing.T) {
+	client := setupClient(t)
+
+	publicKey, err := client.PublicKey(helper.SoftHsmEd25519KeyLabel)
+	assert.Nil(t, err)
+	assert.IsType(t, ed25519.PublicKey{}, publicKey)
+
+	signature, err := client.SignEdDSA(helper.SoftHsmEd25519KeyLabel, []byte("message"))
+
+	assert.Nil(t, err)
+	assert.True(t, ed25519.Verify(publicKey.(ed25519.PublicKey), []byte("message"), signature))
+}
+
+func Test_KeyNotFound(t *testing.T) {
+	client := setupClient(t)
+
+	_, err := client.SignECDSA("missing", digest)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	_, err = client.PublicKey("missing")
+	assert.Equal(t, ErrKeyNotFound, err)
+}
+
+func Test_ToLowS(t *testing.T) {
+	key, _ := ethCrypto.GenerateKey()
+	signature, _ := ethCrypto.Sign(digest, key)
+	signature = signature[:64]
+	highS := make([]byte, 64)
+	copy(highS, signature[:32])
+	new(big.Int).Sub(ethCrypto.S256().Params().N, new(big.Int).SetBytes(signature[32:])).FillBytes(highS[32:])
+
+	assert.Equal(t, signature, toLowS(highS))
+	assert.Equal(t, signature, toLowS(signature))
+}
+
+func Test_ParseCurve(t *testing.T) {
+	secp256k1, _ := asn1.Marshal(secp256k1Oid)
+	edwards25519, _ := asn1.MarshalWithParams("edwards25519", "printable")
+
+	assert.Equal(t, secp256k1Oid, parseCurve(secp256k1))
+	assert.Equal(t, ed25519Oid, parseCurve(edwards25519))
+	assert.Nil(t, parseCurve([]byte{0x01}))
+}
+
+func setupClient(t *testing.T) *Client {
+	client := NewClient(helper.SetupSoftHsm(t))
+	t.Cleanup(client.Close)
+	return client
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistent
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+)
+
+// Payload types of the persisted queue messages
+const (
+	TransferPayload     = "TRANSFER"
+	TopicMessagePayload = "TOPIC_MESSAGE"
+)
+
+type topicMessagePayload struct {
+	Content              []byte
+	TransactionTimestamp int64
+}
+
+// EncodePayload serializes the payload of a queue message, returning its payload type
+func EncodePayload(p interface{}) (string, []byte, error) {
+	switch v := p.(type) {
+	case *payload.Transfer:
+		data, err := json.Marshal(v)
+		return TransferPayload, data, err
+	case *message.Message:
+		content, err := v.ToBytes()
+		if err != nil {
+			return "", nil, err
+		}
+		data, err := json.Marshal(topicMessagePayload{Content: content, TransactionTimestamp: v.TransactionTimestamp})
+		return TopicMessagePayload, data, err
+	default:
+		return "", nil, fmt.Errorf("unsupported payload type [%T]", p)
+	}
+}
+
+// DecodePayload deserializes the payload of a queue message based on its payload type
+func DecodePayload(payloadType string, data []byte) (interface{}, error) {
+	switch payloadType {
+	case TransferPayload:
+		transfer := &payload.Transfer{}
+		err := json.Unmarshal(data, transfer)
+		if err != nil {
+			return nil, err
+		}
+		return transfer, nil
+	case TopicMessagePayload:
+		msg := &topicMessagePayload{}
+		err := json.Unmarshal(data, msg)
+		if err != nil {
+			return nil, err
+		}
+		return message.FromBytesWithTS(msg.Content, msg.TransactionTimestamp)
+	default:
+		return nil, fmt.Errorf("unsupported payload type [%s]", payloadType)
+	}
+}
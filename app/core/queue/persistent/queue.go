@@ -0,0 +1,240 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// pollingInterval is how often the leader checks for messages stored by the standby replicas
+	pollingInterval = 5 * time.Second
+	// maxPersistBackoff caps the wait between the attempts to persist a pushed message
+	maxPersistBackoff = 30 * time.Second
+)
+
+// Queue is a go channel, backed by the database. Every pushed message is stored
+// until it gets acknowledged, so that unhandled messages are redelivered after a restart.
+// Redelivery is left to the leader node, so that standby replicas do not pick up the messages.
+// The leader keeps polling for the messages stored by the standby replicas, e.g. replayed dead letters.
+type Queue struct {
+	channel    chan *queue.Message
+	repository repository.QueueMessage
+	// leaderCtx is the context of the current leadership, set on redelivery. Nil until the node leads
+	leaderCtx context.Context
+	// claimed holds the IDs of the stored messages delivered within the leadership, together with the polling
+	// round in which they were claimed, so that every message is delivered only once
+	claimed         map[uint64]uint64
+	round           uint64
+	pollingInterval time.Duration
+	persistBackoff  time.Duration
+	mu              sync.Mutex
+	logger          *log.Entry
+}
+
+func NewQueue(repository repository.QueueMessage) *Queue {
+	return &Queue{
+		channel:         make(chan *queue.Message),
+		repository:      repository,
+		claimed:         make(map[uint64]uint64),
+		pollingInterval: pollingInterval,
+		persistBackoff:  time.Second,
+		logger:          config.GetLoggerFor("Persistent Queue"),
+	}
+}
+
+// Push stores the message and pushes it to the channel. While the node does not lead, nothing reads
+// the channel, so the message is only stored and gets delivered by the leader.
+// Push blocks until the message is stored, so that the watchers do not advance past unstored events
+func (q *Queue) Push(message *queue.Message) {
+	payloadType, data, err := EncodePayload(message.Payload)
+	if err != nil {
+		q.logger.Fatalf("[%s] - Failed to encode message payload. Error: [%s]", message.Topic, err)
+	}
+	message.ID = q.persist(&entity.QueueMessage{
+		Topic:        message.Topic,
+		PayloadType:  payloadType,
+		Payload:      data,
+		DeadLetterID: message.DeadLetterID,
+	})
+
+	leaderCtx := q.leading()
+	if leaderCtx == nil || leaderCtx.Err() != nil {
+		q.logger.Warnf("[%s] - Node is not leading. Message [%d] is left for delivery by the leader.", message.Topic, message.ID)
+		return
+	}
+	if !q.claim(message.ID) {
+		return
+	}
+
+	select {
+	case q.channel <- message:
+	case <-leaderCtx.Done():
+		q.logger.Warnf("[%s] - Leadership lost. Message [%d] is left for redelivery by the leader.", message.Topic, message.ID)
+	}
+}
+
+// persist retries storing the record until it succeeds, backing off exponentially. Returns the ID of the record
+func (q *Queue) persist(record *entity.QueueMessage) uint64 {
+	backoff := q.persistBackoff
+	for {
+		err := q.repository.Create(record)
+		if err == nil {
+			return record.ID
+		}
+
+		q.logger.Errorf("[%s] - Failed to persist message. Retrying in [%s]. Error: [%s]", record.Topic, backoff, err)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxPersistBackoff {
+			backoff = maxPersistBackoff
+		}
+	}
+}
+
+// Ack removes the stored message, once it has been handled
+func (q *Queue) Ack(message *queue.Message) {
+	if message.ID == 0 {
+		return
+	}
+
+	err := q.repository.Delete(message.ID)
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to acknowledge message [%d]. Error: [%s]", message.Topic, message.ID, err)
+	}
+}
+
+func (q *Queue) Channel() chan *queue.Message {
+	return q.channel
+}
+
+// Redeliver pushes all messages, which were not acknowledged, to the channel. Afterwards it keeps polling
+// for the messages stored by the standby replicas in the background, until the context is cancelled.
+// The context is the one of the leadership, within which the pushed messages are delivered
+func (q *Queue) Redeliver(ctx context.Context) {
+	// The stored messages are read before the leadership is published, so that the messages pushed
+	// meanwhile are claimed either by the push or by the redelivery
+	q.mu.Lock()
+	q.claimed = make(map[uint64]uint64)
+	q.round++
+	q.mu.Unlock()
+
+	records, err := q.repository.GetAll()
+	if err != nil {
+		q.logger.Fatalf("Failed to retrieve unacknowledged queue messages. Error: [%s]", err)
+	}
+
+	q.mu.Lock()
+	q.leaderCtx = ctx
+	q.mu.Unlock()
+
+	if len(records) > 0 {
+		q.logger.Infof("Redelivering [%d] unacknowledged messages", len(records))
+	}
+	if !q.deliver(ctx, records) {
+		return
+	}
+
+	go q.poll(ctx)
+}
+
+// poll delivers the messages stored by the standby replicas until the context is cancelled
+func (q *Queue) poll(ctx context.Context) {
+	for syncHelper.Sleep(ctx, q.pollingInterval) {
+		q.mu.Lock()
+		q.round++
+		round := q.round
+		q.mu.Unlock()
+
+		records, err := q.repository.GetAll()
+		if err != nil {
+			q.logger.Errorf("Failed to retrieve unacknowledged queue messages. Error: [%s]", err)
+			continue
+		}
+
+		q.release(records, round)
+		if !q.deliver(ctx, records) {
+			return
+		}
+	}
+}
+
+// deliver pushes the stored messages, which are not yet claimed, to the channel.
+// Returns false if the context got cancelled meanwhile
+func (q *Queue) deliver(ctx context.Context, records []*entity.QueueMessage) bool {
+	for _, record := range records {
+		if !q.claim(record.ID) {
+			continue
+		}
+
+		p, err := DecodePayload(record.PayloadType, record.Payload)
+		if err != nil {
+			q.logger.Errorf("[%s] - Failed to decode message [%d]. Error: [%s]", record.Topic, record.ID, err)
+			continue
+		}
+
+		select {
+		case q.channel <- &queue.Message{Payload: p, Topic: record.Topic, ID: record.ID, DeadLetterID: record.DeadLetterID}:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// claim marks the stored message as delivered. Returns false if it has already been claimed
+func (q *Queue) claim(id uint64) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.claimed[id]; ok {
+		return false
+	}
+	q.claimed[id] = q.round
+	return true
+}
+
+// release forgets the claimed messages, which are no longer stored. Only messages claimed before the given
+// polling round are released, as the ones claimed during the round may have been stored after the records were read
+func (q *Queue) release(records []*entity.QueueMessage, round uint64) {
+	stored := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		stored[record.ID] = true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for id, claimedIn := range q.claimed {
+		if claimedIn < round && !stored[id] {
+			delete(q.claimed, id)
+		}
+	}
+}
+
+func (q *Queue) leading() context.Context {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.leaderCtx
+}
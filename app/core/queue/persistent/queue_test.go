@@ -0,0 +1,279 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistent
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	transferPayload = &payload.Transfer{
+		TransactionId: "0.0.123-123-123",
+		SourceChainId: constants.HederaNetworkId,
+		TargetChainId: 80001,
+		NativeChainId: constants.HederaNetworkId,
+		SourceAsset:   constants.Hbar,
+		TargetAsset:   "0xasset",
+		NativeAsset:   constants.Hbar,
+		Receiver:      "0xreceiver",
+		Amount:        "100",
+		Timestamp:     time.Unix(100, 0).UTC(),
+	}
+	topicMessage = &message.Message{
+		TopicMessage: &proto.TopicMessage{
+			Message: &proto.TopicMessage_FungibleSignatureMessage{
+				FungibleSignatureMessage: &proto.TopicEthSignatureMessage{
+					SourceChainId: constants.HederaNetworkId,
+					TargetChainId: 80001,
+					TransferID:    "0.0.123-123-123",
+					Asset:         "0xasset",
+					Recipient:     "0xreceiver",
+					Amount:        "100",
+					Signature:     "signature",
+				},
+			},
+		},
+		TransactionTimestamp: 123,
+	}
+)
+
+func setupQueue(pending []*entity.QueueMessage) *Queue {
+	mocks.Setup()
+	mocks.MQueueMessageRepository.On("GetAll").Return(pending, nil)
+	return NewQueue(mocks.MQueueMessageRepository)
+}
+
+func Test_EncodeDecodePayload_Transfer(t *testing.T) {
+	payloadType, data, err := EncodePayload(transferPayload)
+	assert.Nil(t, err)
+	assert.Equal(t, TransferPayload, payloadType)
+
+	actual, err := DecodePayload(payloadType, data)
+	assert.Nil(t, err)
+	assert.Equal(t, transferPayload, actual)
+}
+
+func Test_EncodeDecodePayload_TopicMessage(t *testing.T) {
+	payloadType, data, err := EncodePayload(topicMessage)
+	assert.Nil(t, err)
+	assert.Equal(t, TopicMessagePayload, payloadType)
+
+	actual, err := DecodePayload(payloadType, data)
+	assert.Nil(t, err)
+	actualMessage := actual.(*message.Message)
+	assert.Equal(t, topicMessage.TransactionTimestamp, actualMessage.TransactionTimestamp)
+	assert.Equal(t, topicMessage.GetFungibleSignatureMessage().String(), actualMessage.GetFungibleSignatureMessage().String())
+}
+
+func Test_EncodePayload_Unsupported(t *testing.T) {
+	_, _, err := EncodePayload("unsupported")
+	assert.NotNil(t, err)
+}
+
+func Test_DecodePayload_Unsupported(t *testing.T) {
+	_, err := DecodePayload("unsupported", []byte{})
+	assert.NotNil(t, err)
+}
+
+func Test_Queue_Push(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.QueueMessage).ID = 1
+	})
+
+	pq.Redeliver(context.Background())
+	msg := &queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer, DeadLetterID: 3}
+	go pq.Push(msg)
+	received := <-pq.Channel()
+
+	assert.Equal(t, msg, received)
+	assert.Equal(t, uint64(1), received.ID)
+	mocks.MQueueMessageRepository.AssertCalled(t, "Create", mock.MatchedBy(func(record *entity.QueueMessage) bool {
+		return record.Topic == constants.HederaMintHtsTransfer && record.PayloadType == TransferPayload && record.DeadLetterID == 3
+	}))
+}
+
+func Test_Queue_Push_RetriesCreate(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+	pq.persistBackoff = time.Millisecond
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(errors.New("some-error")).Twice()
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.QueueMessage).ID = 1
+	})
+
+	pq.Redeliver(context.Background())
+	msg := &queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer}
+	go pq.Push(msg)
+	received := <-pq.Channel()
+
+	assert.Equal(t, msg, received)
+	assert.Equal(t, uint64(1), received.ID)
+	mocks.MQueueMessageRepository.AssertNumberOfCalls(t, "Create", 3)
+}
+
+func Test_Queue_Push_NotLeading(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(nil)
+
+	pq.Push(&queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer})
+
+	mocks.MQueueMessageRepository.AssertCalled(t, "Create", mock.Anything)
+	assert.Len(t, pq.Channel(), 0)
+}
+
+func Test_Queue_Push_LeadershipLost(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	pq.Redeliver(ctx)
+
+	pushed := make(chan struct{})
+	go func() {
+		pq.Push(&queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer})
+		close(pushed)
+	}()
+	cancel()
+
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("push blocked after the leadership was lost")
+	}
+}
+
+func Test_Queue_Ack(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+	mocks.MQueueMessageRepository.On("Delete", uint64(1)).Return(nil)
+
+	pq.Ack(&queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer, ID: 1})
+
+	mocks.MQueueMessageRepository.AssertCalled(t, "Delete", uint64(1))
+}
+
+func Test_Queue_Ack_NotPersisted(t *testing.T) {
+	pq := setupQueue([]*entity.QueueMessage{})
+
+	pq.Ack(&queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer})
+
+	mocks.MQueueMessageRepository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func Test_Queue_Redeliver(t *testing.T) {
+	payloadType, data, _ := EncodePayload(transferPayload)
+	pq := setupQueue([]*entity.QueueMessage{
+		{ID: 1, Topic: constants.HederaMintHtsTransfer, PayloadType: "unsupported"},
+		{ID: 2, Topic: constants.HederaMintHtsTransfer, PayloadType: payloadType, Payload: data, DeadLetterID: 3},
+	})
+
+	go pq.Redeliver(context.Background())
+	received := <-pq.Channel()
+
+	assert.Equal(t, uint64(2), received.ID)
+	assert.Equal(t, uint64(3), received.DeadLetterID)
+	assert.Equal(t, constants.HederaMintHtsTransfer, received.Topic)
+	assert.Equal(t, transferPayload, received.Payload)
+}
+
+func Test_Queue_Redeliver_PollsForMessagesStoredByStandby(t *testing.T) {
+	payloadType, data, _ := EncodePayload(transferPayload)
+	mocks.Setup()
+	mocks.MQueueMessageRepository.On("GetAll").Return([]*entity.QueueMessage{}, nil).Once()
+	mocks.MQueueMessageRepository.On("GetAll").Return([]*entity.QueueMessage{
+		{ID: 3, Topic: constants.HederaMintHtsTransfer, PayloadType: payloadType, Payload: data},
+	}, nil)
+	pq := NewQueue(mocks.MQueueMessageRepository)
+	pq.pollingInterval = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pq.Redeliver(ctx)
+	received := <-pq.Channel()
+
+	assert.Equal(t, uint64(3), received.ID)
+	select {
+	case duplicate := <-pq.Channel():
+		t.Fatalf("message [%d] delivered twice", duplicate.ID)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func Test_Queue_Push_NotDeliveredTwiceByPolling(t *testing.T) {
+	payloadType, data, _ := EncodePayload(transferPayload)
+	mocks.Setup()
+	mocks.MQueueMessageRepository.On("GetAll").Return([]*entity.QueueMessage{}, nil).Once()
+	mocks.MQueueMessageRepository.On("GetAll").Return([]*entity.QueueMessage{
+		{ID: 1, Topic: constants.HederaMintHtsTransfer, PayloadType: payloadType, Payload: data},
+	}, nil)
+	mocks.MQueueMessageRepository.On("Create", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.QueueMessage).ID = 1
+	})
+	pq := NewQueue(mocks.MQueueMessageRepository)
+	pq.pollingInterval = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pq.Redeliver(ctx)
+
+	go pq.Push(&queue.Message{Payload: transferPayload, Topic: constants.HederaMintHtsTransfer})
+	received := <-pq.Channel()
+
+	assert.Equal(t, uint64(1), received.ID)
+	select {
+	case duplicate := <-pq.Channel():
+		t.Fatalf("message [%d] delivered twice", duplicate.ID)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func Test_Queue_NotRedeliveredUntilRequested(t *testing.T) {
+	setupQueue([]*entity.QueueMessage{})
+
+	mocks.MQueueMessageRepository.AssertNotCalled(t, "GetAll")
+}
+
+func Test_Queue_Redeliver_StopsOnCancel(t *testing.T) {
+	payloadType, data, _ := EncodePayload(transferPayload)
+	pq := setupQueue([]*entity.QueueMessage{
+		{ID: 1, Topic: constants.HederaMintHtsTransfer, PayloadType: payloadType, Payload: data},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		pq.Redeliver(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("redelivery did not stop on cancelled context")
+	}
+}
@@ -16,9 +16,15 @@
 
 package queue
 
+import "context"
+
 type Message struct {
 	Payload interface{}
 	Topic   string
+	// ID of the persisted message. Zero for messages which are not persisted
+	ID uint64
+	// ID of the dead letter the message is replayed from. Zero for messages which are not replayed
+	DeadLetterID uint64
 }
 
 // Queue is a wrapper of a go channel, particularly to restrict actions on the channel itself
@@ -31,6 +37,12 @@ func (q *Queue) Push(message *Message) {
 	q.channel <- message
 }
 
+// Ack is a no-op, given that in-memory messages are not redelivered
+func (q *Queue) Ack(message *Message) {}
+
+// Redeliver is a no-op, given that in-memory messages are not redelivered
+func (q *Queue) Redeliver(ctx context.Context) {}
+
 func (q *Queue) Channel() chan *Message {
 	return q.channel
 }
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// workerPool handles the messages of a single topic with a bounded number of workers.
+// Messages are buffered per topic, so that a saturated topic does not hold back the others.
+// Once all workers are busy and the buffer is full, dispatching blocks, which in turn
+// blocks the queue and slows down the watchers pushing to it.
+// Messages which are not picked up by a worker before the pool is stopped are left
+// unacknowledged, so that they are redelivered on the next start.
+type workerPool struct {
+	topic       string
+	handler     Handler
+	workers     int
+	messages    chan *q.Message
+	ack         func(message *q.Message)
+	deadLetters service.DeadLetters
+	queueDepth  prometheus.Gauge
+	inFlight    prometheus.Gauge
+	duration    prometheus.Histogram
+	wg          sync.WaitGroup
+	logger      *log.Entry
+}
+
+func newWorkerPool(topic string, handler Handler, workers, buffer int, ack func(message *q.Message), deadLetters service.DeadLetters, prometheusService service.Prometheus, logger *log.Entry) *workerPool {
+	name := strings.ToLower(topic)
+	labels := prometheus.Labels{constants.HandlerTopicMetricLabelKey: topic}
+
+	pool := &workerPool{
+		topic:       topic,
+		handler:     handler,
+		workers:     workers,
+		messages:    make(chan *q.Message, buffer),
+		ack:         ack,
+		deadLetters: deadLetters,
+		logger:      logger,
+	}
+
+	if prometheusService != nil && prometheusService.GetIsMonitoringEnabled() {
+		pool.queueDepth = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name:        fmt.Sprintf(constants.HandlerQueueDepthGaugeNameFormat, name),
+			Help:        constants.HandlerQueueDepthGaugeHelp,
+			ConstLabels: labels,
+		})
+		pool.inFlight = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name:        fmt.Sprintf(constants.HandlerInFlightGaugeNameFormat, name),
+			Help:        constants.HandlerInFlightGaugeHelp,
+			ConstLabels: labels,
+		})
+		pool.duration = prometheusService.CreateHistogramIfNotExists(prometheus.HistogramOpts{
+			Name:        fmt.Sprintf(constants.HandlerDurationHistogramNameFormat, name),
+			Help:        constants.HandlerDurationHistogramHelp,
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		})
+	}
+
+	return pool
+}
+
+// start spawns the workers of the pool. Workers stop picking up new messages once the stop context
+// is cancelled, while the handler context is passed to the handlers of the picked up messages
+func (p *workerPool) start(stop, handlerCtx context.Context) {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.work(stop, handlerCtx)
+	}
+}
+
+// wait blocks until all workers of the pool have stopped
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
+
+// dispatch hands the message over to the pool, blocking while the pool is saturated
+// or until the context is cancelled
+func (p *workerPool) dispatch(ctx context.Context, message *q.Message) {
+	p.addQueueDepth(1)
+	select {
+	case p.messages <- message:
+	default:
+		p.logger.Warnf("[%s] - Handler pool with [%d] workers and a buffer of [%d] messages is saturated. Waiting for a free worker.", p.topic, p.workers, cap(p.messages))
+		select {
+		case p.messages <- message:
+		case <-ctx.Done():
+			p.addQueueDepth(-1)
+		}
+	}
+}
+
+func (p *workerPool) work(stop, handlerCtx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-stop.Done():
+			return
+		case message := <-p.messages:
+			p.addQueueDepth(-1)
+			if stop.Err() != nil {
+				return
+			}
+			p.handle(handlerCtx, message)
+		}
+	}
+}
+
+// handle executes the handler for the message and acknowledges the message once it is handled.
+// Messages whose handler failed are captured as dead letters, while messages whose handler
+// got cancelled are not acknowledged
+func (p *workerPool) handle(ctx context.Context, message *q.Message) {
+	p.addInFlight(1)
+	start := time.Now()
+
+	err := p.handler.Handle(ctx, message.Payload)
+
+	if p.duration != nil {
+		p.duration.Observe(time.Since(start).Seconds())
+	}
+	p.addInFlight(-1)
+
+	if ctx.Err() != nil {
+		p.logger.Warnf("[%s] - Handler got cancelled. Leaving message unacknowledged for redelivery.", p.topic)
+		return
+	}
+
+	if err != nil {
+		p.logger.Errorf("[%s] - Handler failed. Error: [%s]", p.topic, err)
+		p.deadLetters.Capture(message, err)
+	} else {
+		p.deadLetters.Resolve(message)
+	}
+	p.ack(message)
+}
+
+func (p *workerPool) addInFlight(value float64) {
+	if p.inFlight != nil {
+		p.inFlight.Add(value)
+	}
+}
+
+func (p *workerPool) addQueueDepth(value float64) {
+	if p.queueDepth != nil {
+		p.queueDepth.Add(value)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// blockingHandler tracks the max number of concurrent Handle calls, until it gets released
+type blockingHandler struct {
+	mu       sync.Mutex
+	current  int
+	max      int
+	started  chan struct{}
+	released chan struct{}
+}
+
+func (h *blockingHandler) Handle(context.Context, interface{}) error {
+	h.mu.Lock()
+	h.current++
+	if h.current > h.max {
+		h.max = h.current
+	}
+	h.mu.Unlock()
+
+	h.started <- struct{}{}
+	<-h.released
+
+	h.mu.Lock()
+	h.current--
+	h.mu.Unlock()
+
+	return nil
+}
+
+func Test_WorkerPool_BoundsConcurrentHandlers(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MDeadLettersService.On("Resolve", mock.Anything).Return()
+	handler := &blockingHandler{started: make(chan struct{}), released: make(chan struct{})}
+	acked := make(chan *q.Message, 10)
+	pool := newWorkerPool(handlerTopic, handler, 2, 2, func(m *q.Message) { acked <- m }, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+	pool.start(context.Background(), context.Background())
+
+	go func() {
+		for i := 0; i < 5; i++ {
+			pool.dispatch(context.Background(), &q.Message{Payload: i, Topic: handlerTopic})
+		}
+	}()
+
+	<-handler.started
+	<-handler.started
+	select {
+	case <-handler.started:
+		t.Fatal("more handlers than workers were started")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	go func() {
+		for range handler.started {
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		handler.released <- struct{}{}
+	}
+	for i := 0; i < 5; i++ {
+		<-acked
+	}
+
+	assert.Equal(t, 2, handler.max)
+}
+
+func Test_WorkerPool_Metrics(t *testing.T) {
+	mocks.Setup()
+	queueDepth := prometheus.NewGauge(prometheus.GaugeOpts{Name: "queue_depth"})
+	inFlight := prometheus.NewGauge(prometheus.GaugeOpts{Name: "in_flight"})
+	duration := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "duration"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == "handler_topic_msg_submission_queue_depth"
+	})).Return(queueDepth)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == "handler_topic_msg_submission_in_flight"
+	})).Return(inFlight)
+	mocks.MPrometheusService.On("CreateHistogramIfNotExists", mock.Anything).Return(duration)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Return(nil)
+	mocks.MDeadLettersService.On("Resolve", message).Return()
+	acked := make(chan *q.Message)
+	pool := newWorkerPool(handlerTopic, mocks.MHandler, 1, 1, func(m *q.Message) { acked <- m }, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+
+	pool.dispatch(context.Background(), message)
+	assert.Equal(t, float64(1), testutil.ToFloat64(queueDepth))
+
+	pool.start(context.Background(), context.Background())
+	<-acked
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(queueDepth))
+	assert.Equal(t, float64(0), testutil.ToFloat64(inFlight))
+	mocks.MPrometheusService.AssertCalled(t, "CreateHistogramIfNotExists", mock.MatchedBy(func(opts prometheus.HistogramOpts) bool {
+		return opts.Name == "handler_topic_msg_submission_duration_seconds"
+	}))
+}
+
+func Test_WorkerPool_Stop(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	stop, cancel := context.WithCancel(context.Background())
+	pool := newWorkerPool(handlerTopic, mocks.MHandler, 2, 2, func(m *q.Message) {}, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+	pool.start(stop, context.Background())
+
+	cancel()
+	pool.wait()
+	pool.dispatch(stop, &q.Message{Payload: "payload", Topic: handlerTopic})
+
+	mocks.MHandler.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+}
+
+func Test_WorkerPool_CancelledHandlerIsNotAcked(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	handlerCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	mocks.MHandler.On("Handle", handlerCtx, message.Payload).Return(nil)
+	acked := false
+	pool := newWorkerPool(handlerTopic, mocks.MHandler, 1, 1, func(m *q.Message) { acked = true }, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+
+	pool.handle(handlerCtx, message)
+
+	mocks.MHandler.AssertCalled(t, "Handle", handlerCtx, message.Payload)
+	assert.False(t, acked)
+}
+
+func Test_WorkerPool_FailedHandlerIsCaptured(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	handlerErr := errors.New("some-error")
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Return(handlerErr)
+	mocks.MDeadLettersService.On("Capture", message, handlerErr).Return()
+	acked := false
+	pool := newWorkerPool(handlerTopic, mocks.MHandler, 1, 1, func(m *q.Message) { acked = true }, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+
+	pool.handle(context.Background(), message)
+
+	mocks.MDeadLettersService.AssertCalled(t, "Capture", message, handlerErr)
+	mocks.MDeadLettersService.AssertNotCalled(t, "Resolve", message)
+	assert.True(t, acked)
+}
+
+func Test_WorkerPool_ReplayedMessageIsResolved(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic, DeadLetterID: 1}
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Return(nil)
+	mocks.MDeadLettersService.On("Resolve", message).Return()
+	pool := newWorkerPool(handlerTopic, mocks.MHandler, 1, 1, func(m *q.Message) {}, mocks.MDeadLettersService, mocks.MPrometheusService, config.GetLoggerFor("Server"))
+
+	pool.handle(context.Background(), message)
+
+	mocks.MDeadLettersService.AssertCalled(t, "Resolve", message)
+	mocks.MDeadLettersService.AssertNotCalled(t, "Capture", mock.Anything, mock.Anything)
+}
@@ -17,34 +17,65 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/go-chi/chi"
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
-	"net/http"
 )
 
+const (
+	httpShutdownTimeout = 5 * time.Second
+	// cancelledHandlersTimeout bounds the wait for the cancelled handlers to return, before the leadership is resigned
+	cancelledHandlersTimeout = 5 * time.Second
+)
+
+var ErrLeadershipLost = errors.New("leadership lost")
+
 type Watcher interface {
-	Watch(queue queue.Queue)
+	// Watch starts watching for new events, pushing them into the queue until the context is cancelled
+	Watch(ctx context.Context, queue queue.Queue)
 }
 
 type Handler interface {
-	Handle(interface{})
+	// Handle processes the payload. The context is cancelled if the handler does not complete during shutdown.
+	// Payloads which fail with an error are captured as dead letters
+	Handle(ctx context.Context, payload interface{}) error
 }
 
 type Server struct {
-	logger   *log.Entry
-	watchers []Watcher
-	handlers map[string]Handler
-	queue    queue.Queue
+	logger            *log.Entry
+	watchers          []Watcher
+	handlers          map[string]Handler
+	pools             map[string]*workerPool
+	queue             queue.Queue
+	handlersConfig    config.Handlers
+	prometheusService service.Prometheus
+	deadLetters       service.DeadLetters
+	leader            service.Leader
+	leaderTasks       []func(ctx context.Context)
 }
 
-func NewServer() *Server {
+func NewServer(queue queue.Queue, handlersConfig config.Handlers, prometheusService service.Prometheus, deadLetters service.DeadLetters, leader service.Leader) *Server {
 	return &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    q.NewQueue(),
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		pools:             make(map[string]*workerPool),
+		queue:             queue,
+		handlersConfig:    handlersConfig,
+		prometheusService: prometheusService,
+		deadLetters:       deadLetters,
+		leader:            leader,
 	}
 }
 
@@ -56,17 +87,144 @@ func (s *Server) AddHandler(topic string, handler Handler) {
 	s.handlers[topic] = handler
 }
 
-// Run starts every handler and watcher, serving the chi.Mux on a given port
+// AddLeaderTask registers a task, which is executed once the node becomes the leader,
+// before the watchers and handlers are started. The task gets the leader context,
+// which is cancelled once the node stops leading
+func (s *Server) AddLeaderTask(task func(ctx context.Context)) {
+	s.leaderTasks = append(s.leaderTasks, task)
+}
+
+// Run serves the chi.Mux on a given port until SIGINT or SIGTERM is received. Every handler and watcher
+// is started once the node becomes the leader. A node which loses the leadership exits,
+// so that it gets restarted as a standby replica
 func (s *Server) Run(chi *chi.Mux, port string) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := s.serve(ctx, &http.Server{Addr: port, Handler: chi})
+	if err != nil {
+		s.logger.Fatalf("Server stopped. Error: [%s]", err)
+	}
+}
+
+// serve runs the HTTP server until the context is cancelled or the leadership is lost.
+// Returns ErrLeadershipLost if the node stopped leading before the context got cancelled
+func (s *Server) serve(ctx context.Context, httpServer *http.Server) error {
 	go func() {
-		for message := range s.queue.Channel() {
-			go s.handlers[message.Topic].Handle(message.Payload)
+		s.logger.Infof("Listening on port [%s]", httpServer.Addr)
+		err := httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Fatal(err)
 		}
 	}()
 
+	var err error
+	leaderCtx, campaignErr := s.leader.Campaign(ctx)
+	if campaignErr == nil {
+		err = s.lead(ctx, leaderCtx)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	shutdownErr := httpServer.Shutdown(shutdownCtx)
+	if shutdownErr != nil {
+		s.logger.Errorf("Failed to shut down HTTP server. Error: [%s]", shutdownErr)
+	}
+	s.logger.Infof("Server stopped.")
+	return err
+}
+
+// lead runs the leader tasks, the handlers and the watchers until the leader context is cancelled.
+// On shutdown the watchers are stopped first, then the in-flight handlers are drained up to the configured timeout,
+// after which they are cancelled and awaited once more, so that the leadership is not resigned while they still run.
+// If the leadership is lost instead, the in-flight handlers are cancelled right away, as another replica takes over
+func (s *Server) lead(ctx, leaderCtx context.Context) error {
+	defer s.leader.Resign()
+
+	handlerCtx, cancelHandlers := context.WithCancel(context.Background())
+	defer cancelHandlers()
+
+	for _, task := range s.leaderTasks {
+		task(leaderCtx)
+	}
+
+	for topic, handler := range s.handlers {
+		workers := s.handlersConfig.WorkersFor(topic)
+		pool := newWorkerPool(topic, handler, workers, s.handlersConfig.Buffer, s.queue.Ack, s.deadLetters, s.prometheusService, s.logger)
+		pool.start(leaderCtx, handlerCtx)
+		s.pools[topic] = pool
+		s.logger.Debugf("Started [%d] workers for handler [%s]", workers, topic)
+	}
+
+	go s.dispatchMessages(leaderCtx)
+	// The unacknowledged messages are redelivered before the watchers start,
+	// so that the messages stored by the watchers are not delivered twice
+	s.queue.Redeliver(leaderCtx)
+
 	for _, watcher := range s.watchers {
-		go watcher.Watch(s.queue)
+		go watcher.Watch(leaderCtx, s.queue)
+	}
+
+	<-leaderCtx.Done()
+	if ctx.Err() == nil {
+		s.logger.Errorf("Lost leadership. Cancelling in-flight handlers.")
+		cancelHandlers()
+		s.drain(s.handlersConfig.ShutdownTimeout)
+		return ErrLeadershipLost
+	}
+
+	s.logger.Infof("Shutting down. Waiting up to [%s] for in-flight handlers to complete.", s.handlersConfig.ShutdownTimeout)
+	if !s.drain(s.handlersConfig.ShutdownTimeout) {
+		s.logger.Warnf("In-flight handlers did not complete in [%s]. Cancelling them.", s.handlersConfig.ShutdownTimeout)
+		cancelHandlers()
+		if !s.drain(cancelledHandlersTimeout) {
+			s.logger.Errorf("Cancelled handlers did not return in [%s]. Resigning the leadership regardless.", cancelledHandlersTimeout)
+		}
+	}
+	return nil
+}
+
+// dispatchMessages reads the queue until the context is cancelled
+func (s *Server) dispatchMessages(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message := <-s.queue.Channel():
+			s.dispatch(ctx, message)
+		}
+	}
+}
+
+// dispatch hands the message over to the worker pool of its topic.
+// Messages of topics without a handler are captured as dead letters
+func (s *Server) dispatch(ctx context.Context, message *q.Message) {
+	pool, ok := s.pools[message.Topic]
+	if !ok {
+		s.logger.Errorf("No handler registered for topic [%s]", message.Topic)
+		s.deadLetters.Capture(message, fmt.Errorf("no handler registered for topic [%s]", message.Topic))
+		s.queue.Ack(message)
+		return
+	}
+
+	pool.dispatch(ctx, message)
+}
+
+// drain waits for the workers of all pools to complete their in-flight messages.
+// Returns false if they did not complete within the given timeout
+func (s *Server) drain(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		for _, pool := range s.pools {
+			pool.wait()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
 	}
-	s.logger.Infof("Listening on port [%s]", port)
-	s.logger.Fatal(http.ListenAndServe(port, chi))
 }
@@ -17,30 +17,33 @@
 package server
 
 import (
+	"context"
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
 	"testing"
+	"time"
 )
 
 var (
-	server        *Server
-	queueInstance queue.Queue
-	handlerTopic  = constants.TopicMessageSubmission
-	port          = ":8000"
+	server         *Server
+	queueInstance  queue.Queue
+	handlerTopic   = constants.TopicMessageSubmission
+	port           = ":8000"
+	handlersConfig = config.Handlers{Workers: 2, TopicWorkers: map[string]int{}}
 )
 
 func Test_NewServer(t *testing.T) {
 	setup()
 
-	actualServer := NewServer()
+	actualServer := NewServer(queueInstance, handlersConfig, mocks.MPrometheusService, mocks.MDeadLettersService, mocks.MLeaderService)
 
-	assert.Equal(t, server.logger, actualServer.logger)
-	assert.Equal(t, server.handlers, actualServer.handlers)
-	assert.Equal(t, server.watchers, actualServer.watchers)
+	assert.Equal(t, server, actualServer)
 }
 
 func Test_AddWatcher(t *testing.T) {
@@ -61,13 +64,284 @@ func Test_AddHandler(t *testing.T) {
 	assert.Equal(t, server.handlers[handlerTopic], mocks.MHandler)
 }
 
+func Test_Dispatch(t *testing.T) {
+	setup()
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	acked := make(chan *q.Message)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Return(nil)
+	mocks.MDeadLettersService.On("Resolve", message).Return()
+	server.pools[handlerTopic] = newWorkerPool(handlerTopic, mocks.MHandler, 1, 1, func(m *q.Message) { acked <- m }, mocks.MDeadLettersService, mocks.MPrometheusService, server.logger)
+	server.pools[handlerTopic].start(context.Background(), context.Background())
+
+	server.dispatch(context.Background(), message)
+
+	assert.Equal(t, message, <-acked)
+	mocks.MHandler.AssertCalled(t, "Handle", mock.Anything, message.Payload)
+}
+
+func Test_Dispatch_NoHandler(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	mocks.MQueue.On("Ack", message).Return()
+	mocks.MDeadLettersService.On("Capture", message, mock.Anything).Return()
+
+	server.dispatch(context.Background(), message)
+
+	mocks.MDeadLettersService.AssertCalled(t, "Capture", message, mock.Anything)
+	mocks.MQueue.AssertCalled(t, "Ack", message)
+}
+
+func Test_Dispatch_SaturatedTopicDoesNotBlockOthers(t *testing.T) {
+	setup()
+	otherTopic := "other-topic"
+	slowHandler := &blockingHandler{started: make(chan struct{}, 4), released: make(chan struct{})}
+	defer close(slowHandler.released)
+	acked := make(chan *q.Message, 1)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MHandler.On("Handle", mock.Anything, "other-payload").Return(nil)
+	mocks.MDeadLettersService.On("Resolve", mock.Anything).Return()
+	server.pools[handlerTopic] = newWorkerPool(handlerTopic, slowHandler, 1, 3, func(m *q.Message) {}, mocks.MDeadLettersService, mocks.MPrometheusService, server.logger)
+	server.pools[handlerTopic].start(context.Background(), context.Background())
+	server.pools[otherTopic] = newWorkerPool(otherTopic, mocks.MHandler, 1, 1, func(m *q.Message) { acked <- m }, mocks.MDeadLettersService, mocks.MPrometheusService, server.logger)
+	server.pools[otherTopic].start(context.Background(), context.Background())
+
+	for i := 0; i < 4; i++ {
+		server.dispatch(context.Background(), &q.Message{Payload: "payload", Topic: handlerTopic})
+	}
+	message := &q.Message{Payload: "other-payload", Topic: otherTopic}
+	server.dispatch(context.Background(), message)
+
+	assert.Equal(t, message, <-acked)
+}
+
+func Test_Serve_DrainsHandlersOnShutdown(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.handlersConfig.ShutdownTimeout = time.Minute
+	messages := make(chan *q.Message, 1)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	messages <- message
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Channel").Return(messages)
+	mocks.MQueue.On("Ack", message).Return()
+	mocks.MDeadLettersService.On("Resolve", message).Return()
+	mocks.MWatcher.On("Watch", mock.Anything, mocks.MQueue).Return()
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Run(func(args mock.Arguments) {
+		close(started)
+		<-release
+	}).Return(nil)
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	server.AddWatcher(mocks.MWatcher)
+	leaderTasks := 0
+	server.AddLeaderTask(func(ctx context.Context) { leaderTasks++ })
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MLeaderService.On("Campaign", ctx).Return(ctx, nil)
+	mocks.MLeaderService.On("Resign").Return()
+	mocks.MQueue.On("Redeliver", ctx).Return()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+		close(stopped)
+	}()
+	<-started
+	cancel()
+
+	select {
+	case <-stopped:
+		t.Fatal("server stopped before the in-flight handler completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-stopped
+	assert.Equal(t, 1, leaderTasks)
+	mocks.MQueue.AssertCalled(t, "Ack", message)
+	mocks.MWatcher.AssertCalled(t, "Watch", mock.Anything, mocks.MQueue)
+	mocks.MLeaderService.AssertCalled(t, "Resign")
+}
+
+func Test_Serve_CancelsHandlersAfterShutdownTimeout(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.handlersConfig.ShutdownTimeout = 10 * time.Millisecond
+	messages := make(chan *q.Message, 1)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	messages <- message
+	started := make(chan struct{})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Channel").Return(messages)
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Run(func(args mock.Arguments) {
+		close(started)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MLeaderService.On("Campaign", ctx).Return(ctx, nil)
+	mocks.MLeaderService.On("Resign").Return()
+	mocks.MQueue.On("Redeliver", ctx).Return()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+		close(stopped)
+	}()
+	<-started
+	cancel()
+
+	<-stopped
+	mocks.MQueue.AssertNotCalled(t, "Ack", message)
+}
+
+func Test_Serve_WaitsForCancelledHandlersBeforeResigning(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.handlersConfig.ShutdownTimeout = 10 * time.Millisecond
+	messages := make(chan *q.Message, 1)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	messages <- message
+	started := make(chan struct{})
+	returned := make(chan struct{})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Channel").Return(messages)
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Run(func(args mock.Arguments) {
+		close(started)
+		<-args.Get(0).(context.Context).Done()
+		time.Sleep(50 * time.Millisecond)
+		close(returned)
+	}).Return(nil)
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MLeaderService.On("Campaign", ctx).Return(ctx, nil)
+	resignedAfterReturn := false
+	mocks.MLeaderService.On("Resign").Run(func(args mock.Arguments) {
+		select {
+		case <-returned:
+			resignedAfterReturn = true
+		default:
+		}
+	}).Return()
+	mocks.MQueue.On("Redeliver", ctx).Return()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+		close(stopped)
+	}()
+	<-started
+	cancel()
+
+	<-stopped
+	assert.True(t, resignedAfterReturn)
+}
+
+func Test_Serve_StandbyDoesNotStartWatchers(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.AddWatcher(mocks.MWatcher)
+	leaderTasks := 0
+	server.AddLeaderTask(func(ctx context.Context) { leaderTasks++ })
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MLeaderService.On("Campaign", ctx).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.Canceled)
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+	}()
+	cancel()
+
+	assert.Nil(t, <-stopped)
+	assert.Equal(t, 0, leaderTasks)
+	mocks.MWatcher.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Redeliver", mock.Anything)
+	mocks.MLeaderService.AssertNotCalled(t, "Resign")
+}
+
+func Test_Serve_RedeliversBeforeStartingWatchers(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.AddWatcher(mocks.MWatcher)
+	mocks.MQueue.On("Channel").Return(make(chan *q.Message))
+	redelivered := false
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MQueue.On("Redeliver", ctx).Run(func(args mock.Arguments) {
+		time.Sleep(10 * time.Millisecond)
+		redelivered = true
+	}).Return()
+	watched := make(chan bool, 1)
+	mocks.MWatcher.On("Watch", mock.Anything, mocks.MQueue).Run(func(args mock.Arguments) {
+		watched <- redelivered
+	}).Return()
+	mocks.MLeaderService.On("Campaign", ctx).Return(ctx, nil)
+	mocks.MLeaderService.On("Resign").Return()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+		close(stopped)
+	}()
+
+	assert.True(t, <-watched)
+	cancel()
+	<-stopped
+}
+
+func Test_Serve_CancelsHandlersOnLostLeadership(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.handlersConfig.ShutdownTimeout = time.Minute
+	messages := make(chan *q.Message, 1)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	messages <- message
+	started := make(chan struct{})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Channel").Return(messages)
+	mocks.MHandler.On("Handle", mock.Anything, message.Payload).Run(func(args mock.Arguments) {
+		close(started)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	ctx := context.Background()
+	leaderCtx, loseLeadership := context.WithCancel(ctx)
+	mocks.MLeaderService.On("Campaign", ctx).Return(leaderCtx, nil)
+	mocks.MLeaderService.On("Resign").Return()
+	mocks.MQueue.On("Redeliver", leaderCtx).Return()
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- server.serve(ctx, &http.Server{Addr: "127.0.0.1:0"})
+	}()
+	<-started
+	loseLeadership()
+
+	select {
+	case err := <-stopped:
+		assert.Equal(t, ErrLeadershipLost, err)
+	case <-time.After(time.Second):
+		t.Fatal("server did not stop on lost leadership")
+	}
+	mocks.MQueue.AssertNotCalled(t, "Ack", message)
+	mocks.MLeaderService.AssertCalled(t, "Resign")
+}
+
 func setup() {
 	mocks.Setup()
 	queueInstance = q.NewQueue()
 
 	server = &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    queueInstance,
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		pools:             make(map[string]*workerPool),
+		queue:             queueInstance,
+		handlersConfig:    handlersConfig,
+		prometheusService: mocks.MPrometheusService,
+		deadLetters:       mocks.MDeadLettersService,
+		leader:            mocks.MLeaderService,
 	}
 }
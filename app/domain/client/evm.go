@@ -41,7 +41,8 @@ type EVM interface {
 	// onError is called if an error occurs while waiting for TX to go into one of the other 2 states
 	WaitForTransactionCallback(hex string, onSuccess, onRevert func(), onError func(err error))
 	// WaitForConfirmations starts a loop which ends either when we reach the target block number or an error occurs with block number retrieval
-	WaitForConfirmations(raw types.Log) error
+	// or the context is cancelled
+	WaitForConfirmations(ctx context.Context, raw types.Log) error
 	// GetPrivateKey retrieves private key used for the specific EVM Client
 	GetPrivateKey() string
 	BlockConfirmations() uint64
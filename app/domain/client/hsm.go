@@ -0,0 +1,29 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import "crypto"
+
+// Hsm signs with keys kept in a PKCS#11 token, which are referenced by their labels
+type Hsm interface {
+	// SignECDSA signs the digest with the secp256k1 key, returning the concatenated `r` and `s` of the signature
+	SignECDSA(keyLabel string, digest []byte) ([]byte, error)
+	// SignEdDSA signs the message with the Ed25519 key
+	SignEdDSA(keyLabel string, message []byte) ([]byte, error)
+	// PublicKey returns the public key of the key pair, either *ecdsa.PublicKey or ed25519.PublicKey
+	PublicKey(keyLabel string) (crypto.PublicKey, error)
+}
@@ -17,9 +17,12 @@
 package client
 
 import (
+	"context"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/shopspring/decimal"
@@ -30,29 +33,39 @@ type MirrorNode interface {
 	GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error)
 	// GetAccountTokenMintTransactionsAfterTimestamp queries the hedera mirror node for transactions on a certain account with type TokenMint
 	GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error)
+	// IterateAccountTokenMintTransactionsAfterTimestampString returns an iterator over all transactions on a certain account with type TokenMint, following the `links.next` cursor
+	IterateAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction]
 	// GetAccountTokenBurnTransactionsAfterTimestampString queries the hedera mirror node for transactions on a certain account with type TokenBurn
 	GetAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error)
 	// GetAccountTokenBurnTransactionsAfterTimestamp queries the hedera mirror node for transactions on a certain account with type TokenBurn
 	GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error)
+	// IterateAccountTokenBurnTransactionsAfterTimestampString returns an iterator over all transactions on a certain account with type TokenBurn, following the `links.next` cursor
+	IterateAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction]
 	// GetAccountDebitTransactionsAfterTimestampString queries the hedera mirror node for transactions that are debit and after a given timestamp
 	GetAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error)
+	// IterateAccountDebitTransactionsAfterTimestampString returns an iterator over all debit transactions after a given timestamp, following the `links.next` cursor
+	IterateAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction]
 	// GetAccountCreditTransactionsAfterTimestampString returns all transaction after a given timestamp
 	GetAccountCreditTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error)
 	// GetAccountCreditTransactionsAfterTimestamp returns all transaction after a given timestamp
 	GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error)
+	// IterateAccountCreditTransactionsAfterTimestamp returns an iterator over all transactions after a given timestamp, following the `links.next` cursor
+	IterateAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) *pagination.Pages[transaction.Transaction]
 	// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
 	GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]transaction.Transaction, error)
 	// GetTransactionsAfterTimestamp returns all transaction after a given timestamp for the specified account and transaction type
 	GetTransactionsAfterTimestamp(accountId hedera.AccountID, startTimestamp int64, transactionType string) ([]transaction.Transaction, error)
 	// GetMessagesAfterTimestamp returns all topic messages after the given timestamp
 	GetMessagesAfterTimestamp(topicId hedera.TopicID, from int64, limit int64) ([]message.Message, error)
+	// IterateMessagesAfterTimestamp returns an iterator over all topic messages after the given timestamp, following the `links.next` cursor
+	IterateMessagesAfterTimestamp(topicId hedera.TopicID, from int64, limit int64) *pagination.Pages[message.Message]
 	// GetMessageBySequenceNumber returns message from given topic with provided sequence number
 	GetMessageBySequenceNumber(topicId hedera.TopicID, sequenceNumber int64) (*message.Message, error)
 	// GetLatestMessages returns latest Topic messages
 	GetLatestMessages(topicId hedera.TopicID, limit int64) ([]message.Message, error)
 	// GetMessagesForTopicBetween returns all topic messages for a given topic between timestamp `from` included and `to` excluded
 	GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]message.Message, error)
-	// GetNftTransactions returns the nft transactions for tokenID and serialNum
+	// GetNftTransactions returns all the nft transactions for tokenID and serialNum
 	GetNftTransactions(tokenID string, serialNum int64) (transaction.NftTransactionsResponse, error)
 	// GetScheduledTransaction gets the Scheduled transaction of an executed transaction
 	GetScheduledTransaction(transactionID string) (*transaction.Response, error)
@@ -78,8 +91,9 @@ type MirrorNode interface {
 	// TopicExists sends a query to check whether a specific topic exists. If the query returns a status != 200, the function returns a false value
 	TopicExists(topicID hedera.TopicID) bool
 	// WaitForTransaction Polls the transaction at intervals. Depending on the
-	// result, the corresponding `onSuccess` and `onFailure` functions are called
-	WaitForTransaction(txId string, onSuccess, onFailure func())
+	// result, the corresponding `onSuccess` and `onFailure` functions are called.
+	// Polling stops without calling either of them once the context is cancelled
+	WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func())
 	// WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
 	// result, the corresponding `onSuccess` and `onFailure` functions are called
 	WaitForScheduledTransaction(txId string, onSuccess, onFailure func())
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
@@ -43,4 +45,7 @@ type HederaNode interface {
 	SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// TransactionReceiptQuery returns the receipt for a given transaction ID
 	TransactionReceiptQuery(transactionID hedera.TransactionID, nodeAccIds []hedera.AccountID) (hedera.TransactionReceipt, error)
+	// SubscribeToTopic streams the messages of the given topic from the mirror node gRPC API, starting from the given
+	// consensus timestamp. onError is called once the subscription fails and is no longer retried
+	SubscribeToTopic(topicId hedera.TopicID, startTime time.Time, onNext func(hedera.TopicMessage), onError func(error)) (hedera.SubscriptionHandle, error)
 }
@@ -17,10 +17,17 @@
 package queue
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 )
 
 type Queue interface {
 	Push(message *queue.Message)
+	// Ack marks the message as handled, so that it is not redelivered
+	Ack(message *queue.Message)
+	// Redeliver pushes the messages, which were not acknowledged, and keeps delivering the ones
+	// stored by the other replicas until the context is cancelled
+	Redeliver(ctx context.Context)
 	Channel() chan *queue.Message
 }
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import "context"
+
+// Lock is a lock shared between the replicas of the node, which are using the same database
+type Lock interface {
+	// TryAcquire attempts to acquire the lock without blocking. Returns whether the lock got acquired
+	TryAcquire(ctx context.Context) (bool, error)
+	// Verify returns an error if the acquired lock is no longer held
+	Verify(ctx context.Context) error
+	// Release releases the acquired lock
+	Release(ctx context.Context) error
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+type Peer interface {
+	CreateRejection(rejection *entity.PeerRejection) error
+	// Returns the rejections, created at or after the given time, ordered by creation time descending
+	GetRejectionsSince(since time.Time) ([]*entity.PeerRejection, error)
+	// Returns the signing statistics of each signer for the transfers with timestamp at or after the given one
+	GetSigningStatsSince(since int64) ([]*entity.PeerSigningStats, error)
+	// Returns the number of transfers to the given chain with timestamp within [from, to],
+	// which were not signed by the given signer. Held and reorged transfers are not counted
+	CountMissed(signer string, targetChainId uint64, from, to int64) (int64, error)
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+type PolicyDecision interface {
+	Create(decision *entity.PolicyDecision) error
+	// Returns PolicyDecision. Returns nil if not found
+	Get(transferID string) (*entity.PolicyDecision, error)
+	// Returns the decisions which allowed a transfer to be signed, created at or after the given time
+	GetAllowedSince(since time.Time) ([]*entity.PolicyDecision, error)
+	// Returns the decisions which held a transfer, ordered by creation time descending
+	GetHeld() ([]*entity.PolicyDecision, error)
+}
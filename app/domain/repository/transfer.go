@@ -33,5 +33,11 @@ type Transfer interface {
 	Create(ct *payload.Transfer) (*entity.Transfer, error)
 	UpdateStatusCompleted(txId string) error
 	UpdateStatusFailed(txId string) error
+	UpdateStatusReorged(txId string) error
+	UpdateStatusHeld(txId string) error
+	// Returns the Transfers from the given source chain with a timestamp greater than or equal to the given one
+	GetBySourceChainFromTimestamp(sourceChainId uint64, timestamp int64) ([]*entity.Transfer, error)
+	// Returns the fungible Transfers in INITIAL status, which have collected signatures, with preloaded Messages
+	GetUncompletedSigned() ([]*entity.Transfer, error)
 	Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error)
 }
@@ -24,7 +24,7 @@ import (
 type BurnEvent interface {
 	// ProcessEvent processes the burn event by submitting the appropriate
 	// scheduled transaction, leaving the synchronization of the actual transfer on HCS
-	ProcessEvent(transfer payload.Transfer)
+	ProcessEvent(transfer payload.Transfer) error
 	// TransactionID returns the corresponding Scheduled Transaction paying out the
 	// fees to validators and the amount being bridged to the receiver address
 	TransactionID(id string) (string, error)
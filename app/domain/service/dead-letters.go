@@ -0,0 +1,37 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	deadLetterModel "github.com/limechain/hedera-eth-bridge-validator/app/model/dead-letter"
+)
+
+// DeadLetters stores the payloads which handlers failed to process, allowing them to be inspected and replayed
+type DeadLetters interface {
+	// Capture stores the failed message together with its error. Failures of replayed messages
+	// increment the attempts of the dead letter they are replayed from
+	Capture(message *queue.Message, err error)
+	// Resolve removes the dead letter, which the successfully handled message was replayed from
+	Resolve(message *queue.Message)
+	// DeadLetters returns all stored dead letters
+	DeadLetters() ([]*deadLetterModel.DeadLetter, error)
+	// DeadLetter returns the dead letter with the given ID. Returns ErrNotFound if it does not exist
+	DeadLetter(id uint64) (*deadLetterModel.DeadLetter, error)
+	// Replay re-enqueues the payload of the dead letter onto its original handler topic
+	Replay(id uint64) error
+}
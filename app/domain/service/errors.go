@@ -25,3 +25,8 @@ var ErrNotFound = errors.New("not found")
 var ErrBadRequestTransferTargetNetworkNoSignaturesRequired = errors.New("transfer target network does not require signatures")
 var ErrWrongQuery = errors.New("wrong query parameter")
 var ErrTooManyRetires = fmt.Errorf("too many retries")
+var ErrTransferHeld = errors.New("transfer held by the signing policy")
+var ErrMajorityNotReached = errors.New("transfer signatures have not reached super majority")
+var ErrDoubleSign = errors.New("authorisation message differs from the one already signed for the transfer")
+var ErrUnknownTransfer = errors.New("unknown transfer")
+var ErrInvalidSignature = errors.New("invalid signature")
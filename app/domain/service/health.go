@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+)
+
+// Health aggregates the heartbeats of the watchers and the outcome of the client calls
+type Health interface {
+	// RegisterWatcher starts tracking the watcher, which is expected to complete an iteration on every interval
+	RegisterWatcher(watcher string, interval time.Duration)
+	// Heartbeat records a completed iteration of the watcher, together with its error if the iteration failed
+	Heartbeat(watcher string, err error)
+	// Progress records the position (timestamp or block number) up to which the watcher processed, and its lag behind the chain head
+	Progress(watcher string, position, lag int64)
+	// ReportCall records the outcome of a call made by the client
+	ReportCall(client string, err error)
+	// Liveness reports unhealthy if any of the watchers stopped completing its iterations
+	Liveness() *health.Status
+	// Readiness reports unhealthy if any of the watchers is failing or any of the clients exceeds the maximum error rate
+	Readiness() *health.Status
+}
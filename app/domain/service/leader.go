@@ -0,0 +1,28 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import "context"
+
+// Leader elects a single active node among the replicas sharing the same database
+type Leader interface {
+	// Campaign blocks until the node becomes the leader or the context is cancelled.
+	// The returned context is cancelled once the leadership is lost
+	Campaign(ctx context.Context) (context.Context, error)
+	// Resign gives up the leadership, so that a standby replica can take over
+	Resign()
+}
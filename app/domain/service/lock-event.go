@@ -24,5 +24,5 @@ import (
 type LockEvent interface {
 	// ProcessEvent processes the lock event by submitting the appropriate
 	// Scheduled Token Mint and Transfer transactions
-	ProcessEvent(event payload.Transfer)
+	ProcessEvent(event payload.Transfer) error
 }
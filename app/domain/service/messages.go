@@ -28,10 +28,12 @@ type Messages interface {
 	// SanityCheckNftSignature performs any validation required prior handling the topic message
 	// (verifies input data against the corresponding Transaction record)
 	SanityCheckNftSignature(tm *proto.TopicEthNftSignatureMessage) (bool, error)
-	// ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-	ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error
-	// SignFungibleMessage signs a Fungible message based on Transfer
+	// ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB.
+	// The validator is the one stated in the envelope of the message, if any, and has to be the signer.
+	// Returns ErrInvalidSignature if the signature fails the verification
+	ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte, validator string) error
+	// SignFungibleMessage signs a Fungible message based on Transfer. Returns ErrTransferHeld if the transfer breaks the signing policy
 	SignFungibleMessage(transfer payload.Transfer) ([]byte, error)
-	// SignNftMessage signs an NFT messaged based on Transfer
+	// SignNftMessage signs an NFT messaged based on Transfer. Returns ErrTransferHeld if the transfer breaks the signing policy
 	SignNftMessage(transfer payload.Transfer) ([]byte, error)
 }
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+
+// Peers tracks the misbehaviour and the availability of the peer validators
+type Peers interface {
+	// Reject records a rejected topic message of the given signer together with the reason for its rejection
+	Reject(transferID, signer, signature, reason string)
+	// Report returns the signing statistics of each peer validator within the configured window
+	Report() (*peer.Report, error)
+	// Rejections returns the rejected topic messages of the given peer validator within the configured window
+	Rejections(address string) ([]*peer.Rejection, error)
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	policyModel "github.com/limechain/hedera-eth-bridge-validator/app/model/policy"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+)
+
+// Policy evaluates the signing policy of the validator, limiting the value it signs for
+type Policy interface {
+	// Evaluate checks the transfer against the configured limits before it gets signed or scheduled.
+	// Returns ErrTransferHeld if the transfer breaks any of them, in which case the transfer is marked as held
+	// and must not be signed. Evaluating the same transfer again returns the initial decision
+	Evaluate(transfer payload.Transfer) error
+	// Held returns the transfers held by the signing policy
+	Held() ([]*policyModel.HeldTransfer, error)
+}
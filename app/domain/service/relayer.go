@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import "context"
+
+// Relayer submits the claim transactions of fungible transfers, which reached super majority, on their target chain
+type Relayer interface {
+	// Relay schedules the submission of the claim transaction of the given transfer, which reached super majority
+	// at the given consensus timestamp. The elected validator submits it right away, while the others take over
+	// one after another, if the transfer does not get completed before the deadline of the previous one.
+	// The submission is abandoned once the context is cancelled
+	Relay(ctx context.Context, transferID string, targetChainId uint64, majorityTimestamp int64)
+	// Resume relays the fungible transfers, which reached super majority, but did not get completed,
+	// e.g. because the node got restarted while relaying them
+	Resume(ctx context.Context)
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/reprocess"
+)
+
+// Reprocessor runs the parsing pipeline of a watcher over a bounded window of its source
+type Reprocessor interface {
+	// Reprocess pushes the events of the source between `from` and `to` onto the queue without
+	// moving the checkpoint of the watcher. Returns the number of events found in the window
+	Reprocess(ctx context.Context, from, to int64, q qi.Queue) (int, error)
+}
+
+// Reprocess recovers missed events by running the watchers over a window of their source
+type Reprocess interface {
+	// Register makes the source reprocessable by the given reprocessor
+	Register(source string, reprocessor Reprocessor)
+	// Reprocess runs the reprocessor of the requested source over the requested window, skipping the
+	// transfers and messages already in the database. Returns ErrNotFound if the source is not registered
+	Reprocess(ctx context.Context, request *reprocess.Request) (*reprocess.Report, error)
+}
@@ -22,7 +22,15 @@ import (
 )
 
 type Signer interface {
-	Sign(msg []byte) ([]byte, error)
+	// Sign returns the signature of the keccak256 hash of the given data with a recovery id of 27 or 28
+	Sign(data []byte) ([]byte, error)
 	NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error)
 	Address() string
 }
+
+// RotatingSigner is a Signer holding a next key, which it switches over to once the key becomes a router member
+type RotatingSigner interface {
+	Signer
+	// NextAddress returns the address of the key, which is not used for signing yet
+	NextAddress() string
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+// SigningJournal records the authorisation messages signed by the validator, guarding against signing
+// different contents for the same transfer, e.g. after a database restore or a watcher rewind
+type SigningJournal interface {
+	// Record persists the hash of the authorisation message about to be signed for the given transfer and target chain.
+	// Recording the same hash again is allowed. Returns ErrDoubleSign if a different hash has already been recorded,
+	// in which case the message must not be signed
+	Record(transferID string, targetChainId uint64, hash []byte) error
+}
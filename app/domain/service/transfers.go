@@ -17,6 +17,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -33,20 +35,29 @@ type Transfers interface {
 	InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, error)
 	// ProcessNativeTransfer processes the native fungible transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeTransfer(tm payload.Transfer) error
+	ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error
 	// ProcessNativeNftTransfer processes the native nft transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeNftTransfer(tm payload.Transfer) error
+	ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error
 	// ProcessWrappedTransfer processes the wrapped transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessWrappedTransfer(tm payload.Transfer) error
+	ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error
 	// TransferData returns from the database the given transfer, its signatures and
 	// calculates if its messages have reached super majority
 	TransferData(txId string) (interface{}, error)
+	// ClaimData returns the ready to submit transaction claiming the given transfer on the router of its
+	// target chain, once its messages have reached super majority
+	ClaimData(txId string) (*ClaimData, error)
 	// Paged returns a paginated list of all transfers
 	Paged(filter *model.PagedRequest) (*model.Paged, error)
 	// UpdateTransferStatusCompleted updates the transfer status to completed
 	UpdateTransferStatusCompleted(txId string) error
+	// SubmitSignatureMessage submits the signature message of the given transfer into the required HCS Topic,
+	// either on its own or as part of a signature batch
+	SubmitSignatureMessage(ctx context.Context, transferID string, signatureMessage []byte) error
+	// UseLeaderContext bounds the submission of signature batches, shared by several transfers,
+	// to the given leader context
+	UseLeaderContext(ctx context.Context)
 }
 
 type TransferData struct {
@@ -72,3 +83,14 @@ type FungibleTransferData struct {
 	TransferData
 	Amount string `json:"amount"`
 }
+
+// ClaimData is the transaction claiming a transfer on the router of its target chain
+type ClaimData struct {
+	TransferId    string `json:"transferId"`
+	ChainId       uint64 `json:"chainId"`
+	RouterAddress string `json:"routerAddress"`
+	Method        string `json:"method"`
+	Selector      string `json:"selector"`
+	Calldata      string `json:"calldata"`
+	GasEstimate   uint64 `json:"gasEstimate"`
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+)
+
+// ApiKeyHeader is the request header holding the admin API key
+const ApiKeyHeader = "X-Api-Key"
+
+var errUnauthorized = errors.New("Unauthorized")
+
+// RequireApiKey rejects the requests without a matching admin API key, or all requests if the key is not set
+func RequireApiKey(apiKey string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(ApiKeyHeader)
+			if apiKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+				render.Status(r, http.StatusUnauthorized)
+				render.JSON(w, r, response.ErrorResponse(errUnauthorized))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
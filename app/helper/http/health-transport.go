@@ -0,0 +1,58 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+)
+
+// HealthTransport is a http.RoundTripper, which reports the outcome of every request to the health service.
+// Transport errors, rate limiting and server errors are reported as failed calls
+type HealthTransport struct {
+	next   http.RoundTripper
+	health service.Health
+	client string
+}
+
+func NewHealthTransport(next http.RoundTripper, health service.Health, client string) *HealthTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &HealthTransport{
+		next:   next,
+		health: health,
+		client: client,
+	}
+}
+
+func (t *HealthTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.next.RoundTrip(request)
+	if err != nil {
+		t.health.ReportCall(t.client, err)
+		return response, err
+	}
+
+	if response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests {
+		t.health.ReportCall(t.client, fmt.Errorf("request failed with status [%s]", response.Status))
+	} else {
+		t.health.ReportCall(t.client, nil)
+	}
+	return response, nil
+}
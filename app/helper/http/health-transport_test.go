@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func Test_HealthTransport(t *testing.T) {
+	for _, statusCode := range []int{http.StatusOK, http.StatusNotFound} {
+		mocks.Setup()
+		mocks.MHealthService.On("ReportCall", "client", nil).Return()
+		transport := NewHealthTransport(respondWith(statusCode, nil), mocks.MHealthService, "client")
+
+		response, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Nil(t, err)
+		assert.Equal(t, statusCode, response.StatusCode)
+		mocks.MHealthService.AssertExpectations(t)
+	}
+}
+
+func Test_HealthTransport_Fails(t *testing.T) {
+	for _, statusCode := range []int{http.StatusInternalServerError, http.StatusTooManyRequests} {
+		mocks.Setup()
+		mocks.MHealthService.On("ReportCall", "client", mock.AnythingOfType("*errors.errorString")).Return()
+		transport := NewHealthTransport(respondWith(statusCode, nil), mocks.MHealthService, "client")
+
+		response, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Nil(t, err)
+		assert.Equal(t, statusCode, response.StatusCode)
+		mocks.MHealthService.AssertExpectations(t)
+	}
+}
+
+func Test_HealthTransport_TransportError(t *testing.T) {
+	mocks.Setup()
+	expectedErr := errors.New("connection refused")
+	mocks.MHealthService.On("ReportCall", "client", expectedErr).Return()
+	transport := NewHealthTransport(respondWith(0, expectedErr), mocks.MHealthService, "client")
+
+	_, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MHealthService.AssertExpectations(t)
+}
+
+func respondWith(statusCode int, err error) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: statusCode, Status: http.StatusText(statusCode)}, nil
+	})
+}
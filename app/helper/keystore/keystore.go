@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+var ErrNoPassphrase = errors.New("no passphrase provided")
+
+// ReadPassphrase reads the passphrase from the file or, if it is not provided, from the environment variable.
+// Trailing line breaks of the file are ignored
+func ReadPassphrase(file, env string) (string, error) {
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+	if env != "" {
+		if passphrase, ok := os.LookupEnv(env); ok {
+			return passphrase, nil
+		}
+		return "", fmt.Errorf("environment variable [%s] is not set", env)
+	}
+
+	return "", ErrNoPassphrase
+}
+
+// DecryptEvm decrypts the geth keystore JSON, returning the hex encoded private key
+func DecryptEvm(keyJson []byte, passphrase string) (string, error) {
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(crypto.FromECDSA(key.PrivateKey)), nil
+}
+
+// EncryptEvm encrypts the hex encoded private key into a geth keystore JSON
+func EncryptEvm(privateKey, passphrase string) ([]byte, error) {
+	pk, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(pk.PublicKey),
+		PrivateKey: pk,
+	}
+	return keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// InspectEvm decrypts the geth keystore JSON, returning the address of its key
+func InspectEvm(keyJson []byte, passphrase string) (string, error) {
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return key.Address.String(), nil
+}
+
+// DecryptHedera decrypts the Hedera key file, returning the private key in the format of hedera.PrivateKey.String.
+// The file is either a Hedera keystore or an encrypted PKCS#8 PEM
+func DecryptHedera(content []byte, passphrase string) (string, error) {
+	key, err := decryptHedera(content, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return key.String(), nil
+}
+
+// EncryptHedera encrypts the private key into a Hedera keystore. Only Ed25519 keys are supported
+func EncryptHedera(privateKey, passphrase string) ([]byte, error) {
+	key, err := hedera.PrivateKeyFromString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Keystore(passphrase)
+}
+
+// InspectHedera decrypts the Hedera key file, returning the public key of its key
+func InspectHedera(content []byte, passphrase string) (string, error) {
+	key, err := decryptHedera(content, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return key.PublicKey().String(), nil
+}
+
+func decryptHedera(content []byte, passphrase string) (hedera.PrivateKey, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("-----BEGIN")) {
+		return hedera.PrivateKeyFromPem(content, passphrase)
+	}
+
+	return hedera.PrivateKeyFromKeystore(content, passphrase)
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+const passphrase = "some-passphrase"
+
+func Test_ReadPassphrase_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "passphrase")
+	assert.Nil(t, os.WriteFile(file, []byte(passphrase+"\n"), 0600))
+
+	actual, err := ReadPassphrase(file, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, passphrase, actual)
+}
+
+func Test_ReadPassphrase_Env(t *testing.T) {
+	t.Setenv("KEYSTORE_PASSPHRASE", passphrase)
+
+	actual, err := ReadPassphrase("", "KEYSTORE_PASSPHRASE")
+
+	assert.Nil(t, err)
+	assert.Equal(t, passphrase, actual)
+}
+
+func Test_ReadPassphrase_EnvNotSet(t *testing.T) {
+	_, err := ReadPassphrase("", "KEYSTORE_PASSPHRASE_NOT_SET")
+
+	assert.EqualError(t, err, "environment variable [KEYSTORE_PASSPHRASE_NOT_SET] is not set")
+}
+
+func Test_ReadPassphrase_NotProvided(t *testing.T) {
+	_, err := ReadPassphrase("", "")
+
+	assert.Equal(t, ErrNoPassphrase, err)
+}
+
+func Test_Evm(t *testing.T) {
+	pk, _ := crypto.GenerateKey()
+	privateKey := hex.EncodeToString(crypto.FromECDSA(pk))
+
+	keyJson, err := EncryptEvm(privateKey, passphrase)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(keyJson), privateKey)
+
+	decrypted, err := DecryptEvm(keyJson, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, privateKey, decrypted)
+
+	address, err := InspectEvm(keyJson, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(pk.PublicKey).String(), address)
+}
+
+func Test_DecryptEvm_WrongPassphrase(t *testing.T) {
+	pk, _ := crypto.GenerateKey()
+	key := &keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(pk.PublicKey), PrivateKey: pk}
+	keyJson, _ := keystore.EncryptKey(key, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+
+	_, err := DecryptEvm(keyJson, "wrong-passphrase")
+
+	assert.Equal(t, keystore.ErrDecrypt, err)
+}
+
+func Test_Hedera(t *testing.T) {
+	pk, _ := hedera.PrivateKeyGenerateEd25519()
+
+	content, err := EncryptHedera(pk.String(), passphrase)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(content), pk.StringRaw())
+
+	decrypted, err := DecryptHedera(content, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, pk.String(), decrypted)
+
+	publicKey, err := InspectHedera(content, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, pk.PublicKey().String(), publicKey)
+}
+
+func Test_DecryptHedera_WrongPassphrase(t *testing.T) {
+	pk, _ := hedera.PrivateKeyGenerateEd25519()
+	content, _ := EncryptHedera(pk.String(), passphrase)
+
+	_, err := DecryptHedera(content, "wrong-passphrase")
+
+	assert.NotNil(t, err)
+}
+
+func Test_EncryptHedera_ECDSA(t *testing.T) {
+	pk, _ := hedera.PrivateKeyGenerateEcdsa()
+
+	_, err := EncryptHedera(pk.String(), passphrase)
+
+	assert.NotNil(t, err)
+}
@@ -1,8 +1,11 @@
 package auth_message
 
 import (
-	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -40,3 +43,13 @@ func Test_EncodeFungibleBytesFromWorks(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, actualResult)
 }
+
+func Test_EncodeFungibleDataFrom(t *testing.T) {
+	data, err := EncodeFungibleDataFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount)
+	assert.Nil(t, err)
+
+	hash, err := EncodeFungibleBytesFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.Keccak256(data), hash)
+	assert.True(t, strings.HasPrefix(string(data), "\x19Ethereum Signed Message:\n32"))
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import "time"
+
+const (
+	StatusOK        = "OK"
+	StatusUnhealthy = "UNHEALTHY"
+)
+
+// Status serves as a response model of the aggregated health of the node
+type Status struct {
+	Status   string             `json:"status"`
+	Watchers map[string]Watcher `json:"watchers,omitempty"`
+	Clients  map[string]Client  `json:"clients,omitempty"`
+}
+
+// Watcher holds the health of a single watcher. Hedera watchers report a consensus timestamp position
+// and a lag in seconds, while EVM watchers report a block number position and a lag in blocks
+type Watcher struct {
+	Status        string     `json:"status"`
+	LastHeartbeat *time.Time `json:"lastHeartbeat,omitempty"`
+	Position      int64      `json:"position"`
+	Lag           int64      `json:"lag"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// Client holds the health of a single client, based on its latest calls
+type Client struct {
+	Status      string     `json:"status"`
+	LastSuccess *time.Time `json:"lastSuccess,omitempty"`
+	Calls       int        `json:"calls"`
+	ErrorRate   float64    `json:"errorRate"`
+	Error       string     `json:"error,omitempty"`
+}
@@ -18,20 +18,83 @@ package message
 
 import (
 	"encoding/base64"
-	"google.golang.org/protobuf/proto"
+	"errors"
+	"fmt"
+	"time"
+
 	msgHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	model "github.com/limechain/hedera-eth-bridge-validator/proto"
+	"google.golang.org/protobuf/proto"
 )
 
+// EnvelopeVersion is the current version of the protocol, submitted in the TopicEnvelope
+const EnvelopeVersion uint32 = 1
+
 // Message serves as a model between Topic Message Watcher and Handler
 type Message struct {
 	*model.TopicMessage
 	TransactionTimestamp int64
+	// Version of the envelope, in which the message was received. Zero for messages in the legacy format
+	Version uint32
+	// Validator is the EVM address of the validator, as stated in the envelope
+	Validator string
+	// CreatedAt is the creation time of the message in nanoseconds, as stated in the envelope
+	CreatedAt int64
 }
 
-// FromBytes instantiates new TopicMessage protobuf used internally by the Watchers/Handlers
+// FromBytes instantiates new TopicMessage protobuf used internally by the Watchers/Handlers.
+// Both enveloped and legacy (bare TopicMessage or TopicEthSignatureMessage) messages are supported
 func FromBytes(data []byte) (*Message, error) {
+	envelope := &model.TopicEnvelope{}
+	err := proto.Unmarshal(data, envelope)
+	if err == nil && envelope.Version > 0 {
+		return fromEnvelope(envelope)
+	}
+
+	return fromLegacyBytes(data)
+}
+
+// fromEnvelope decodes the payload of the envelope according to its type
+func fromEnvelope(envelope *model.TopicEnvelope) (*Message, error) {
+	if envelope.Version > EnvelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version [%d]", envelope.Version)
+	}
+
+	msg := &model.TopicMessage{}
+	switch envelope.Type {
+	case model.TopicMessageType_TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE:
+		fungibleMsg := &model.TopicEthSignatureMessage{}
+		err := proto.Unmarshal(envelope.Payload, fungibleMsg)
+		if err != nil {
+			return nil, err
+		}
+		msgHelper.UpdateHederaChainIdOfFungibleMsg(fungibleMsg)
+		msg.Message = &model.TopicMessage_FungibleSignatureMessage{FungibleSignatureMessage: fungibleMsg}
+	case model.TopicMessageType_TOPIC_MESSAGE_TYPE_NFT_SIGNATURE:
+		nftMsg := &model.TopicEthNftSignatureMessage{}
+		err := proto.Unmarshal(envelope.Payload, nftMsg)
+		if err != nil {
+			return nil, err
+		}
+		msgHelper.UpdateHederaChainIdOfNftMsg(nftMsg)
+		msg.Message = &model.TopicMessage_NftSignatureMessage{NftSignatureMessage: nftMsg}
+	case model.TopicMessageType_TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH:
+		return nil, errors.New("signature batches have to be unpacked")
+	default:
+		return nil, fmt.Errorf("unsupported envelope message type [%s]", envelope.Type)
+	}
+
+	return &Message{
+		TopicMessage: msg,
+		Version:      envelope.Version,
+		Validator:    envelope.Validator,
+		CreatedAt:    envelope.CreatedAt,
+	}, nil
+}
+
+// fromLegacyBytes decodes messages submitted before the introduction of the TopicEnvelope
+func fromLegacyBytes(data []byte) (*Message, error) {
 	msg := &model.TopicMessage{}
 	err := proto.Unmarshal(data, msg)
 	if err != nil {
@@ -63,6 +126,56 @@ func FromBytes(data []byte) (*Message, error) {
 	}
 }
 
+// UnpackBytes instantiates the messages contained in `data`, unpacking signature batches into separate messages.
+// All of them are assigned the transaction timestamp `ts`
+func UnpackBytes(data []byte, ts int64) ([]*Message, error) {
+	envelope := &model.TopicEnvelope{}
+	err := proto.Unmarshal(data, envelope)
+	if err != nil || envelope.Type != model.TopicMessageType_TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH {
+		msg, err := FromBytesWithTS(data, ts)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+
+	if envelope.Version > EnvelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version [%d]", envelope.Version)
+	}
+
+	batch := &model.TopicEnvelopeBatch{}
+	err = proto.Unmarshal(envelope.Payload, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(batch.Envelopes))
+	for _, e := range batch.Envelopes {
+		msg, err := fromEnvelope(e)
+		if err != nil {
+			return nil, err
+		}
+		msg.TransactionTimestamp = ts
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// UnpackString instantiates the messages contained in the base64 encoded `data`, unpacking signature batches
+func UnpackString(data, ts string) ([]*Message, error) {
+	t, err := timestamp.FromString(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return UnpackBytes(bytes, t)
+}
+
 // FromBytesWithTS instantiates new TopicMessage protobuf used internally by the Watchers/Handlers
 func FromBytesWithTS(data []byte, ts int64) (*Message, error) {
 	msg, err := FromBytes(data)
@@ -98,7 +211,84 @@ func NewNftSignature(topicMsg *model.TopicEthNftSignatureMessage) *Message {
 	return &Message{TopicMessage: &model.TopicMessage{Message: &model.TopicMessage_NftSignatureMessage{NftSignatureMessage: topicMsg}}}
 }
 
-// ToBytes marshals the underlying protobuf Message into bytes
+// WithEnvelope marks the message for submission in a TopicEnvelope of the current version
+func (tm *Message) WithEnvelope(validator string, createdAt time.Time) *Message {
+	tm.Version = EnvelopeVersion
+	tm.Validator = validator
+	tm.CreatedAt = createdAt.UnixNano()
+	return tm
+}
+
+// ToBytes marshals the underlying protobuf Message into bytes. Messages with a version are wrapped in a TopicEnvelope
 func (tm *Message) ToBytes() ([]byte, error) {
-	return proto.Marshal(tm.TopicMessage)
+	if tm.Version == 0 {
+		return proto.Marshal(tm.TopicMessage)
+	}
+
+	envelope, err := tm.toEnvelope()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(envelope)
+}
+
+// BatchToBytes marshals the given messages into a single signature batch, created by `validator` at `createdAt`
+func BatchToBytes(messages []*Message, validator string, createdAt time.Time) ([]byte, error) {
+	batch := &model.TopicEnvelopeBatch{}
+	for _, msg := range messages {
+		envelope, err := msg.toEnvelope()
+		if err != nil {
+			return nil, err
+		}
+		batch.Envelopes = append(batch.Envelopes, envelope)
+	}
+
+	payload, err := proto.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&model.TopicEnvelope{
+		Version:   EnvelopeVersion,
+		Validator: validator,
+		CreatedAt: createdAt.UnixNano(),
+		Type:      model.TopicMessageType_TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH,
+		Payload:   payload,
+	})
+}
+
+// toEnvelope wraps the underlying protobuf Message in a TopicEnvelope
+func (tm *Message) toEnvelope() (*model.TopicEnvelope, error) {
+	var (
+		msgType model.TopicMessageType
+		payload proto.Message
+	)
+	switch msg := tm.TopicMessage.Message.(type) {
+	case *model.TopicMessage_FungibleSignatureMessage:
+		msgType = model.TopicMessageType_TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE
+		payload = msg.FungibleSignatureMessage
+	case *model.TopicMessage_NftSignatureMessage:
+		msgType = model.TopicMessageType_TOPIC_MESSAGE_TYPE_NFT_SIGNATURE
+		payload = msg.NftSignatureMessage
+	default:
+		return nil, errors.New("unsupported message type for envelope")
+	}
+
+	payloadBytes, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	version := tm.Version
+	if version == 0 {
+		version = EnvelopeVersion
+	}
+
+	return &model.TopicEnvelope{
+		Version:   version,
+		Validator: tm.Validator,
+		CreatedAt: tm.CreatedAt,
+		Type:      msgType,
+		Payload:   payloadBytes,
+	}, nil
 }
@@ -2,11 +2,11 @@ package message
 
 import (
 	"encoding/base64"
-	"google.golang.org/protobuf/proto"
 	timestampHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	model "github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"testing"
 	"time"
 )
@@ -106,6 +106,160 @@ func Test_FromStringWorks(t *testing.T) {
 	signatureEqualFields(t, expected, result.TopicMessage.GetFungibleSignatureMessage())
 }
 
+func Test_EnvelopeRoundTripFungible(t *testing.T) {
+	expected := expectedSignature()
+	bytes, err := NewFungibleSignature(expected).WithEnvelope("0xvalidator", now).ToBytes()
+	assert.Nil(t, err)
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvelopeVersion, result.Version)
+	assert.Equal(t, "0xvalidator", result.Validator)
+	assert.Equal(t, now.UnixNano(), result.CreatedAt)
+	signatureEqualFields(t, expected, result.TopicMessage.GetFungibleSignatureMessage())
+}
+
+func Test_EnvelopeRoundTripNft(t *testing.T) {
+	expected := &model.TopicEthNftSignatureMessage{
+		SourceChainId: 1,
+		TargetChainId: constants.HederaNetworkId,
+		TransferID:    "0xsomehash-1",
+		Asset:         "0xasset",
+		TokenId:       5,
+		Metadata:      "somemetadata",
+		Recipient:     "0.0.123",
+		Signature:     "somesigneddatahere",
+	}
+	bytes, err := NewNftSignature(expected).WithEnvelope("0xvalidator", now).ToBytes()
+	assert.Nil(t, err)
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvelopeVersion, result.Version)
+	assert.True(t, proto.Equal(expected, result.TopicMessage.GetNftSignatureMessage()))
+}
+
+func Test_FromBytesLegacyTopicMessage(t *testing.T) {
+	bytes, err := NewFungibleSignature(expectedSignature()).ToBytes()
+	assert.Nil(t, err)
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), result.Version)
+	assert.Empty(t, result.Validator)
+	signatureEqualFields(t, expectedSignature(), result.TopicMessage.GetFungibleSignatureMessage())
+}
+
+func Test_FromBytesEnvelopeUnsupportedVersion(t *testing.T) {
+	bytes, err := proto.Marshal(&model.TopicEnvelope{
+		Version: EnvelopeVersion + 1,
+		Type:    model.TopicMessageType_TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func Test_FromBytesEnvelopeUnsupportedType(t *testing.T) {
+	bytes, err := proto.Marshal(&model.TopicEnvelope{
+		Version: EnvelopeVersion,
+		Type:    model.TopicMessageType_TOPIC_MESSAGE_TYPE_UNSPECIFIED,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func Test_ToBytesEnvelopeWithoutMessage(t *testing.T) {
+	msg := &Message{TopicMessage: &model.TopicMessage{}}
+	result, err := msg.WithEnvelope("0xvalidator", now).ToBytes()
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func Test_BatchRoundTrip(t *testing.T) {
+	fungible := expectedSignature()
+	nft := &model.TopicEthNftSignatureMessage{
+		SourceChainId: 1,
+		TargetChainId: constants.HederaNetworkId,
+		TransferID:    "0xsomehash-1",
+		Asset:         "0xasset",
+		TokenId:       5,
+		Recipient:     "0.0.123",
+		Signature:     "somesigneddatahere",
+	}
+	messages := []*Message{
+		NewFungibleSignature(fungible).WithEnvelope("0xvalidator", now),
+		NewNftSignature(nft).WithEnvelope("0xvalidator", now),
+	}
+
+	bytes, err := BatchToBytes(messages, "0xvalidator", now)
+	assert.Nil(t, err)
+
+	result, err := UnpackBytes(bytes, ts)
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	signatureEqualFields(t, fungible, result[0].TopicMessage.GetFungibleSignatureMessage())
+	assert.True(t, proto.Equal(nft, result[1].TopicMessage.GetNftSignatureMessage()))
+	for _, msg := range result {
+		assert.Equal(t, ts, msg.TransactionTimestamp)
+		assert.Equal(t, "0xvalidator", msg.Validator)
+		assert.Equal(t, now.UnixNano(), msg.CreatedAt)
+	}
+}
+
+func Test_FromBytesBatchFails(t *testing.T) {
+	bytes, err := BatchToBytes([]*Message{NewFungibleSignature(expectedSignature())}, "0xvalidator", now)
+	assert.Nil(t, err)
+
+	result, err := FromBytes(bytes)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func Test_UnpackBytesSingleMessage(t *testing.T) {
+	bytes, err := proto.Marshal(expectedSignature())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := UnpackBytes(bytes, ts)
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, ts, result[0].TransactionTimestamp)
+	signatureEqualFields(t, expectedSignature(), result[0].TopicMessage.GetFungibleSignatureMessage())
+}
+
+func Test_UnpackBytesWithInvalidBytes(t *testing.T) {
+	result, err := UnpackBytes(invalidBytes, ts)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func Test_UnpackStringWorks(t *testing.T) {
+	bytes, err := BatchToBytes([]*Message{NewFungibleSignature(expectedSignature())}, "0xvalidator", now)
+	assert.Nil(t, err)
+
+	result, err := UnpackString(base64.StdEncoding.EncodeToString(bytes), timestampHelper.String(now.UnixNano()))
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, now.UnixNano(), result[0].TransactionTimestamp)
+}
+
+func Test_UnpackStringWithInvalidTS(t *testing.T) {
+	result, err := UnpackString(invalidStringData, invalidStringTs)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
 //
 //func Test_ToBytes(t *testing.T) {
 //	expectedBytes, err := proto.Marshal(expectedSignature())
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peer
+
+import "time"
+
+// Reasons for rejecting a topic message of a peer validator
+const (
+	// ReasonWrongSigner is set when the signer is not a member of the router of the target chain
+	ReasonWrongSigner = "wrong_signer"
+	// ReasonWrongAmount is set when the signed amount differs from the amount of the transfer
+	ReasonWrongAmount = "wrong_amount"
+	// ReasonWrongRecipient is set when the signed recipient differs from the receiver of the transfer
+	ReasonWrongRecipient = "wrong_recipient"
+	// ReasonWrongContents is set when any other signed field differs from the transfer
+	ReasonWrongContents = "wrong_contents"
+	// ReasonDuplicate is set when an already received signature is submitted again
+	ReasonDuplicate = "duplicate"
+	// ReasonUnknownTransfer is set when the signed transfer is not known to the validator
+	ReasonUnknownTransfer = "unknown_transfer"
+	// ReasonWrongValidator is set when the validator stated in the envelope differs from the signer
+	ReasonWrongValidator = "wrong_validator"
+)
+
+// UnknownSigner is used for messages, whose signer could not be recovered
+const UnknownSigner = "unknown"
+
+// Rejection is a topic message of a peer validator, which was rejected by the validator
+type Rejection struct {
+	TransferId string    `json:"transferId"`
+	Signer     string    `json:"signer"`
+	Signature  string    `json:"signature"`
+	Reason     string    `json:"reason"`
+	RejectedAt time.Time `json:"rejectedAt"`
+}
+
+// Peer holds the signing statistics of a peer validator within the report window
+type Peer struct {
+	Address string `json:"address"`
+	// Member is whether the peer is currently a member of the router of any chain
+	Member bool  `json:"member"`
+	Signed int64 `json:"signed"`
+	// Missed is the number of transfers past the grace period, except for held and reorged ones, which the peer has not signed
+	Missed int64 `json:"missed"`
+	// AverageSigningLatency is the average time in seconds between a transfer and the signature of the peer
+	AverageSigningLatency float64          `json:"averageSigningLatency"`
+	LastSignedAt          *time.Time       `json:"lastSignedAt,omitempty"`
+	Rejections            map[string]int64 `json:"rejections"`
+}
+
+// Report holds the statistics of all peer validators for the transfers since the given time
+type Report struct {
+	Since time.Time `json:"since"`
+	Peers []*Peer   `json:"peers"`
+}
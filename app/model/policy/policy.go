@@ -0,0 +1,31 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package policy
+
+import "time"
+
+// HeldTransfer is a transfer which broke the signing policy of the validator and was not signed
+type HeldTransfer struct {
+	TransferId    string    `json:"transferId"`
+	SourceChainId uint64    `json:"sourceChainId"`
+	TargetChainId uint64    `json:"targetChainId"`
+	Asset         string    `json:"asset"`
+	Originator    string    `json:"originator"`
+	Amount        string    `json:"amount,omitempty"`
+	UsdAmount     string    `json:"usdAmount,omitempty"`
+	Reason        string    `json:"reason"`
+	HeldAt        time.Time `json:"heldAt"`
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reprocess
+
+// Request describes the window of a source, which has to be reprocessed. The source is either the Hedera
+// bridge account, the HCS topic or the ID of an EVM chain. For Hedera sources the window is between the
+// `From` included and `To` excluded consensus timestamps. For EVM sources it is between the `From` and `To`
+// included block numbers
+type Request struct {
+	Source string `json:"source"`
+	From   int64  `json:"from"`
+	To     int64  `json:"to"`
+}
+
+// Report serves as a response model, describing the outcome of a reprocessing.
+// `Found` is the number of source events in the window, `Created` holds the IDs of the transfers and
+// messages handed over to the handlers and `Skipped` holds the IDs of the ones already in the database
+type Report struct {
+	Source  string   `json:"source"`
+	From    int64    `json:"from"`
+	To      int64    `json:"to"`
+	Found   int      `json:"found"`
+	Created []string `json:"created"`
+	Skipped []string `json:"skipped"`
+}
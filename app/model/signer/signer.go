@@ -0,0 +1,25 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signer
+
+// Signer serves as a response model, describing the EVM keys of the validator for a given chain.
+// `NextAddress` is set only during a key rotation
+type Signer struct {
+	ChainId       uint64 `json:"chainId"`
+	ActiveAddress string `json:"activeAddress"`
+	NextAddress   string `json:"nextAddress,omitempty"`
+}
@@ -58,6 +58,7 @@ type Filter struct {
 	TimestampQuery string `json:"timestamp"`
 	TokenId        string `json:"tokenId"`
 	TransactionId  string `json:"transactionId"`
+	Status         string `json:"status"`
 }
 
 type SanityCheckResult struct {
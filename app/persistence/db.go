@@ -18,7 +18,7 @@ package persistence
 
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
-	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -45,17 +45,15 @@ func (db *Database) Connection() *gorm.DB {
 	return db.connection
 }
 
-// Migrate auto-migrates the database
-func (db *Database) Migrate() {
-	err := db.Connection().
-		AutoMigrate(
-			entity.Transfer{},
-			entity.Fee{},
-			entity.Message{},
-			entity.Schedule{},
-			entity.Status{})
+// VerifySchema ensures the database schema is at the version expected by the node.
+// Migrations are applied separately with the migrate command
+func (db *Database) VerifySchema() {
+	migrator, err := migrations.NewMigrator(db.Connection())
 	if err != nil {
 		log.Fatal(err)
 	}
-	log.Println("Migrations passed successfully")
+	if err = migrator.Verify(); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Database schema verified successfully")
 }
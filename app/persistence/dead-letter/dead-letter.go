@@ -0,0 +1,85 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"errors"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db: dbClient,
+	}
+}
+
+func (r *Repository) Create(deadLetter *entity.DeadLetter) error {
+	return r.db.Create(deadLetter).Error
+}
+
+// Returns DeadLetter. Returns nil if not found
+func (r *Repository) Get(id uint64) (*entity.DeadLetter, error) {
+	record := &entity.DeadLetter{}
+
+	result := r.db.
+		Model(entity.DeadLetter{}).
+		Where("id = ?", id).
+		First(record)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return record, nil
+}
+
+// GetAll returns all dead letters, ordered by insertion
+func (r *Repository) GetAll() ([]*entity.DeadLetter, error) {
+	var deadLetters []*entity.DeadLetter
+
+	err := r.db.
+		Order("id").
+		Find(&deadLetters).Error
+	return deadLetters, err
+}
+
+// UpdateFailure increments the attempts of the dead letter, storing the error of the latest attempt
+func (r *Repository) UpdateFailure(id uint64, errorMessage string) error {
+	return r.db.
+		Model(entity.DeadLetter{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"error":    errorMessage,
+			"attempts": gorm.Expr("attempts + 1"),
+		}).
+		Error
+}
+
+func (r *Repository) Delete(id uint64) error {
+	return r.db.
+		Where("id = ?", id).
+		Delete(&entity.DeadLetter{}).
+		Error
+}
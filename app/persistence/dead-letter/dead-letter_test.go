@@ -0,0 +1,186 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository         *Repository
+	dbConn             *gorm.DB
+	sqlMock            sqlmock.Sqlmock
+	id                 = uint64(1)
+	topic              = "topic"
+	payloadType        = "TRANSFER"
+	payload            = []byte("{}")
+	errorMessage       = "some-error"
+	attempts           = 1
+	now                = time.Unix(1, 0)
+	expectedDeadLetter = &entity.DeadLetter{
+		ID:          id,
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Error:       errorMessage,
+		Attempts:    attempts,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	columns = []string{"id", "topic", "payload_type", "payload", "error", "attempts", "created_at", "updated_at"}
+	rowArgs = []driver.Value{id, topic, payloadType, payload, errorMessage, attempts, now, now}
+
+	createQuery        = regexp.QuoteMeta(`INSERT INTO "dead_letters" ("topic","payload_type","payload","error","attempts","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+	getQuery           = regexp.QuoteMeta(`SELECT * FROM "dead_letters" WHERE id = $1 ORDER BY "dead_letters"."id" LIMIT 1`)
+	getAllQuery        = regexp.QuoteMeta(`SELECT * FROM "dead_letters" ORDER BY id`)
+	updateFailureQuery = regexp.QuoteMeta(`UPDATE "dead_letters" SET "attempts"=attempts + 1,"error"=$1,"updated_at"=$2 WHERE id = $3`)
+	deleteQuery        = regexp.QuoteMeta(`DELETE FROM "dead_letters" WHERE id = $1`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db: dbConn,
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_Create(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{id}, createQuery,
+		topic, payloadType, payload, errorMessage, attempts, sqlmock.AnyArg(), sqlmock.AnyArg())
+
+	record := &entity.DeadLetter{
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Error:       errorMessage,
+		Attempts:    attempts,
+	}
+	err := repository.Create(record)
+	assert.Nil(t, err)
+	assert.Equal(t, id, record.ID)
+}
+
+func Test_Create_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, createQuery,
+		topic, payloadType, payload, errorMessage, attempts, sqlmock.AnyArg(), sqlmock.AnyArg())
+
+	err := repository.Create(&entity.DeadLetter{
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Error:       errorMessage,
+		Attempts:    attempts,
+	})
+	assert.NotNil(t, err)
+}
+
+func Test_Get(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getQuery, id)
+
+	actual, err := repository.Get(id)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDeadLetter, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getQuery, id)
+
+	actual, err := repository.Get(id)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Get_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getQuery, id)
+
+	actual, err := repository.Get(id)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetAll(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getAllQuery)
+
+	actual, err := repository.GetAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.DeadLetter{expectedDeadLetter}, actual)
+}
+
+func Test_GetAll_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAllQuery)
+
+	actual, err := repository.GetAll()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_UpdateFailure(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, updateFailureQuery, errorMessage, sqlmock.AnyArg(), id)
+
+	err := repository.UpdateFailure(id, errorMessage)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateFailure_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateFailureQuery, errorMessage, sqlmock.AnyArg(), id)
+
+	err := repository.UpdateFailure(id, errorMessage)
+	assert.NotNil(t, err)
+}
+
+func Test_Delete(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, deleteQuery, id)
+
+	err := repository.Delete(id)
+	assert.Nil(t, err)
+}
+
+func Test_Delete_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, deleteQuery, id)
+
+	err := repository.Delete(id)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import (
+	"time"
+
+	deadLetterModel "github.com/limechain/hedera-eth-bridge-validator/app/model/dead-letter"
+)
+
+// DeadLetter is a db model used to persist the payloads which handlers failed to process, so that they can be replayed
+type DeadLetter struct {
+	ID          uint64 `gorm:"primaryKey"`
+	Topic       string
+	PayloadType string
+	Payload     []byte
+	Error       string
+	Attempts    int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (d *DeadLetter) ToDto() *deadLetterModel.DeadLetter {
+	return &deadLetterModel.DeadLetter{
+		ID:          d.ID,
+		Topic:       d.Topic,
+		PayloadType: d.PayloadType,
+		Payload:     d.Payload,
+		Error:       d.Error,
+		Attempts:    d.Attempts,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package entity
+
+// EvmBlock is a db model used to track the hashes of the blocks processed by an EVM watcher,
+// so that reorganisations of the chain can be detected
+type EvmBlock struct {
+	EntityID string `gorm:"primaryKey"`
+	Number   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Hash     string
+}
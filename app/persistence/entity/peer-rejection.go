@@ -0,0 +1,53 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import (
+	"time"
+
+	peerModel "github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+)
+
+// PeerRejection is a db model used to persist the topic messages of peer validators, which were rejected
+type PeerRejection struct {
+	ID         uint64 `gorm:"primaryKey"`
+	TransferID string `gorm:"index"`
+	Signer     string `gorm:"index"`
+	Signature  string
+	Reason     string
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func (p *PeerRejection) ToDto() *peerModel.Rejection {
+	return &peerModel.Rejection{
+		TransferId: p.TransferID,
+		Signer:     p.Signer,
+		Signature:  p.Signature,
+		Reason:     p.Reason,
+		RejectedAt: p.CreatedAt,
+	}
+}
+
+// PeerSigningStats holds the aggregated signatures of a single signer. It is not persisted
+type PeerSigningStats struct {
+	Signer string
+	Signed int64
+	// AverageLatency is in nanoseconds
+	AverageLatency float64
+	// LastSigned is the latest consensus timestamp of a signature message in nanoseconds
+	LastSigned int64
+}
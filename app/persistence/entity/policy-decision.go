@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package entity
+
+import (
+	"time"
+
+	policyModel "github.com/limechain/hedera-eth-bridge-validator/app/model/policy"
+)
+
+// PolicyDecision is a db model used to persist the outcome of the signing policy for each transfer,
+// so that the rolling outflow caps and the velocity limits can be computed
+type PolicyDecision struct {
+	TransferID    string `gorm:"primaryKey"`
+	SourceChainID uint64
+	TargetChainID uint64
+	Asset         string
+	Originator    string
+	// Amount is in whole token units of the target asset
+	Amount    string
+	UsdAmount string
+	Held      bool
+	Reason    string
+	CreatedAt time.Time
+}
+
+func (p *PolicyDecision) ToDto() *policyModel.HeldTransfer {
+	return &policyModel.HeldTransfer{
+		TransferId:    p.TransferID,
+		SourceChainId: p.SourceChainID,
+		TargetChainId: p.TargetChainID,
+		Asset:         p.Asset,
+		Originator:    p.Originator,
+		Amount:        p.Amount,
+		UsdAmount:     p.UsdAmount,
+		Reason:        p.Reason,
+		HeldAt:        p.CreatedAt,
+	}
+}
@@ -28,4 +28,9 @@ const (
 	Failed = "FAILED"
 	// Submitted is set when a pending Fee/Schedule operation is created.
 	Submitted = "SUBMITTED"
+	// Reorged is a status set once the source event of a Transfer gets removed from the chain by a reorganisation.
+	// This is a terminal status
+	Reorged = "REORGED"
+	// Held is a status set once a Transfer breaks the signing policy of the validator and does not get signed.
+	Held = "HELD"
 )
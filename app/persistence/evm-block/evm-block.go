@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package evm_block
+
+import (
+	"errors"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db: dbClient,
+	}
+}
+
+func (r *Repository) Create(entityID string, number int64, hash string) error {
+	return r.db.Create(&entity.EvmBlock{
+		EntityID: entityID,
+		Number:   number,
+		Hash:     hash,
+	}).Error
+}
+
+// Returns EvmBlock. Returns nil if not found
+func (r *Repository) Get(entityID string, number int64) (*entity.EvmBlock, error) {
+	block := &entity.EvmBlock{}
+
+	result := r.db.
+		Model(entity.EvmBlock{}).
+		Where("entity_id = ? AND number = ?", entityID, number).
+		First(block)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return block, nil
+}
+
+// GetAll returns all blocks of the entity, ordered by number descending
+func (r *Repository) GetAll(entityID string) ([]*entity.EvmBlock, error) {
+	var blocks []*entity.EvmBlock
+
+	err := r.db.
+		Where("entity_id = ?", entityID).
+		Order("number desc").
+		Find(&blocks).Error
+	return blocks, err
+}
+
+// DeleteAfter deletes the blocks of the entity with a number greater than the given one
+func (r *Repository) DeleteAfter(entityID string, number int64) error {
+	return r.db.
+		Where("entity_id = ? AND number > ?", entityID, number).
+		Delete(&entity.EvmBlock{}).
+		Error
+}
+
+// DeleteBefore deletes the blocks of the entity with a number lower than the given one
+func (r *Repository) DeleteBefore(entityID string, number int64) error {
+	return r.db.
+		Where("entity_id = ? AND number < ?", entityID, number).
+		Delete(&entity.EvmBlock{}).
+		Error
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package evm_block
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository    *Repository
+	dbConn        *gorm.DB
+	sqlMock       sqlmock.Sqlmock
+	entityID      = "80001-0x0000000000000000000000000000000000000001"
+	number        = int64(100)
+	hash          = "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23a3a6b5a8cbb3b6b4b1a2c3d4"
+	expectedBlock = &entity.EvmBlock{
+		EntityID: entityID,
+		Number:   number,
+		Hash:     hash,
+	}
+	columns = []string{"entity_id", "number", "hash"}
+	rowArgs = []driver.Value{entityID, number, hash}
+
+	createQuery       = regexp.QuoteMeta(`INSERT INTO "evm_blocks" ("entity_id","number","hash") VALUES ($1,$2,$3)`)
+	getQuery          = regexp.QuoteMeta(`SELECT * FROM "evm_blocks" WHERE entity_id = $1 AND number = $2 ORDER BY "evm_blocks"."entity_id" LIMIT 1`)
+	getAllQuery       = regexp.QuoteMeta(`SELECT * FROM "evm_blocks" WHERE entity_id = $1 ORDER BY number desc`)
+	deleteAfterQuery  = regexp.QuoteMeta(`DELETE FROM "evm_blocks" WHERE entity_id = $1 AND number > $2`)
+	deleteBeforeQuery = regexp.QuoteMeta(`DELETE FROM "evm_blocks" WHERE entity_id = $1 AND number < $2`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db: dbConn,
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_Create(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, createQuery, entityID, number, hash)
+
+	err := repository.Create(entityID, number, hash)
+	assert.Nil(t, err)
+}
+
+func Test_Create_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery, entityID, number, hash)
+
+	err := repository.Create(entityID, number, hash)
+	assert.NotNil(t, err)
+}
+
+func Test_Get(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getQuery, entityID, number)
+
+	actual, err := repository.Get(entityID, number)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBlock, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getQuery, entityID, number)
+
+	actual, err := repository.Get(entityID, number)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Get_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getQuery, entityID, number)
+
+	actual, err := repository.Get(entityID, number)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetAll(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getAllQuery, entityID)
+
+	actual, err := repository.GetAll(entityID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.EvmBlock{expectedBlock}, actual)
+}
+
+func Test_GetAll_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAllQuery, entityID)
+
+	actual, err := repository.GetAll(entityID)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_DeleteAfter(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, deleteAfterQuery, entityID, number)
+
+	err := repository.DeleteAfter(entityID, number)
+	assert.Nil(t, err)
+}
+
+func Test_DeleteAfter_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, deleteAfterQuery, entityID, number)
+
+	err := repository.DeleteAfter(entityID, number)
+	assert.NotNil(t, err)
+}
+
+func Test_DeleteBefore(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, deleteBeforeQuery, entityID, number)
+
+	err := repository.DeleteBefore(entityID, number)
+	assert.Nil(t, err)
+}
+
+func Test_DeleteBefore_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, deleteBeforeQuery, entityID, number)
+
+	err := repository.DeleteBefore(entityID, number)
+	assert.NotNil(t, err)
+}
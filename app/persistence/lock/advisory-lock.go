@@ -0,0 +1,148 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	tryAcquireQuery = `SELECT pg_try_advisory_lock($1)`
+	releaseQuery    = `SELECT pg_advisory_unlock($1)`
+	// Advisory locks on a bigint key are split into classid (high bits) and objid (low bits)
+	verifyQuery = `SELECT EXISTS (SELECT 1 FROM pg_locks WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND granted AND objsubid = 1 AND (classid::bigint << 32 | objid::bigint) = $1)`
+)
+
+var (
+	ErrNotAcquired = errors.New("lock is not acquired")
+	ErrNotHeld     = errors.New("lock is no longer held")
+)
+
+// AdvisoryLock is a session level Postgres advisory lock. The lock is held on a dedicated connection,
+// so that it gets released by Postgres once the connection of the holder is lost.
+type AdvisoryLock struct {
+	db        *gorm.DB
+	key       int64
+	keepAlive time.Duration
+	conn      *sql.Conn
+	mu        sync.Mutex
+}
+
+// NewAdvisoryLock instantiates the lock for the given key. Postgres probes the connection of the holder
+// after the given keep alive period of inactivity, so that the lock of an unreachable holder gets released
+func NewAdvisoryLock(db *gorm.DB, key int64, keepAlive time.Duration) *AdvisoryLock {
+	return &AdvisoryLock{
+		db:        db,
+		key:       key,
+		keepAlive: keepAlive,
+	}
+}
+
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		return true, nil
+	}
+
+	sqlDB, err := l.db.DB()
+	if err != nil {
+		return false, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = l.setKeepAlive(ctx, conn)
+	if err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, tryAcquireQuery, l.key).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+func (l *AdvisoryLock) Verify(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return ErrNotAcquired
+	}
+
+	var held bool
+	err := l.conn.QueryRowContext(ctx, verifyQuery, l.key).Scan(&held)
+	if err != nil {
+		return err
+	}
+	if !held {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// Release unlocks the lock and closes its dedicated connection
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(ctx, releaseQuery, l.key)
+	closeErr := l.conn.Close()
+	l.conn = nil
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+func (l *AdvisoryLock) setKeepAlive(ctx context.Context, conn *sql.Conn) error {
+	seconds := int(l.keepAlive.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	settings := []string{"tcp_keepalives_idle", "tcp_keepalives_interval"}
+	for _, setting := range settings {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("SET %s = %d", setting, seconds))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := conn.ExecContext(ctx, "SET tcp_keepalives_count = 2")
+	return err
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	lock      *AdvisoryLock
+	dbConn    *gorm.DB
+	sqlMock   sqlmock.Sqlmock
+	key       = int64(42)
+	keepAlive = 5 * time.Second
+	ctx       = context.Background()
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	lock = &AdvisoryLock{
+		db:        dbConn,
+		key:       key,
+		keepAlive: keepAlive,
+	}
+}
+
+func expectKeepAlive() {
+	sqlMock.ExpectExec(regexp.QuoteMeta("SET tcp_keepalives_idle = 5")).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(regexp.QuoteMeta("SET tcp_keepalives_interval = 5")).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(regexp.QuoteMeta("SET tcp_keepalives_count = 2")).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectAcquire(acquired bool) {
+	expectKeepAlive()
+	sqlMock.ExpectQuery(regexp.QuoteMeta(tryAcquireQuery)).
+		WithArgs(key).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(acquired))
+}
+
+func Test_NewAdvisoryLock(t *testing.T) {
+	setup()
+
+	actual := NewAdvisoryLock(dbConn, key, keepAlive)
+
+	assert.Equal(t, lock, actual)
+}
+
+func Test_TryAcquire(t *testing.T) {
+	setup()
+	expectAcquire(true)
+
+	acquired, err := lock.TryAcquire(ctx)
+
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+	assert.NotNil(t, lock.conn)
+	assert.Nil(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_TryAcquire_HeldByAnother(t *testing.T) {
+	setup()
+	expectAcquire(false)
+
+	acquired, err := lock.TryAcquire(ctx)
+
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+	assert.Nil(t, lock.conn)
+	assert.Nil(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_TryAcquire_Fails(t *testing.T) {
+	setup()
+	expectKeepAlive()
+	sqlMock.ExpectQuery(regexp.QuoteMeta(tryAcquireQuery)).
+		WithArgs(key).
+		WillReturnError(errors.New("some-error"))
+
+	acquired, err := lock.TryAcquire(ctx)
+
+	assert.Error(t, err)
+	assert.False(t, acquired)
+	assert.Nil(t, lock.conn)
+}
+
+func Test_Verify(t *testing.T) {
+	setup()
+	expectAcquire(true)
+	sqlMock.ExpectQuery(regexp.QuoteMeta(verifyQuery)).
+		WithArgs(key).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	lock.TryAcquire(ctx)
+
+	err := lock.Verify(ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_Verify_NotHeld(t *testing.T) {
+	setup()
+	expectAcquire(true)
+	sqlMock.ExpectQuery(regexp.QuoteMeta(verifyQuery)).
+		WithArgs(key).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	lock.TryAcquire(ctx)
+
+	err := lock.Verify(ctx)
+
+	assert.Equal(t, ErrNotHeld, err)
+}
+
+func Test_Verify_NotAcquired(t *testing.T) {
+	setup()
+
+	err := lock.Verify(ctx)
+
+	assert.Equal(t, ErrNotAcquired, err)
+}
+
+func Test_Release(t *testing.T) {
+	setup()
+	expectAcquire(true)
+	sqlMock.ExpectExec(regexp.QuoteMeta(releaseQuery)).
+		WithArgs(key).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	lock.TryAcquire(ctx)
+
+	err := lock.Release(ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, lock.conn)
+	assert.Nil(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_Release_NotAcquired(t *testing.T) {
+	setup()
+
+	err := lock.Release(ctx)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,330 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// TableName is the table tracking the applied migrations
+const TableName = "schema_migrations"
+
+const (
+	lockQuery   = `SELECT pg_advisory_lock($1)`
+	unlockQuery = `SELECT pg_advisory_unlock($1)`
+)
+
+var (
+	ErrSchemaOlder = errors.New("database schema is older than the one expected by the node")
+	ErrSchemaNewer = errors.New("database schema is newer than the one expected by the node")
+
+	//go:embed sql/*.sql
+	embedded embed.FS
+
+	// fileNamePattern matches migration files such as 0001_initial_schema.up.sql
+	fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+)
+
+// Migration is a numbered schema change together with the SQL reverting it
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration known to the node or to the database. AppliedAt is nil for pending migrations
+type Status struct {
+	Version   uint64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   uint64
+	Name      string
+	AppliedAt time.Time
+}
+
+// Migrator applies and rolls back the migrations embedded in the node
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	logger     *log.Entry
+}
+
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	return newMigrator(db, embedded, "sql")
+}
+
+func newMigrator(db *gorm.DB, files fs.FS, dir string) (*Migrator, error) {
+	migrations, err := load(files, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     config.GetLoggerFor("Migrator"),
+	}, nil
+}
+
+// Latest returns the version of the last migration known to the node
+func (m *Migrator) Latest() uint64 {
+	return uint64(len(m.migrations))
+}
+
+// Version returns the version of the last migration applied to the database. 0 if none were applied
+func (m *Migrator) Version() (uint64, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return 0, err
+	}
+	if len(applied) == 0 {
+		return 0, nil
+	}
+	return applied[len(applied)-1].Version, nil
+}
+
+// Verify returns an error unless the database schema is at the version expected by the node
+func (m *Migrator) Verify() error {
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case version < m.Latest():
+		return fmt.Errorf("%w: version [%d], expected [%d]. Run 'migrate up' to apply the pending migrations", ErrSchemaOlder, version, m.Latest())
+	case version > m.Latest():
+		return fmt.Errorf("%w: version [%d], expected [%d]. Upgrade the node or run 'migrate down' with the newer version", ErrSchemaNewer, version, m.Latest())
+	}
+	return nil
+}
+
+// Up applies all pending migrations in order, each in its own transaction. Returns the applied migrations
+func (m *Migrator) Up() ([]*Migration, error) {
+	unlock, err := m.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	version, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+	if version > m.Latest() {
+		return nil, fmt.Errorf("%w: version [%d], expected [%d]", ErrSchemaNewer, version, m.Latest())
+	}
+
+	applied := make([]*Migration, 0)
+	for _, migration := range m.migrations[version:] {
+		m.logger.Infof("Applying migration [%d] [%s] ...", migration.Version, migration.Name)
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Exec(fmt.Sprintf("INSERT INTO %s (version, name, applied_at) VALUES (?, ?, ?)", TableName),
+				migration.Version, migration.Name, time.Now().UTC()).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("failed to apply migration [%d] [%s]: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Down rolls back the given number of the last applied migrations, each in its own transaction.
+// Returns the rolled back migrations
+func (m *Migrator) Down(steps int) ([]*Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("invalid number of steps [%d]", steps)
+	}
+	unlock, err := m.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	version, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+	if version > m.Latest() {
+		return nil, fmt.Errorf("%w: version [%d], expected [%d]. Migrations unknown to the node cannot be rolled back", ErrSchemaNewer, version, m.Latest())
+	}
+
+	rolledBack := make([]*Migration, 0)
+	for ; steps > 0 && version > 0; steps-- {
+		migration := m.migrations[version-1]
+		m.logger.Infof("Rolling back migration [%d] [%s] ...", migration.Version, migration.Name)
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			return tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE version = ?", TableName), migration.Version).Error
+		})
+		if err != nil {
+			return rolledBack, fmt.Errorf("failed to roll back migration [%d] [%s]: %w", migration.Version, migration.Name, err)
+		}
+		rolledBack = append(rolledBack, migration)
+		version--
+	}
+
+	return rolledBack, nil
+}
+
+// Status returns the migrations known to the node, followed by the applied ones, which are unknown to it
+func (m *Migrator) Status() ([]*Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	appliedAt := make(map[uint64]time.Time, len(applied))
+	for _, a := range applied {
+		appliedAt[a.Version] = a.AppliedAt
+	}
+
+	result := make([]*Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := &Status{Version: migration.Version, Name: migration.Name}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		result = append(result, status)
+	}
+	for _, a := range applied {
+		if a.Version > m.Latest() {
+			at := a.AppliedAt
+			result = append(result, &Status{Version: a.Version, Name: a.Name, AppliedAt: &at})
+		}
+	}
+
+	return result, nil
+}
+
+// lock waits for the migration advisory lock, so that replicas starting together do not migrate the schema concurrently.
+// The lock is held on a dedicated connection until the returned function is called
+func (m *Migrator) lock() (func(), error) {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Debugf("Waiting for the migration lock ...")
+	_, err = conn.ExecContext(context.Background(), lockQuery, constants.MigrationLockKey)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to acquire the migration lock: %w", err)
+	}
+
+	return func() {
+		_, err := conn.ExecContext(context.Background(), unlockQuery, constants.MigrationLockKey)
+		if err != nil {
+			m.logger.Errorf("Failed to release the migration lock. Error: [%s]", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+func (m *Migrator) applied() ([]appliedMigration, error) {
+	err := m.db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version bigint PRIMARY KEY, name text NOT NULL, applied_at timestamptz NOT NULL)", TableName)).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create table [%s]: %w", TableName, err)
+	}
+
+	var applied []appliedMigration
+	err = m.db.Raw(fmt.Sprintf("SELECT version, name, applied_at FROM %s ORDER BY version", TableName)).Scan(&applied).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the applied migrations: %w", err)
+	}
+	return applied, nil
+}
+
+// load reads the migrations from the given directory. Versions have to start from 1 without gaps
+// and each migration has to have both an up and a down file
+func load(files fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name [%s]", entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name [%s]: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(files, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration [%d] has different names [%s] and [%s]", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for version := uint64(1); version <= uint64(len(byVersion)); version++ {
+		migration, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("migration [%d] is missing", version)
+		}
+		if strings.TrimSpace(migration.Up) == "" || strings.TrimSpace(migration.Down) == "" {
+			return nil, fmt.Errorf("migration [%d] [%s] has to have both up and down SQL", version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
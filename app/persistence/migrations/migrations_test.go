@@ -0,0 +1,250 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	createTableQuery = regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS schema_migrations (version bigint PRIMARY KEY, name text NOT NULL, applied_at timestamptz NOT NULL)`)
+	appliedQuery     = regexp.QuoteMeta(`SELECT version, name, applied_at FROM schema_migrations ORDER BY version`)
+	insertQuery      = regexp.QuoteMeta(`INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`)
+	deleteQuery      = regexp.QuoteMeta(`DELETE FROM schema_migrations WHERE version = $1`)
+	lockQueryRegex   = regexp.QuoteMeta(lockQuery)
+	unlockQueryRegex = regexp.QuoteMeta(unlockQuery)
+	appliedColumns   = []string{"version", "name", "applied_at"}
+	appliedAt        = time.Unix(1700000000, 0).UTC()
+
+	files = fstest.MapFS{
+		"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id bigint);")},
+		"sql/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"sql/0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id bigint);")},
+		"sql/0002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+	}
+)
+
+func Test_NewMigrator_Embedded(t *testing.T) {
+	migrator, err := NewMigrator(&gorm.DB{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), migrator.Latest())
+	assert.Equal(t, "initial_schema", migrator.migrations[0].Name)
+	assert.Contains(t, migrator.migrations[0].Down, "RAISE EXCEPTION")
+	assert.Equal(t, "queue_message_dead_letter_id", migrator.migrations[1].Name)
+}
+
+func Test_Load(t *testing.T) {
+	migrations, err := load(files, "sql")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Migration{
+		{Version: 1, Name: "create_a", Up: "CREATE TABLE a (id bigint);", Down: "DROP TABLE a;"},
+		{Version: 2, Name: "create_b", Up: "CREATE TABLE b (id bigint);", Down: "DROP TABLE b;"},
+	}, migrations)
+}
+
+func Test_Load_Invalid(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"invalid name": {"sql/create_a.up.sql": {Data: []byte("CREATE TABLE a (id bigint);")}},
+		"missing down": {"sql/0001_create_a.up.sql": {Data: []byte("CREATE TABLE a (id bigint);")}},
+		"gap": {
+			"sql/0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id bigint);")},
+			"sql/0002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+		},
+		"different names": {
+			"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id bigint);")},
+			"sql/0001_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			migrations, err := load(test, "sql")
+
+			assert.Nil(t, migrations)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_Up(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	helper.SqlMockPrepareExec(sqlMock, lockQueryRegex, constants.MigrationLockKey)
+	expectApplied(sqlMock, 1)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareExec(sqlMock, regexp.QuoteMeta("CREATE TABLE b (id bigint);"))
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, uint64(2), "create_b", sqlmock.AnyArg())
+	sqlMock.ExpectCommit()
+	helper.SqlMockPrepareExec(sqlMock, unlockQueryRegex, constants.MigrationLockKey)
+
+	applied, err := migrator.Up()
+
+	assert.Nil(t, err)
+	assert.Len(t, applied, 1)
+	assert.Equal(t, uint64(2), applied[0].Version)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Up_RollsBackFailedMigration(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	helper.SqlMockPrepareExec(sqlMock, lockQueryRegex, constants.MigrationLockKey)
+	expectApplied(sqlMock)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareExec(sqlMock, regexp.QuoteMeta("CREATE TABLE a (id bigint);"))
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, uint64(1), "create_a", sqlmock.AnyArg())
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(regexp.QuoteMeta("CREATE TABLE b (id bigint);")).WillReturnError(errors.New("some-error"))
+	sqlMock.ExpectRollback()
+	helper.SqlMockPrepareExec(sqlMock, unlockQueryRegex, constants.MigrationLockKey)
+
+	applied, err := migrator.Up()
+
+	assert.ErrorContains(t, err, "failed to apply migration [2] [create_b]")
+	assert.Len(t, applied, 1)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Up_SchemaNewer(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	helper.SqlMockPrepareExec(sqlMock, lockQueryRegex, constants.MigrationLockKey)
+	expectApplied(sqlMock, 1, 2, 3)
+	helper.SqlMockPrepareExec(sqlMock, unlockQueryRegex, constants.MigrationLockKey)
+
+	applied, err := migrator.Up()
+
+	assert.Nil(t, applied)
+	assert.ErrorIs(t, err, ErrSchemaNewer)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Down(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	helper.SqlMockPrepareExec(sqlMock, lockQueryRegex, constants.MigrationLockKey)
+	expectApplied(sqlMock, 1, 2)
+	for _, m := range []struct {
+		version uint64
+		down    string
+	}{{2, "DROP TABLE b;"}, {1, "DROP TABLE a;"}} {
+		sqlMock.ExpectBegin()
+		helper.SqlMockPrepareExec(sqlMock, regexp.QuoteMeta(m.down))
+		helper.SqlMockPrepareExec(sqlMock, deleteQuery, m.version)
+		sqlMock.ExpectCommit()
+	}
+	helper.SqlMockPrepareExec(sqlMock, unlockQueryRegex, constants.MigrationLockKey)
+
+	rolledBack, err := migrator.Down(5)
+
+	assert.Nil(t, err)
+	assert.Len(t, rolledBack, 2)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Up_LockFails(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	sqlMock.ExpectExec(lockQueryRegex).WithArgs(constants.MigrationLockKey).WillReturnError(errors.New("some-error"))
+
+	applied, err := migrator.Up()
+
+	assert.Nil(t, applied)
+	assert.ErrorContains(t, err, "failed to acquire the migration lock")
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Down_InvalidSteps(t *testing.T) {
+	migrator, sqlMock := setup(t)
+
+	rolledBack, err := migrator.Down(0)
+
+	assert.Nil(t, rolledBack)
+	assert.Error(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Verify(t *testing.T) {
+	tests := map[string]struct {
+		applied []uint64
+		err     error
+	}{
+		"up to date": {applied: []uint64{1, 2}},
+		"older":      {applied: []uint64{1}, err: ErrSchemaOlder},
+		"empty":      {err: ErrSchemaOlder},
+		"newer":      {applied: []uint64{1, 2, 3}, err: ErrSchemaNewer},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			migrator, sqlMock := setup(t)
+			expectApplied(sqlMock, test.applied...)
+
+			err := migrator.Verify()
+
+			if test.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+			helper.CheckSqlMockExpectationsMet(sqlMock, t)
+		})
+	}
+}
+
+func Test_Status(t *testing.T) {
+	migrator, sqlMock := setup(t)
+	expectApplied(sqlMock, 1)
+
+	statuses, err := migrator.Status()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Status{
+		{Version: 1, Name: "create_a", AppliedAt: &appliedAt},
+		{Version: 2, Name: "create_b"},
+	}, statuses)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func setup(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+	mocks.Setup()
+	db, sqlMock, _ := helper.SetupSqlMock()
+	migrator, err := newMigrator(db, files, "sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return migrator, sqlMock
+}
+
+// expectApplied mocks the schema_migrations table holding the given versions
+func expectApplied(sqlMock sqlmock.Sqlmock, versions ...uint64) {
+	helper.SqlMockPrepareExec(sqlMock, createTableQuery)
+	rows := sqlmock.NewRows(appliedColumns)
+	for _, version := range versions {
+		rows.AddRow([]driver.Value{version, "migration", appliedAt}...)
+	}
+	sqlMock.ExpectQuery(appliedQuery).WillReturnRows(rows)
+}
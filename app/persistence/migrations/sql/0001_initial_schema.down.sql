@@ -0,0 +1,7 @@
+-- The initial schema holds the whole state of the node. Rolling it back would drop every transfer,
+-- signature and schedule, so it is refused. Drop the tables manually to start from an empty database.
+DO $$
+BEGIN
+    RAISE EXCEPTION 'the initial schema cannot be rolled back, as it would drop all data of the node';
+END
+$$;
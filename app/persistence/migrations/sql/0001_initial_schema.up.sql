@@ -0,0 +1,122 @@
+-- The schema previously created by GORM AutoMigrate. Tables are created only if missing, so that databases
+-- migrated by older versions of the node get adopted without changes.
+
+CREATE TABLE IF NOT EXISTS transfers
+(
+    transaction_id  text NOT NULL,
+    source_chain_id bigint,
+    target_chain_id bigint,
+    native_chain_id bigint,
+    source_asset    text,
+    target_asset    text,
+    native_asset    text,
+    receiver        text,
+    amount          text,
+    fee             text,
+    status          text,
+    serial_number   bigint,
+    metadata        text,
+    is_nft          boolean DEFAULT false,
+    "timestamp"     bigint,
+    originator      text,
+    PRIMARY KEY (transaction_id)
+);
+CREATE INDEX IF NOT EXISTS idx_transfers_timestamp ON transfers ("timestamp" DESC);
+
+CREATE TABLE IF NOT EXISTS messages
+(
+    transfer_id           text,
+    hash                  text,
+    signature             text UNIQUE,
+    signer                text,
+    transaction_timestamp bigint,
+    CONSTRAINT fk_transfers_messages FOREIGN KEY (transfer_id) REFERENCES transfers (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS fees
+(
+    transaction_id text NOT NULL,
+    schedule_id    text,
+    amount         text,
+    status         text,
+    transfer_id    text,
+    PRIMARY KEY (transaction_id),
+    CONSTRAINT fk_transfers_fees FOREIGN KEY (transfer_id) REFERENCES transfers (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS schedules
+(
+    transaction_id text NOT NULL,
+    schedule_id    text,
+    has_receiver   boolean,
+    operation      text,
+    status         text,
+    transfer_id    text,
+    PRIMARY KEY (transaction_id),
+    CONSTRAINT fk_transfers_schedules FOREIGN KEY (transfer_id) REFERENCES transfers (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS statuses
+(
+    entity_id text,
+    last      bigint
+);
+
+CREATE TABLE IF NOT EXISTS queue_messages
+(
+    id           bigserial,
+    topic        text,
+    payload_type text,
+    payload      bytea,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS dead_letters
+(
+    id           bigserial,
+    topic        text,
+    payload_type text,
+    payload      bytea,
+    error        text,
+    attempts     bigint,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS evm_blocks
+(
+    entity_id text   NOT NULL,
+    number    bigint NOT NULL,
+    hash      text,
+    PRIMARY KEY (entity_id, number)
+);
+
+CREATE TABLE IF NOT EXISTS policy_decisions
+(
+    transfer_id     text NOT NULL,
+    source_chain_id bigint,
+    target_chain_id bigint,
+    asset           text,
+    originator      text,
+    amount          text,
+    usd_amount      text,
+    held            boolean,
+    reason          text,
+    created_at      timestamptz,
+    PRIMARY KEY (transfer_id)
+);
+
+CREATE TABLE IF NOT EXISTS peer_rejections
+(
+    id          bigserial,
+    transfer_id text,
+    signer      text,
+    signature   text,
+    reason      text,
+    created_at  timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_peer_rejections_transfer_id ON peer_rejections (transfer_id);
+CREATE INDEX IF NOT EXISTS idx_peer_rejections_signer ON peer_rejections (signer);
+CREATE INDEX IF NOT EXISTS idx_peer_rejections_created_at ON peer_rejections (created_at);
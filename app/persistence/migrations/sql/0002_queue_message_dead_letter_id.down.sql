@@ -0,0 +1,2 @@
+ALTER TABLE queue_messages
+    DROP COLUMN IF EXISTS dead_letter_id;
@@ -0,0 +1,2 @@
+ALTER TABLE queue_messages
+    ADD COLUMN IF NOT EXISTS dead_letter_id bigint NOT NULL DEFAULT 0;
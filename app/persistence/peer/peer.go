@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peer
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db: dbClient,
+	}
+}
+
+func (r *Repository) CreateRejection(rejection *entity.PeerRejection) error {
+	return r.db.Create(rejection).Error
+}
+
+// GetRejectionsSince returns the rejections, created at or after the given time, ordered by creation time descending
+func (r *Repository) GetRejectionsSince(since time.Time) ([]*entity.PeerRejection, error) {
+	var rejections []*entity.PeerRejection
+
+	err := r.db.
+		Where("created_at >= ?", since).
+		Order("created_at desc").
+		Find(&rejections).Error
+	return rejections, err
+}
+
+// GetSigningStatsSince returns the signing statistics of each signer for the transfers with timestamp at or after the given one
+func (r *Repository) GetSigningStatsSince(since int64) ([]*entity.PeerSigningStats, error) {
+	var stats []*entity.PeerSigningStats
+
+	err := r.db.
+		Table("messages").
+		Select("messages.signer AS signer, COUNT(*) AS signed, AVG(messages.transaction_timestamp - transfers.timestamp) AS average_latency, MAX(messages.transaction_timestamp) AS last_signed").
+		Joins("JOIN transfers ON transfers.transaction_id = messages.transfer_id").
+		Where("transfers.timestamp >= ?", since).
+		Group("messages.signer").
+		Scan(&stats).Error
+	return stats, err
+}
+
+// CountMissed returns the number of transfers to the given chain with timestamp within [from, to], which were not
+// signed by the given signer. Held and reorged transfers are not counted, as they are not expected to be signed
+func (r *Repository) CountMissed(signer string, targetChainId uint64, from, to int64) (int64, error) {
+	var count int64
+
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("status NOT IN ? AND target_chain_id = ? AND timestamp >= ? AND timestamp <= ?", []string{status.Held, status.Reorged}, targetChainId, from, to).
+		Where("NOT EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id AND messages.signer = ?)", signer).
+		Count(&count).Error
+	return count, err
+}
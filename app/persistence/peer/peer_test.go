@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peer
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository        *Repository
+	dbConn            *gorm.DB
+	sqlMock           sqlmock.Sqlmock
+	transferID        = "0.0.123-1234567890-123456789"
+	signer            = "0x0000000000000000000000000000000000000001"
+	createdAt         = time.Unix(1680000000, 0).UTC()
+	since             = int64(1680000000000000000)
+	until             = int64(1680086400000000000)
+	expectedRejection = &entity.PeerRejection{
+		ID:         1,
+		TransferID: transferID,
+		Signer:     signer,
+		Signature:  "some-signature",
+		Reason:     "wrong_amount",
+		CreatedAt:  createdAt,
+	}
+	expectedStats = &entity.PeerSigningStats{
+		Signer:         signer,
+		Signed:         5,
+		AverageLatency: 2000000000,
+		LastSigned:     1680000005000000000,
+	}
+	rejectionColumns = []string{"id", "transfer_id", "signer", "signature", "reason", "created_at"}
+	rejectionRowArgs = []driver.Value{uint64(1), transferID, signer, "some-signature", "wrong_amount", createdAt}
+	statsColumns     = []string{"signer", "signed", "average_latency", "last_signed"}
+	statsRowArgs     = []driver.Value{signer, int64(5), float64(2000000000), int64(1680000005000000000)}
+
+	createRejectionQuery      = regexp.QuoteMeta(`INSERT INTO "peer_rejections" ("transfer_id","signer","signature","reason","created_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)
+	getRejectionsSinceQuery   = regexp.QuoteMeta(`SELECT * FROM "peer_rejections" WHERE created_at >= $1 ORDER BY created_at desc`)
+	getSigningStatsSinceQuery = regexp.QuoteMeta(`SELECT messages.signer AS signer, COUNT(*) AS signed, AVG(messages.transaction_timestamp - transfers.timestamp) AS average_latency, MAX(messages.transaction_timestamp) AS last_signed FROM "messages" JOIN transfers ON transfers.transaction_id = messages.transfer_id WHERE transfers.timestamp >= $1 GROUP BY "messages"."signer"`)
+	countMissedQuery          = regexp.QuoteMeta(`SELECT count(*) FROM "transfers" WHERE (status NOT IN ($1,$2) AND target_chain_id = $3 AND timestamp >= $4 AND timestamp <= $5) AND (NOT EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id AND messages.signer = $6))`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db: dbConn,
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_CreateRejection(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{uint64(1)}, createRejectionQuery,
+		transferID, signer, "some-signature", "wrong_amount", sqlmock.AnyArg())
+
+	rejection := &entity.PeerRejection{
+		TransferID: transferID,
+		Signer:     signer,
+		Signature:  "some-signature",
+		Reason:     "wrong_amount",
+	}
+	err := repository.CreateRejection(rejection)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), rejection.ID)
+}
+
+func Test_CreateRejection_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, createRejectionQuery,
+		transferID, signer, "some-signature", "wrong_amount", sqlmock.AnyArg())
+
+	err := repository.CreateRejection(&entity.PeerRejection{
+		TransferID: transferID,
+		Signer:     signer,
+		Signature:  "some-signature",
+		Reason:     "wrong_amount",
+	})
+	assert.NotNil(t, err)
+}
+
+func Test_GetRejectionsSince(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, rejectionColumns, rejectionRowArgs, getRejectionsSinceQuery, createdAt)
+
+	actual, err := repository.GetRejectionsSince(createdAt)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.PeerRejection{expectedRejection}, actual)
+}
+
+func Test_GetRejectionsSince_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getRejectionsSinceQuery, createdAt)
+
+	actual, err := repository.GetRejectionsSince(createdAt)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetSigningStatsSince(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, statsColumns, statsRowArgs, getSigningStatsSinceQuery, since)
+
+	actual, err := repository.GetSigningStatsSince(since)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.PeerSigningStats{expectedStats}, actual)
+}
+
+func Test_GetSigningStatsSince_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getSigningStatsSinceQuery, since)
+
+	actual, err := repository.GetSigningStatsSince(since)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_CountMissed(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{int64(3)}, countMissedQuery,
+		status.Held, status.Reorged, uint64(80001), since, until, signer)
+
+	actual, err := repository.CountMissed(signer, 80001, since, until)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), actual)
+}
+
+func Test_CountMissed_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, countMissedQuery,
+		status.Held, status.Reorged, uint64(80001), since, until, signer)
+
+	actual, err := repository.CountMissed(signer, 80001, since, until)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(0), actual)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package policy
+
+import (
+	"errors"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db: dbClient,
+	}
+}
+
+func (r *Repository) Create(decision *entity.PolicyDecision) error {
+	return r.db.Create(decision).Error
+}
+
+// Returns PolicyDecision. Returns nil if not found
+func (r *Repository) Get(transferID string) (*entity.PolicyDecision, error) {
+	decision := &entity.PolicyDecision{}
+
+	result := r.db.
+		Model(entity.PolicyDecision{}).
+		Where("transfer_id = ?", transferID).
+		First(decision)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return decision, nil
+}
+
+// GetAllowedSince returns the decisions which allowed a transfer to be signed, created at or after the given time
+func (r *Repository) GetAllowedSince(since time.Time) ([]*entity.PolicyDecision, error) {
+	var decisions []*entity.PolicyDecision
+
+	err := r.db.
+		Where("held = ? AND created_at >= ?", false, since).
+		Find(&decisions).Error
+	return decisions, err
+}
+
+// GetHeld returns the decisions which held a transfer, ordered by creation time descending
+func (r *Repository) GetHeld() ([]*entity.PolicyDecision, error) {
+	var decisions []*entity.PolicyDecision
+
+	err := r.db.
+		Where("held = ?", true).
+		Order("created_at desc").
+		Find(&decisions).Error
+	return decisions, err
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package policy
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository       *Repository
+	dbConn           *gorm.DB
+	sqlMock          sqlmock.Sqlmock
+	transferID       = "0.0.123-1234567890-123456789"
+	createdAt        = time.Unix(1680000000, 0).UTC()
+	expectedDecision = &entity.PolicyDecision{
+		TransferID:    transferID,
+		SourceChainID: 296,
+		TargetChainID: 80001,
+		Asset:         "0x0000000000000000000000000000000000000001",
+		Originator:    "0.0.123",
+		Amount:        "150",
+		UsdAmount:     "375",
+		Held:          true,
+		Reason:        "some-reason",
+		CreatedAt:     createdAt,
+	}
+	columns = []string{"transfer_id", "source_chain_id", "target_chain_id", "asset", "originator", "amount", "usd_amount", "held", "reason", "created_at"}
+	rowArgs = []driver.Value{transferID, uint64(296), uint64(80001), expectedDecision.Asset, expectedDecision.Originator, "150", "375", true, "some-reason", createdAt}
+
+	createQuery          = regexp.QuoteMeta(`INSERT INTO "policy_decisions" ("transfer_id","source_chain_id","target_chain_id","asset","originator","amount","usd_amount","held","reason","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`)
+	getQuery             = regexp.QuoteMeta(`SELECT * FROM "policy_decisions" WHERE transfer_id = $1 ORDER BY "policy_decisions"."transfer_id" LIMIT 1`)
+	getAllowedSinceQuery = regexp.QuoteMeta(`SELECT * FROM "policy_decisions" WHERE held = $1 AND created_at >= $2`)
+	getHeldQuery         = regexp.QuoteMeta(`SELECT * FROM "policy_decisions" WHERE held = $1 ORDER BY created_at desc`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db: dbConn,
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_Create(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, createQuery, rowArgs...)
+
+	decision := *expectedDecision
+	err := repository.Create(&decision)
+	assert.Nil(t, err)
+}
+
+func Test_Create_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery, rowArgs...)
+
+	decision := *expectedDecision
+	err := repository.Create(&decision)
+	assert.NotNil(t, err)
+}
+
+func Test_Get(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getQuery, transferID)
+
+	actual, err := repository.Get(transferID)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDecision, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getQuery, transferID)
+
+	actual, err := repository.Get(transferID)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Get_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getQuery, transferID)
+
+	actual, err := repository.Get(transferID)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetAllowedSince(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getAllowedSinceQuery, false, createdAt)
+
+	actual, err := repository.GetAllowedSince(createdAt)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.PolicyDecision{expectedDecision}, actual)
+}
+
+func Test_GetAllowedSince_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAllowedSinceQuery, false, createdAt)
+
+	actual, err := repository.GetAllowedSince(createdAt)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetHeld(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getHeldQuery, true)
+
+	actual, err := repository.GetHeld()
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.PolicyDecision{expectedDecision}, actual)
+}
+
+func Test_GetHeld_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getHeldQuery, true)
+
+	actual, err := repository.GetHeld()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
@@ -138,6 +138,52 @@ func (r *Repository) UpdateStatusFailed(txId string) error {
 	return r.updateStatus(txId, status.Failed)
 }
 
+func (r *Repository) UpdateStatusReorged(txId string) error {
+	return r.updateStatus(txId, status.Reorged)
+}
+
+func (r *Repository) UpdateStatusHeld(txId string) error {
+	return r.updateStatus(txId, status.Held)
+}
+
+// GetBySourceChainFromTimestamp returns the Transfers from the given source chain with a timestamp greater than or equal to the given one
+func (r *Repository) GetBySourceChainFromTimestamp(sourceChainId uint64, timestamp int64) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("source_chain_id = ? AND timestamp >= ?", sourceChainId, timestamp).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range transfers {
+		r.updateHederaChainId(tx)
+	}
+	return transfers, nil
+}
+
+// GetUncompletedSigned returns the fungible Transfers in INITIAL status, which have collected signatures,
+// with preloaded Messages
+func (r *Repository) GetUncompletedSigned() ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+
+	err := r.db.
+		Preload("Messages").
+		Model(entity.Transfer{}).
+		Where("status = ? AND is_nft = ? AND EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id)", status.Initial, false).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range transfers {
+		r.updateHederaChainId(tx)
+	}
+	return transfers, nil
+}
+
 func formatTimestampFilter(q *gorm.DB, ts_query string) (*gorm.DB, error) {
 	qParams := strings.Split(ts_query, "&")
 	operators := map[string]string{
@@ -227,6 +273,9 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 	if f.TransactionId != "" {
 		q = q.Where("transaction_id LIKE ?", fmt.Sprintf(`%s%%`, f.TransactionId))
 	}
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
 
 	q = q.Count(&count).
 		Offset(int(offset)).
@@ -268,7 +317,9 @@ func (r *Repository) updateStatus(txId string, s string) error {
 	// Sanity check
 	if s != status.Initial &&
 		s != status.Completed &&
-		s != status.Failed {
+		s != status.Failed &&
+		s != status.Reorged &&
+		s != status.Held {
 		return errors.New("invalid status")
 	}
 
@@ -277,7 +328,7 @@ func (r *Repository) updateStatus(txId string, s string) error {
 		Where("transaction_id = ?", txId).
 		UpdateColumn("status", s)
 	if result.Error == nil {
-		if s == status.Failed {
+		if s == status.Failed || s == status.Reorged || s == status.Held {
 			r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, s)
 			return nil
 		}
@@ -190,6 +190,9 @@ var (
 	updateFeeQuery    = regexp.QuoteMeta(`UPDATE "transfers" SET "fee"=$1 WHERE transaction_id = $2`)
 	updateStatusQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2`)
 
+	getBySourceChainFromTimestampQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE source_chain_id = $1 AND timestamp >= $2`)
+	getUncompletedSignedQuery          = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 AND is_nft = $2 AND EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id)`)
+
 	// "SELECT count(*) FROM \"transfers\"\"
 	countQuery                      = regexp.QuoteMeta(`SELECT count(*) FROM "transfers"`)
 	pagedQuery                      = regexp.QuoteMeta(`SELECT * FROM "transfers" ORDER BY timestamp desc, status asc LIMIT 10 OFFSET 10`)
@@ -198,6 +201,7 @@ var (
 	pagedFilterFromToTimestampQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE timestamp <= $1 AND timestamp >= $2 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTransactionIdQuery   = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id LIKE $1 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTokenIdQuery         = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE (source_asset = $1 OR target_asset = $2) ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterStatusQuery          = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 ORDER BY timestamp desc, status asc LIMIT 10`)
 )
 
 func setup() {
@@ -477,6 +481,71 @@ func Test_UpdateStatusFailed_Err(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_UpdateStatusReorged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
+		status.Reorged,
+		transactionId)
+
+	err := repository.UpdateStatusReorged(transactionId)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatusHeld(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
+		status.Held,
+		transactionId)
+
+	err := repository.UpdateStatusHeld(transactionId)
+	assert.Nil(t, err)
+}
+
+func Test_GetBySourceChainFromTimestamp(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getBySourceChainFromTimestampQuery, expectedEntityTransfer.SourceChainID, nanoTime.UnixNano())
+
+	actual, err := repository.GetBySourceChainFromTimestamp(expectedEntityTransfer.SourceChainID, nanoTime.UnixNano())
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{expectedEntityTransfer}, actual)
+}
+
+func Test_GetBySourceChainFromTimestamp_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getBySourceChainFromTimestampQuery, expectedEntityTransfer.SourceChainID, nanoTime.UnixNano())
+
+	actual, err := repository.GetBySourceChainFromTimestamp(expectedEntityTransfer.SourceChainID, nanoTime.UnixNano())
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetUncompletedSigned(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getUncompletedSignedQuery, status.Initial, false)
+	helper.SqlMockPrepareQuery(sqlMock, messageColumns, messageRowArgs, getWithPreloadsMessagesQuery, transactionId)
+	expected := *expectedEntityTransfer
+	expected.Messages = []entity.Message{expectedEntityMessage}
+
+	actual, err := repository.GetUncompletedSigned()
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{&expected}, actual)
+}
+
+func Test_GetUncompletedSigned_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getUncompletedSignedQuery, status.Initial, false)
+
+	actual, err := repository.GetUncompletedSigned()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
 func Test_create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -715,6 +784,28 @@ func Test_PagedWithFilterTransactionId(t *testing.T) {
 	assert.NotEmpty(t, actual)
 }
 
+func Test_PagedWithFilterStatus(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Status: status.Held,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterStatusQuery, status.Held)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
 func Test_PagedWithFilterTokenId(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -17,6 +17,7 @@
 package burn_message
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -56,9 +57,9 @@ func Test_Handle_ProcessWrappedTransfer_Fails(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessWrappedTransfer", mt).Return(errors.New("some-error"))
+	mockedService.On("ProcessWrappedTransfer", mock.Anything, mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 }
 
 func Test_Handle_NotInitial(t *testing.T) {
@@ -72,20 +73,20 @@ func Test_Handle_NotInitial(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	ctHandler.Handle(&mt)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	ctHandler.Handle(context.Background(), &mt)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
 	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
-	ctHandler.Handle(&mt)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	ctHandler.Handle(context.Background(), &mt)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_Payload_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
-	ctHandler.Handle("string")
+	ctHandler.Handle(context.Background(), "string")
 	mockedService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
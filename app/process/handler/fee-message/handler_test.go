@@ -17,6 +17,7 @@
 package fee_message
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -26,6 +27,8 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -57,12 +60,12 @@ func Test_Handle(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessNativeTransfer", mt).Return(nil)
+	mockedService.On("ProcessNativeTransfer", mock.Anything, mt).Return(nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertCalled(t, "InitiateNewTransfer", mt)
-	mockedService.AssertCalled(t, "ProcessNativeTransfer", mt)
+	mockedService.AssertCalled(t, "ProcessNativeTransfer", mock.Anything, mt)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -70,7 +73,7 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	ctHandler.Handle(invalidTransferPayload)
+	assert.Error(t, ctHandler.Handle(context.Background(), invalidTransferPayload))
 
 	mockedService.AssertNotCalled(t, "InitiateNewTransfer")
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
@@ -81,7 +84,7 @@ func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 
 	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -98,7 +101,7 @@ func Test_Handle_StatusNotInitial_Fails(t *testing.T) {
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -114,7 +117,7 @@ func Test_Handle_ProcessNativeTransfer_Fails(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessNativeTransfer", mt).Return(errors.New("some-error"))
+	mockedService.On("ProcessNativeTransfer", mock.Anything, mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 }
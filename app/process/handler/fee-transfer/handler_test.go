@@ -17,6 +17,7 @@
 package fee_transfer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -51,8 +52,8 @@ func Test_Handle_Burn(t *testing.T) {
 		Receiver:      "",
 		Amount:        "0",
 	}
-	mocks.MBurnService.On("ProcessEvent", *someEvent).Return()
-	feeTransferHandler.Handle(someEvent)
+	mocks.MBurnService.On("ProcessEvent", *someEvent).Return(nil)
+	feeTransferHandler.Handle(context.Background(), someEvent)
 	mocks.MBurnService.AssertCalled(t, "ProcessEvent", *someEvent)
 }
 
@@ -61,7 +62,7 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	feeTransferHandler.Handle(invalidTransferPayload)
+	assert.Error(t, feeTransferHandler.Handle(context.Background(), invalidTransferPayload))
 
 	mocks.MBurnService.AssertNotCalled(t, "ProcessEvent")
 }
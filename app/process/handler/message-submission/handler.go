@@ -17,11 +17,11 @@
 package message_submission
 
 import (
-	"github.com/hashgraph/hedera-sdk-go/v2"
-	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"context"
+	"errors"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
-	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -30,91 +30,57 @@ import (
 
 // Handler is transfers event handler
 type Handler struct {
-	hederaNode         client.HederaNode
-	mirrorNode         client.MirrorNode
 	transfersService   service.Transfers
 	transferRepository repository.Transfer
-	topicID            hedera.TopicID
 	messageService     service.Messages
 	logger             *log.Entry
 }
 
 func NewHandler(
-	hederaNode client.HederaNode,
-	mirrorNode client.MirrorNode,
 	transfersService service.Transfers,
 	transferRepository repository.Transfer,
 	messageService service.Messages,
-	topicId string,
 ) *Handler {
-	topicID, err := hedera.TopicIDFromString(topicId)
-	if err != nil {
-		log.Fatalf("Invalid topic id: [%v]", topicId)
-	}
-
 	return &Handler{
-		hederaNode:         hederaNode,
-		mirrorNode:         mirrorNode,
 		logger:             config.GetLoggerFor("Topic Message Submission Handler"),
 		transfersService:   transfersService,
 		transferRepository: transferRepository,
 		messageService:     messageService,
-		topicID:            topicID,
 	}
 }
-
-func (smh Handler) Handle(p interface{}) {
+func (smh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		smh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 	transactionRecord, err := smh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		smh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = smh.submitMessage(transferMsg)
+	err = smh.submitMessage(ctx, transferMsg)
 	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
 		smh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
-	}
-}
-
-func (smh Handler) submitMessage(tm *payload.Transfer) error {
-	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(*tm)
-	if err != nil {
-		return err
-	}
-
-	messageTxId, err := smh.hederaNode.SubmitTopicConsensusMessage(
-		smh.topicID,
-		signatureMessageBytes)
-	if err != nil {
-		smh.logger.Errorf("[%s] - Failed to submit Signature Message to Topic. Error: [%s]", tm.TransactionId, err)
 		return err
 	}
 
-	// Attach update callbacks on Signature HCS Message
-	smh.logger.Infof("[%s] - Submitted signature on Topic [%s]", tm.TransactionId, smh.topicID)
-	onSuccessfulAuthMessage, onFailedAuthMessage := smh.authMessageSubmissionCallbacks(tm.TransactionId)
-	smh.mirrorNode.WaitForTransaction(hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
-func (smh Handler) authMessageSubmissionCallbacks(txId string) (onSuccess, onRevert func()) {
-	onSuccess = func() {
-		smh.logger.Debugf("Authorisation Signature TX successfully executed for TX [%s]", txId)
+func (smh Handler) submitMessage(ctx context.Context, tm *payload.Transfer) error {
+	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(*tm)
+	if err != nil {
+		return err
 	}
 
-	onRevert = func() {
-		smh.logger.Debugf("Authorisation Signature TX failed for TX ID [%s]", txId)
-	}
-	return onSuccess, onRevert
+	return smh.transfersService.SubmitSignatureMessage(ctx, tm.TransactionId, signatureMessageBytes)
 }
@@ -17,14 +17,13 @@
 package message_submission
 
 import (
+	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
-	"github.com/hashgraph/hedera-sdk-go/v2"
-	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
@@ -65,11 +64,6 @@ var (
 		Schedules:     nil,
 	}
 
-	topicId = hedera.TopicID{
-		Shard: 0,
-		Realm: 0,
-		Topic: 10,
-	}
 	fungibleMessage = &proto.TopicEthSignatureMessage{
 		SourceChainId: uint64(transferRecord.SourceChainID),
 		TargetChainId: uint64(transferRecord.TargetChainID),
@@ -80,32 +74,16 @@ var (
 		Signature:     "signature",
 	}
 	authMsgBytes, _ = auth_message.EncodeFungibleBytesFrom(transferRecord.SourceChainID, transferRecord.TargetChainID, transferRecord.TransactionID, transferRecord.TargetAsset, transferRecord.Receiver, transferRecord.Amount)
-	date            = time.Date(2001, time.June, 1, 1, 1, 1, 1, time.UTC)
-	txId            = &hedera.TransactionID{
-		AccountID: &hedera.AccountID{
-			Shard:   0,
-			Realm:   0,
-			Account: 2,
-		},
-		ValidStart: &date,
-	}
 )
 
 func Test_NewHandler(t *testing.T) {
 	mocks.Setup()
-	h := NewHandler(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService, "0.0.1111")
+	h := NewHandler(mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService)
 	assert.Equal(t, &Handler{
-		hederaNode:         mocks.MHederaNodeClient,
-		mirrorNode:         mocks.MHederaMirrorClient,
 		transfersService:   mocks.MTransferService,
 		transferRepository: mocks.MTransferRepository,
-		topicID: hedera.TopicID{
-			Shard: 0,
-			Realm: 0,
-			Topic: 1111,
-		},
-		messageService: mocks.MMessageService,
-		logger:         config.GetLoggerFor("Topic Message Submission Handler"),
+		messageService:     mocks.MMessageService,
+		logger:             config.GetLoggerFor("Topic Message Submission Handler"),
 	}, h)
 }
 
@@ -114,49 +92,57 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	msHandler.Handle(invalidTransferPayload)
+	assert.Error(t, msHandler.Handle(context.Background(), invalidTransferPayload))
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
 
 func Test_Invalid_Payload(t *testing.T) {
 	setup()
-	msHandler.Handle(tr)
+	msHandler.Handle(context.Background(), tr)
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
 }
 
-func Test_AuthMessageSubmissionCallbacks(t *testing.T) {
+func Test_Handle(t *testing.T) {
 	setup()
-	onSuccess, onFail := msHandler.authMessageSubmissionCallbacks("some-tx-id")
-	onSuccess()
-	onFail()
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
+	mocks.MTransferService.On("SubmitSignatureMessage", mock.Anything, tr.TransactionId, authMsgBytes).Return(nil)
+
+	err := msHandler.Handle(context.Background(), &tr)
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertCalled(t, "SubmitSignatureMessage", mock.Anything, tr.TransactionId, authMsgBytes)
 }
 
-func Test_Handle(t *testing.T) {
+func Test_Handle_SubmitSignatureMessageFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
-	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, nil)
-	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
-	msHandler.Handle(&tr)
+	mocks.MTransferService.On("SubmitSignatureMessage", mock.Anything, tr.TransactionId, authMsgBytes).Return(errors.New("some-error"))
+
+	err := msHandler.Handle(context.Background(), &tr)
+
+	assert.Error(t, err)
 }
 
-func Test_Handle_SubmitTopicConsensusMessageFails(t *testing.T) {
+func Test_Handle_Held(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
-	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, errors.New("some-error"))
-	msHandler.Handle(&tr)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return([]byte(nil), service.ErrTransferHeld)
+
+	err := msHandler.Handle(context.Background(), &tr)
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertNotCalled(t, "SubmitSignatureMessage", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, errors.New("some-error"))
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "SubmitSignatureMessage", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
@@ -164,10 +150,9 @@ func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
 	transferRecord.Status = "not-initial"
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "SubmitSignatureMessage", mock.Anything, mock.Anything, mock.Anything)
 
 	transferRecord.Status = status.Initial
 }
@@ -176,20 +161,16 @@ func Test_Handle_SignFungibleMessage_Fails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return([]byte{}, errors.New("some-error"))
-	msHandler.Handle(&tr)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	msHandler.Handle(context.Background(), &tr)
+	mocks.MTransferService.AssertNotCalled(t, "SubmitSignatureMessage", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func setup() {
 	mocks.Setup()
 	msHandler = &Handler{
-		hederaNode:         mocks.MHederaNodeClient,
-		mirrorNode:         mocks.MHederaMirrorClient,
 		transfersService:   mocks.MTransferService,
 		transferRepository: mocks.MTransferRepository,
 		messageService:     mocks.MMessageService,
-		topicID:            topicId,
 		logger:             config.GetLoggerFor("Hedera Mint and Transfer Handler"),
 	}
 }
@@ -17,6 +17,8 @@
 package message
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/dariubs/percent"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -45,6 +47,7 @@ type Handler struct {
 	participationRateGauge prometheus.Gauge
 	prometheusService      service.Prometheus
 	assetsService          service.Assets
+	relayer                service.Relayer
 }
 
 func NewHandler(
@@ -55,6 +58,7 @@ func NewHandler(
 	messages service.Messages,
 	prometheusService service.Prometheus,
 	assetsService service.Assets,
+	relayer service.Relayer,
 ) *Handler {
 	topicID, err := hedera.TopicIDFromString(topicId)
 	if err != nil {
@@ -80,93 +84,105 @@ func NewHandler(
 		prometheusService:      prometheusService,
 		participationRateGauge: participationRate,
 		assetsService:          assetsService,
+		relayer:                relayer,
 	}
 }
 
-func (cmh Handler) Handle(payload interface{}) {
+func (cmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	m, ok := payload.(*message.Message)
 	if !ok {
-		cmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return fmt.Errorf("could not cast payload [%v]", payload)
+	}
+
+	if m.Version > 0 {
+		cmh.logger.Debugf("Received message of envelope version [%d] from validator [%s], created at [%d].", m.Version, m.Validator, m.CreatedAt)
 	}
 
 	switch msg := m.Message.(type) {
 	case *proto.TopicMessage_FungibleSignatureMessage:
 		msgHelper.UpdateHederaChainIdOfFungibleMsg(msg.FungibleSignatureMessage)
-		cmh.handleFungibleSignatureMessage(msg.FungibleSignatureMessage, m.TransactionTimestamp)
-		break
+		return cmh.handleFungibleSignatureMessage(ctx, msg.FungibleSignatureMessage, m.TransactionTimestamp, m.Validator)
 	case *proto.TopicMessage_NftSignatureMessage:
 		msgHelper.UpdateHederaChainIdOfNftMsg(msg.NftSignatureMessage)
-		cmh.handleNftSignatureMessage(msg.NftSignatureMessage, m.TransactionTimestamp)
-		break
+		return cmh.handleNftSignatureMessage(ctx, msg.NftSignatureMessage, m.TransactionTimestamp, m.Validator)
 	default:
-		cmh.logger.Errorf("Invalid topic message provided: [%v]", msg)
-		break
+		return fmt.Errorf("invalid topic message provided [%v]", msg)
 	}
 }
 
-// handleFungibleSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
-func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMessage, timestamp int64) {
+// handleFungibleSignatureMessage is the main component responsible for the processing of new incoming Signature Messages.
+// Invalid signatures are dropped, while any other failure is returned, so that the message gets dead-lettered
+func (cmh Handler) handleFungibleSignatureMessage(ctx context.Context, tsm *proto.TopicEthSignatureMessage, timestamp int64, validator string) error {
 
 	valid, err := cmh.messages.SanityCheckFungibleSignature(tsm)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
-		return
+		return dropRejected(err)
 	}
 	if !valid {
 		cmh.logger.Errorf("[%s] - Incoming signature is invalid", tsm.TransferID)
-		return
+		return nil
 	}
 
 	// Parse incoming message
 	authMsgBytes, err := auth_message.EncodeFungibleBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tsm.Recipient, tsm.Amount)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tsm.TransferID, err)
-		return
+		return nil
 	}
 
-	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
+	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes, validator)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process signature [%s]", tsm.TransferID, tsm.GetSignature())
-		return
+		return dropRejected(err)
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false)
+	return cmh.completeTransfer(ctx, tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false, timestamp)
 }
 
-// handleNftSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
-func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMessage, timestamp int64) {
+// handleNftSignatureMessage is the main component responsible for the processing of new incoming Signature Messages.
+// Invalid signatures are dropped, while any other failure is returned, so that the message gets dead-lettered
+func (cmh Handler) handleNftSignatureMessage(ctx context.Context, tsm *proto.TopicEthNftSignatureMessage, timestamp int64, validator string) error {
 	valid, err := cmh.messages.SanityCheckNftSignature(tsm)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on nft incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
-		return
+		return dropRejected(err)
 	}
 	if !valid {
 		cmh.logger.Errorf("[%s] - Incoming nft signature is invalid", tsm.TransferID)
-		return
+		return nil
 	}
 
 	// Parse incoming message
 	authMsgBytes, err := auth_message.EncodeNftBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, int64(tsm.TokenId), tsm.Metadata, tsm.Recipient)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to encode the authorisation nft signature. Error: [%s]", tsm.TransferID, err)
-		return
+		return nil
 	}
 
-	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
+	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes, validator)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process nft signature [%s]", tsm.TransferID, tsm.GetSignature())
-		return
+		return dropRejected(err)
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
+	return cmh.completeTransfer(ctx, tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true, timestamp)
+}
+
+// dropRejected drops the errors of signatures rejected as invalid, as they are already reported to the peers service
+// and handling them again would fail the same way
+func dropRejected(err error) error {
+	if errors.Is(err, service.ErrUnknownTransfer) || errors.Is(err, service.ErrInvalidSignature) {
+		return nil
+	}
+	return err
 }
 
-func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool) {
+func (cmh Handler) completeTransfer(ctx context.Context, transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool, timestamp int64) error {
 	majorityReached, err := cmh.checkMajority(transferID, targetChainId)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not determine whether majority was reached. Error: [%s]", transferID, err)
-		return
+		return err
 	}
 
 	if majorityReached {
@@ -181,11 +197,19 @@ func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChai
 				cmh.logger,
 			)
 		}
+		// In relayer mode fungible transfers get completed once the claim transaction is observed on the target chain
+		if cmh.relayer != nil && !isNFT {
+			cmh.relayer.Relay(ctx, transferID, targetChainId, timestamp)
+			return nil
+		}
 		err = cmh.transferRepository.UpdateStatusCompleted(transferID)
 		if err != nil {
 			cmh.logger.Errorf("[%s] - Failed to complete. Error: [%s]", transferID, err)
+			return err
 		}
 	}
+
+	return nil
 }
 
 func (cmh *Handler) checkMajority(transferID string, targetChainId uint64) (majorityReached bool, err error) {
@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -66,12 +67,12 @@ var (
 
 func Test_NewHandler(t *testing.T) {
 	setup()
-	assert.Equal(t, h, NewHandler(topicId.String(), mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService))
+	assert.Equal(t, h, NewHandler(topicId.String(), mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService, nil))
 }
 
 func Test_Handle_Fails(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
+	assert.Error(t, h.Handle(context.Background(), "invalid-payload"))
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything)
 	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
@@ -80,51 +81,87 @@ func Test_Handle_Fails(t *testing.T) {
 func Test_HandleSignatureMessage_SanityCheckFails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, errors.New("some-error"))
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Error(t, err)
+	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", tsm)
+}
+
+func Test_HandleSignatureMessage_SanityCheckUnknownTransfer(t *testing.T) {
+	setup()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, fmt.Errorf("some-error: %w", service.ErrUnknownTransfer))
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Nil(t, err)
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", tsm)
 }
 
 func Test_HandleSignatureMessage_SanityCheckIsNotValid(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, nil)
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Nil(t, err)
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", tsm)
 }
 
 func Test_HandleSignatureMessage_ProcessSignatureFails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(errors.New("some-error"))
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(errors.New("some-error"))
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Error(t, err)
 	mocks.MTransferRepository.AssertNotCalled(t, "Update", mock.Anything)
 	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
 }
 
+func Test_HandleSignatureMessage_ProcessSignatureInvalid(t *testing.T) {
+	setup()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(fmt.Errorf("%w: some-error", service.ErrInvalidSignature))
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Nil(t, err)
+	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
+}
+
 func Test_HandleSignatureMessage_MajorityReached(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(nil)
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
 
+func Test_HandleSignatureMessage_MajorityReached_Relayer(t *testing.T) {
+	setup()
+	h.relayer = mocks.MRelayerService
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(nil)
+	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+	mocks.MRelayerService.On("Relay", mock.Anything, tsm.GetFungibleSignatureMessage().TransferID, TargetChainId, transactionTimestamp).Return()
+	h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	mocks.MRelayerService.AssertCalled(t, "Relay", mock.Anything, tsm.GetFungibleSignatureMessage().TransferID, TargetChainId, transactionTimestamp)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", mock.Anything)
+}
+
 func Test_Handle(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(nil)
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
-	h.Handle(&tsm)
+	err := h.Handle(context.Background(), &tsm)
+	assert.Nil(t, err)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
@@ -132,13 +169,14 @@ func Test_Handle(t *testing.T) {
 func Test_HandleSignatureMessage_UpdateStatusCompleted_Fails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(nil)
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(errors.New("some-error"))
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.handleFungibleSignatureMessage(context.Background(), tsm.GetFungibleSignatureMessage(), transactionTimestamp, "")
+	assert.Error(t, err)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted")
 }
@@ -146,9 +184,10 @@ func Test_HandleSignatureMessage_UpdateStatusCompleted_Fails(t *testing.T) {
 func Test_HandleSignatureMessage_CheckMajority_Fails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, "").Return(nil)
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, errors.New("some-error"))
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.Handle(context.Background(), &tsm)
+	assert.Error(t, err)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
@@ -17,6 +17,8 @@
 package mint_hts
 
 import (
+	"context"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -35,11 +37,10 @@ func NewHandler(lockService service.LockEvent) *Handler {
 	}
 }
 
-func (mhh Handler) Handle(p interface{}) {
+func (mhh Handler) Handle(ctx context.Context, p interface{}) error {
 	event, ok := p.(*payload.Transfer)
 	if !ok {
-		mhh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
-	mhh.lockService.ProcessEvent(*event)
+	return mhh.lockService.ProcessEvent(*event)
 }
@@ -17,6 +17,7 @@
 package mint_hts
 
 import (
+	"context"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -51,8 +52,8 @@ func Test_Handle_Lock(t *testing.T) {
 		Receiver:      "",
 		Amount:        "0",
 	}
-	mocks.MLockService.On("ProcessEvent", *tr).Return()
-	mintHtsHandler.Handle(tr)
+	mocks.MLockService.On("ProcessEvent", *tr).Return(nil)
+	mintHtsHandler.Handle(context.Background(), tr)
 	mocks.MLockService.AssertCalled(t, "ProcessEvent", *tr)
 }
 
@@ -61,7 +62,7 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	mintHtsHandler.Handle(invalidTransferPayload)
+	assert.Error(t, mintHtsHandler.Handle(context.Background(), invalidTransferPayload))
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
@@ -17,6 +17,7 @@
 package fee_message
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -29,6 +30,7 @@ import (
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -99,22 +101,22 @@ func Test_Handle(t *testing.T) {
 	setup()
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(nilErr)
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_CastError(t *testing.T) {
 	setup()
 	brokenPayload := "Not a transfer"
 
-	handler.Handle(brokenPayload)
+	assert.Error(t, handler.Handle(context.Background(), brokenPayload))
 
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_TransactionError(t *testing.T) {
@@ -122,22 +124,22 @@ func Test_Handle_TransactionError(t *testing.T) {
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, errors.New("failed to create record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_ProcessNativeNftTransferError(t *testing.T) {
 	setup()
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(errors.New("failed to process native NFT transfer"))
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(errors.New("failed to process native NFT transfer"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_NotInitial(t *testing.T) {
@@ -145,14 +147,14 @@ func Test_Handle_NotInitial(t *testing.T) {
 
 	resultEntityTransfer.Status = status.Submitted
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(nilErr)
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	resultEntityTransfer.Status = entityStatus
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 
 }
 
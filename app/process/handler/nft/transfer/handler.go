@@ -17,6 +17,9 @@
 package transfer
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"sync"
@@ -37,6 +40,7 @@ type Handler struct {
 	scheduleRepository repository.Schedule
 	scheduledService   service.Scheduled
 	transfersService   service.Transfers
+	policyService      service.Policy
 	logger             *log.Entry
 }
 
@@ -46,6 +50,7 @@ func NewHandler(
 	scheduleRepository repository.Schedule,
 	transfersService service.Transfers,
 	scheduledService service.Scheduled,
+	policyService service.Policy,
 ) *Handler {
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
 	if err != nil {
@@ -58,27 +63,27 @@ func NewHandler(
 		scheduleRepository: scheduleRepository,
 		scheduledService:   scheduledService,
 		transfersService:   transfersService,
+		policyService:      policyService,
 		logger:             config.GetLoggerFor("Hedera Native Scheduled Nft Transfer Handler"),
 	}
 }
 
-func (nth Handler) Handle(p interface{}) {
+func (nth Handler) Handle(ctx context.Context, p interface{}) error {
 	transfer, ok := p.(*payload.Transfer)
 	if !ok {
-		nth.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 
 	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transfer.TransactionId, transfer.Receiver, err)
-		return
+		return err
 	}
 
 	token, err := hedera.TokenIDFromString(transfer.TargetAsset)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", transfer.TransactionId, transfer.TargetAsset, err)
-		return
+		return err
 	}
 	nftID := hedera.NftID{
 		TokenID:      token,
@@ -88,12 +93,20 @@ func (nth Handler) Handle(p interface{}) {
 	transactionRecord, err := nth.transfersService.InitiateNewTransfer(*transfer)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		nth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	err = nth.policyService.Evaluate(*transfer)
+	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
+		return err
 	}
 
 	var statusResult string
@@ -103,4 +116,6 @@ func (nth Handler) Handle(p interface{}) {
 	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.TransactionId, &statusResult, wg)
 
 	nth.scheduledService.ExecuteScheduledNftAllowTransaction(transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+
+	return nil
 }
@@ -17,6 +17,7 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
@@ -125,7 +127,8 @@ func Test_NewHandler(t *testing.T) {
 		mocks.MTransferRepository,
 		mocks.MScheduleRepository,
 		mocks.MTransferService,
-		mocks.MScheduledService)
+		mocks.MScheduledService,
+		mocks.MPolicyService)
 
 	assert.Equal(t, handler, actualHandler)
 }
@@ -142,7 +145,8 @@ func Test_NewHandler_BridgeAccountError(t *testing.T) {
 		mocks.MTransferRepository,
 		mocks.MScheduleRepository,
 		mocks.MTransferService,
-		mocks.MScheduledService)
+		mocks.MScheduledService,
+		mocks.MPolicyService)
 
 	assert.Equal(t, true, fatal)
 }
@@ -160,6 +164,7 @@ func Test_Handle(t *testing.T) {
 	}
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
+	mocks.MPolicyService.On("Evaluate", *p).Return(nilErr)
 	mocks.MScheduledService.On("ExecuteScheduledNftAllowTransaction",
 		transactionId,
 		nftID,
@@ -167,7 +172,7 @@ func Test_Handle(t *testing.T) {
 		receiverAccountId,
 	).Return()
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledNftAllowTransaction",
@@ -181,7 +186,7 @@ func Test_Handle_CastError(t *testing.T) {
 	setup(t)
 	brokenPayload := "Not a transfer"
 
-	handler.Handle(brokenPayload)
+	assert.Error(t, handler.Handle(context.Background(), brokenPayload))
 
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer")
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
@@ -191,7 +196,7 @@ func Test_Handle_ReceiverError(t *testing.T) {
 	setup(t)
 	p.Receiver = ""
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	p.Receiver = receiver
 
@@ -203,7 +208,7 @@ func Test_Handle_TokenError(t *testing.T) {
 	setup(t)
 	p.TargetAsset = ""
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	p.TargetAsset = targetAsset
 
@@ -215,7 +220,7 @@ func Test_Handle_TransactionError(t *testing.T) {
 	setup(t)
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, errors.New("failed to create record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
@@ -226,7 +231,7 @@ func Test_Handle_NotInitialStatus(t *testing.T) {
 	resultEntityTransfer.Status = status.Submitted
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	resultEntityTransfer.Status = entityStatus
 
@@ -234,6 +239,17 @@ func Test_Handle_NotInitialStatus(t *testing.T) {
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
 }
 
+func Test_Handle_Held(t *testing.T) {
+	setup(t)
+	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
+	mocks.MPolicyService.On("Evaluate", *p).Return(service.ErrTransferHeld)
+
+	err := handler.Handle(context.Background(), p)
+
+	assert.Nil(t, err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftAllowTransaction")
+}
+
 func Test_scheduledTxMinedCallbacks(t *testing.T) {
 	setup(t)
 
@@ -489,6 +505,7 @@ func setup(t *testing.T) {
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
+		mocks.MPolicyService,
 		config.GetLoggerFor("Hedera Native Scheduled Nft Transfer Handler"),
 	}
 
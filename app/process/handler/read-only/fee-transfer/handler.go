@@ -17,10 +17,13 @@
 package fee_transfer
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -84,34 +87,33 @@ func NewHandler(
 	}
 }
 
-func (fmh *Handler) Handle(p interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 
 	receiver, err := hedera.AccountIDFromString(transferMsg.Receiver)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
-		return
+		return err
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	calculatedFee, remainder := fmh.feeService.CalculateFee(transferMsg.TargetAsset, intAmount)
@@ -124,7 +126,7 @@ func (fmh *Handler) Handle(p interface{}) {
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, _ := fmh.distributorService.CalculateMemberDistribution(validFee)
@@ -153,8 +155,8 @@ func (fmh *Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount, hasReceiver := util.TotalFeeFromTransfers(splitTransfer, receiver)
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirrorNodeTransaction.Response, error) {
-			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
+		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() *pagination.Pages[mirrorNodeTransaction.Transaction] {
+			return fmh.mirrorNode.IterateAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 		}, func(transactionID, scheduleID, status string) error {
 			result := false
 			if status == entityStatus.Completed {
@@ -199,6 +201,8 @@ func (fmh *Handler) Handle(p interface{}) {
 	}
 
 	fmh.startAwaitingFunctionsForMetrics(userOutParams, transferMsg, feeOutParams)
+
+	return nil
 }
 
 func (fmh *Handler) startAwaitingFunctionsForMetrics(userOutParams *hederaHelper.UserOutParams, transferMsg *payload.Transfer, feeOutParams *hederaHelper.FeeOutParams) {
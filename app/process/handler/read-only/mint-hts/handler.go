@@ -17,11 +17,14 @@
 package mint_hts
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	mirrorNode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -80,28 +83,27 @@ func NewHandler(
 	}
 }
 
-func (fmh *Handler) Handle(p interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	fmh.readOnlyService.FindTransfer(
 		transferMsg.TransactionId,
-		func() (*mirrorNode.Response, error) {
-			return fmh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
+		func() *pagination.Pages[mirrorNode.Transaction] {
+			return fmh.mirrorNode.IterateAccountTokenMintTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 			return fmh.scheduleRepository.Create(&entity.Schedule{
@@ -118,8 +120,8 @@ func (fmh *Handler) Handle(p interface{}) {
 
 	fmh.readOnlyService.FindTransfer(
 		transferMsg.TransactionId,
-		func() (*mirrorNode.Response, error) {
-			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.payerAccount, transferMsg.NetworkTimestamp)
+		func() *pagination.Pages[mirrorNode.Transaction] {
+			return fmh.mirrorNode.IterateAccountDebitTransactionsAfterTimestampString(fmh.payerAccount, transferMsg.NetworkTimestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 
@@ -154,4 +156,6 @@ func (fmh *Handler) Handle(p interface{}) {
 				},
 			})
 		})
+
+	return nil
 }
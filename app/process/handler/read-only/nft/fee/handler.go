@@ -17,10 +17,13 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -78,22 +81,21 @@ func NewHandler(
 	return instance
 }
 
-func (fmh Handler) Handle(p interface{}) {
+func (fmh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	fmh.readOnlyService.FindNftTransfer(
@@ -127,7 +129,7 @@ func (fmh Handler) Handle(p interface{}) {
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, _ := fmh.distributor.CalculateMemberDistribution(validFee)
@@ -141,17 +143,19 @@ func (fmh Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
 		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, constants.Hbar, splitTransfer,
-			func() (*mirror_node.Response, error) {
+			func() *pagination.Pages[mirror_node.Transaction] {
 				return fmh.feeTransfersFetch(transferMsg)
 			},
 			func(transactionID, scheduleID, status string) error {
 				return fmh.feeTransfersSave(transactionID, scheduleID, status, transferMsg, feeAmount)
 			})
 	}
+
+	return nil
 }
 
-func (fmh Handler) feeTransfersFetch(transferMsg *payload.Transfer) (*mirror_node.Response, error) {
-	return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
+func (fmh Handler) feeTransfersFetch(transferMsg *payload.Transfer) *pagination.Pages[mirror_node.Transaction] {
+	return fmh.mirrorNode.IterateAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 }
 
 func (fmh Handler) feeTransfersSave(transactionID string, scheduleID string, status string, transferMsg *payload.Transfer, feeAmount int64) error {
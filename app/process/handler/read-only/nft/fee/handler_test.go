@@ -17,6 +17,7 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"strconv"
@@ -24,7 +25,6 @@ import (
 	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
-	mirrorNodeErr "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/error"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -34,6 +34,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -173,7 +174,7 @@ func Test_Handle(t *testing.T) {
 	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 	mocks.MReadOnlyService.On("FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
@@ -187,7 +188,7 @@ func Test_Handle_ErrOnCast(t *testing.T) {
 	setup(t, true)
 	brokenPayload := "not a transfer"
 
-	handler.Handle(brokenPayload)
+	assert.Error(t, handler.Handle(context.Background(), brokenPayload))
 
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
@@ -201,7 +202,7 @@ func Test_Handle_ErrOnTransactionRecord(t *testing.T) {
 	var nilTransfer *entity.Transfer
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(nilTransfer, errors.New("failed to create transaction record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
@@ -215,7 +216,7 @@ func Test_Handle_TransactionRecordNotInitialStatus(t *testing.T) {
 	entityTransfer.Status = status.Completed
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	entityTransfer.Status = entityStatus
 
@@ -234,7 +235,7 @@ func Test_Handle_ErrOnUpdateFee(t *testing.T) {
 	mocks.MTransferRepository.On("UpdateFee", transactionId, formattedValidFee).Return(errors.New("failed to create transaction record"))
 	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
@@ -246,21 +247,17 @@ func Test_Handle_ErrOnUpdateFee(t *testing.T) {
 
 func Test_fetch(t *testing.T) {
 	setup(t, false)
-	expectedResponse := &transaction.Response{
-		Transactions: []transaction.Transaction{},
-		Status:       mirrorNodeErr.Status{},
-	}
+	expectedPages := helper.MakePages([]transaction.Transaction{})
 	mocks.MHederaMirrorClient.On(
-		"GetAccountDebitTransactionsAfterTimestampString",
+		"IterateAccountDebitTransactionsAfterTimestampString",
 		bridgeAccount,
 		p.NetworkTimestamp,
-	).Return(expectedResponse, nilErr)
+	).Return(expectedPages)
 
-	actualResponse, err := handler.feeTransfersFetch(p)
+	actualPages := handler.feeTransfersFetch(p)
 
-	assert.Nil(t, err)
-	assert.Equal(t, expectedResponse, actualResponse)
-	mocks.MHederaMirrorClient.AssertCalled(t, "GetAccountDebitTransactionsAfterTimestampString", bridgeAccount, p.NetworkTimestamp)
+	assert.Equal(t, expectedPages, actualPages)
+	mocks.MHederaMirrorClient.AssertCalled(t, "IterateAccountDebitTransactionsAfterTimestampString", bridgeAccount, p.NetworkTimestamp)
 }
 
 func Test_save(t *testing.T) {
@@ -17,7 +17,9 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -68,22 +70,21 @@ func NewHandler(
 	}
 }
 
-func (rnth Handler) Handle(p interface{}) {
+func (rnth Handler) Handle(ctx context.Context, p interface{}) error {
 	transfer, ok := p.(*payload.Transfer)
 	if !ok {
-		rnth.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%v]", p)
 	}
 
 	transactionRecord, err := rnth.transfersService.InitiateNewTransfer(*transfer)
 	if err != nil {
 		rnth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		rnth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	rnth.readOnlyService.FindScheduledNftAllowanceApprove(
@@ -108,4 +109,6 @@ func (rnth Handler) Handle(p interface{}) {
 			return rnth.transferRepository.UpdateStatusCompleted(transfer.TransactionId)
 		},
 	)
+
+	return nil
 }
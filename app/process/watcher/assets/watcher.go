@@ -17,6 +17,7 @@
 package assets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gookit/event"
@@ -24,6 +25,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -44,6 +46,7 @@ type Watcher struct {
 	bridgeCfg                  *config.Bridge
 	assetsService              service.Assets
 	paused                     bool
+	healthService              service.Health
 	logger                     *log.Entry
 }
 
@@ -53,6 +56,7 @@ func NewWatcher(
 	EvmFungibleTokenClients map[uint64]map[string]client.EvmFungibleToken,
 	EvmNonFungibleTokenClients map[uint64]map[string]client.EvmNft,
 	assetsService service.Assets,
+	healthService service.Health,
 ) *Watcher {
 
 	instance := &Watcher{
@@ -62,6 +66,7 @@ func NewWatcher(
 		bridgeCfg:                  bridgeCfg,
 		logger:                     config.GetLoggerFor(fmt.Sprintf("Assets Watcher on interval [%v]", sleepTime)),
 		assetsService:              assetsService,
+		healthService:              healthService,
 	}
 
 	event.On(constants.EventBridgeConfigUpdate, event.ListenerFunc(func(e event.Event) error {
@@ -75,25 +80,29 @@ func NewWatcher(
 	return instance
 }
 
-func (pw *Watcher) Watch(q qi.Queue) {
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
 
 	// there will be no handler, so the q is to implement the interface
+	pw.healthService.RegisterWatcher(constants.HealthAssetsWatcher, sleepTime)
 	go func() {
 		for {
+			sleep := pausedSleepTime
 			if !pw.paused {
-				pw.watchIteration()
-				time.Sleep(sleepTime)
-			} else {
-				time.Sleep(pausedSleepTime)
+				pw.healthService.Heartbeat(constants.HealthAssetsWatcher, pw.watchIteration())
+				sleep = sleepTime
+			}
+			if !syncHelper.Sleep(ctx, sleep) {
+				pw.logger.Infof("Stopped watching assets.")
+				return
 			}
 		}
 	}()
 }
 
-func (pw *Watcher) watchIteration() {
+func (pw *Watcher) watchIteration() error {
 	bridgeAccount, err := pw.getAccount(pw.bridgeCfg.Hedera.BridgeAccount)
 	if err != nil {
-		return
+		return err
 	}
 
 	hederaTokenBalances := bridgeAccount.Balance.GetAccountTokenBalancesByAddress()
@@ -101,6 +110,8 @@ func (pw *Watcher) watchIteration() {
 	nonFungibleAssets := pw.assetsService.NonFungibleNetworkAssets()
 	pw.updateAssetInfos(hederaTokenBalances, fungibleAssets, true)
 	pw.updateAssetInfos(hederaTokenBalances, nonFungibleAssets, false)
+
+	return nil
 }
 
 func (pw *Watcher) updateAssetInfos(hederaTokenBalances map[string]int, assets map[uint64][]string, isFungible bool) {
@@ -17,10 +17,13 @@
 package bridge_config
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	"time"
 )
@@ -29,40 +32,49 @@ type Watcher struct {
 	svc             service.BridgeConfig
 	pollingInterval time.Duration
 	topicID         hedera.TopicID
+	healthService   service.Health
 	logger          *log.Entry
 }
 
-func NewWatcher(svc service.BridgeConfig, topicID hedera.TopicID, pollingInterval time.Duration) *Watcher {
+func NewWatcher(svc service.BridgeConfig, topicID hedera.TopicID, pollingInterval time.Duration, healthService service.Health) *Watcher {
 	return &Watcher{
 		svc:             svc,
 		topicID:         topicID,
 		pollingInterval: pollingInterval,
+		healthService:   healthService,
 		logger:          config.GetLoggerFor("Bridge Config Watcher"),
 	}
 }
 
-func (w *Watcher) Watch(q qi.Queue) {
+func (w *Watcher) Watch(ctx context.Context, q qi.Queue) {
 	// there will be no handler, so the q is to implement the interface
+	w.healthService.RegisterWatcher(constants.HealthBridgeConfigWatcher, w.pollingInterval*time.Second)
 	go func() {
 		for {
-			w.watchIteration()
-			time.Sleep(w.pollingInterval * time.Second)
+			w.healthService.Heartbeat(constants.HealthBridgeConfigWatcher, w.watchIteration())
+			if !syncHelper.Sleep(ctx, w.pollingInterval*time.Second) {
+				w.logger.Infof("Stopped watching bridge config.")
+				return
+			}
 		}
 	}()
 }
 
-func (w *Watcher) watchIteration() {
+func (w *Watcher) watchIteration() error {
 	w.logger.Debugf("Checking for new bridge config ...")
 	parsedBridge, err := w.svc.ProcessLatestConfig(w.topicID)
 
 	if err != nil {
 		w.logger.Errorf(err.Error())
-		return
+		return err
 	}
 
 	if parsedBridge != nil {
 		if parsedBridge.PollingInterval != w.pollingInterval {
 			w.pollingInterval = parsedBridge.PollingInterval
+			w.healthService.RegisterWatcher(constants.HealthBridgeConfigWatcher, w.pollingInterval*time.Second)
 		}
 	}
+
+	return nil
 }
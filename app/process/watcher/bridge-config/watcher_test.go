@@ -17,14 +17,17 @@
 package bridge_config
 
 import (
+	"context"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 	"time"
 )
@@ -43,7 +46,7 @@ var (
 func Test_NewWatcher(t *testing.T) {
 	setup()
 
-	actualWatcher := NewWatcher(mocks.MBridgeConfigService, topicId, pollingInterval)
+	actualWatcher := NewWatcher(mocks.MBridgeConfigService, topicId, pollingInterval, mocks.MHealthService)
 
 	assert.Equal(t, watcher, actualWatcher)
 }
@@ -52,17 +55,32 @@ func Test_watchIteration(t *testing.T) {
 	setup()
 	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId).Return(&testConstants.ParserBridge, nil)
 
-	watcher.watchIteration()
+	err := watcher.watchIteration()
 
+	assert.Nil(t, err)
 	mocks.MBridgeConfigService.AssertCalled(t, "ProcessLatestConfig", topicId)
 }
 
+func Test_watchIteration_PollingIntervalChanged(t *testing.T) {
+	setup()
+	parsedBridge := testConstants.ParserBridge
+	parsedBridge.PollingInterval = 5
+	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId).Return(&parsedBridge, nil)
+
+	err := watcher.watchIteration()
+
+	assert.Nil(t, err)
+	assert.Equal(t, parsedBridge.PollingInterval, watcher.pollingInterval)
+	mocks.MHealthService.AssertCalled(t, "RegisterWatcher", constants.HealthBridgeConfigWatcher, 5*time.Second)
+}
+
 func Test_watchIteration_Error(t *testing.T) {
 	setup()
 	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId).Return(nilParser, errors.New("some error"))
 
-	watcher.watchIteration()
+	err := watcher.watchIteration()
 
+	assert.Error(t, err)
 	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId)
 }
 
@@ -70,16 +88,19 @@ func Test_Watch(t *testing.T) {
 	setup()
 	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId).Return(&testConstants.ParserBridge, nil)
 
-	watcher.Watch(qi.Queue(nil))
+	watcher.Watch(context.Background(), qi.Queue(nil))
 }
 
 func setup() {
 	mocks.Setup()
+	mocks.MHealthService.On("RegisterWatcher", mock.Anything, mock.Anything).Return()
+	mocks.MHealthService.On("Heartbeat", mock.Anything, mock.Anything).Return()
 
 	watcher = &Watcher{
 		svc:             mocks.MBridgeConfigService,
 		topicID:         topicId,
 		pollingInterval: pollingInterval,
+		healthService:   mocks.MHealthService,
 		logger:          config.GetLoggerFor("Bridge Config Watcher"),
 	}
 }
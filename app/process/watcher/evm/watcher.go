@@ -17,6 +17,7 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
@@ -41,15 +43,20 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	c "github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type Watcher struct {
-	repository repository.Status
+	repository         repository.Status
+	evmBlockRepository repository.EvmBlock
+	transferRepository repository.Transfer
 	// A unique database identifier, used as a key to track the progress
 	// of the given EVM watcher. Given that addresses between different
 	// EVM networks might be the same, a concatenation between
@@ -66,6 +73,13 @@ type Watcher struct {
 	validator           bool
 	filterConfig        FilterConfig
 	blacklistedAccounts []string
+	healthService       service.Health
+	healthName          string
+	maxReorgDepth       int64
+	subscribeLogs       bool
+	// Signals that a log, received through the subscription, has reached
+	// the required block confirmations and the next range can be processed
+	confirmed chan struct{}
 }
 
 // Certain node providers (Alchemy, Infura) have a limitation on how many blocks
@@ -78,6 +92,10 @@ const defaultMaxLogsBlocks = int64(500)
 // The default polling interval (in seconds) when querying for upcoming events/logs
 const defaultSleepDuration = 15 * time.Second
 
+// The default maximum depth (in blocks) of a chain reorganisation which the watcher can recover from.
+// Hashes of the processed blocks are kept for this many blocks back
+const defaultMaxReorgDepth = int64(1000)
+
 type FilterConfig struct {
 	abi               abi.ABI
 	topics            [][]common.Hash
@@ -93,6 +111,8 @@ type FilterConfig struct {
 
 func NewWatcher(
 	repository repository.Status,
+	evmBlockRepository repository.EvmBlock,
+	transferRepository repository.Transfer,
 	contracts service.Contracts,
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
@@ -103,7 +123,10 @@ func NewWatcher(
 	validator bool,
 	pollingInterval time.Duration,
 	maxLogsBlocks int64,
-	blacklistedAccounts []string) *Watcher {
+	maxReorgDepth int64,
+	subscribeLogs bool,
+	blacklistedAccounts []string,
+	healthService service.Health) *Watcher {
 	currentBlock, err := evmClient.RetryBlockNumber()
 	if err != nil {
 		log.Fatalf("Could not retrieve latest block. Error: [%s].", err)
@@ -154,6 +177,10 @@ func NewWatcher(
 		maxLogsBlocks:     maxLogsBlocks,
 	}
 
+	if maxReorgDepth == 0 {
+		maxReorgDepth = defaultMaxReorgDepth
+	}
+
 	if pollingInterval == 0 {
 		pollingInterval = defaultSleepDuration
 	} else {
@@ -183,6 +210,8 @@ func NewWatcher(
 	}
 	return &Watcher{
 		repository:          repository,
+		evmBlockRepository:  evmBlockRepository,
+		transferRepository:  transferRepository,
 		dbIdentifier:        dbIdentifier,
 		contracts:           contracts,
 		prometheusService:   prometheusService,
@@ -195,43 +224,63 @@ func NewWatcher(
 		sleepDuration:       pollingInterval,
 		filterConfig:        filterConfig,
 		blacklistedAccounts: blacklistedAccounts,
+		healthService:       healthService,
+		healthName:          fmt.Sprintf(constants.HealthEvmWatcherFormat, dbIdentifier),
+		maxReorgDepth:       maxReorgDepth,
+		subscribeLogs:       subscribeLogs,
+		confirmed:           make(chan struct{}, 1),
 	}
 }
 
-func (ew *Watcher) Watch(queue qi.Queue) {
-	go ew.beginWatching(queue)
+func (ew *Watcher) Watch(ctx context.Context, queue qi.Queue) {
+	ew.healthService.RegisterWatcher(ew.healthName, ew.sleepDuration)
+	go ew.beginWatching(ctx, queue)
+	if ew.subscribeLogs {
+		go ew.beginSubscription(ctx)
+	}
 
 	ew.logger.Infof("Listening for events at contract [%s]", ew.dbIdentifier)
 }
 
-func (ew Watcher) beginWatching(queue qi.Queue) {
+func (ew Watcher) beginWatching(ctx context.Context, queue qi.Queue) {
 	fromBlock, err := ew.repository.Get(ew.dbIdentifier)
 	if err != nil {
 		ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
-		time.Sleep(ew.sleepDuration)
-		ew.beginWatching(queue)
+		if ew.sleep(ctx) {
+			ew.beginWatching(ctx, queue)
+		}
 		return
 	}
 
 	ew.logger.Infof("Processing events from [%d]", fromBlock)
 
 	for {
+		if ctx.Err() != nil {
+			ew.logger.Infof("Stopped listening for events at contract [%s]", ew.dbIdentifier)
+			return
+		}
+
 		fromBlock, err := ew.repository.Get(ew.dbIdentifier)
 		if err != nil {
 			ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
+			ew.healthService.Heartbeat(ew.healthName, err)
 			continue
 		}
 
 		currentBlock, err := ew.evmClient.RetryBlockNumber()
 		if err != nil {
 			ew.logger.Errorf("Failed to retrieve latest block number. Error [%s]", err)
-			time.Sleep(ew.sleepDuration)
+			ew.healthService.Heartbeat(ew.healthName, err)
+			ew.sleep(ctx)
 			continue
 		}
 
-		toBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
+		headBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
+		toBlock := headBlock
 		if fromBlock > toBlock {
-			time.Sleep(ew.sleepDuration)
+			ew.healthService.Heartbeat(ew.healthName, nil)
+			ew.healthService.Progress(ew.healthName, fromBlock-1, 0)
+			ew.sleep(ctx)
 			continue
 		}
 
@@ -239,14 +288,286 @@ func (ew Watcher) beginWatching(queue qi.Queue) {
 			toBlock = fromBlock + ew.filterConfig.maxLogsBlocks
 		}
 
+		reorged, err := ew.checkReorg(ctx, fromBlock)
+		if err != nil {
+			ew.logger.Errorf("Failed to check for chain reorganisation. Error: [%s].", err)
+			ew.healthService.Heartbeat(ew.healthName, err)
+			ew.sleep(ctx)
+			continue
+		}
+		if reorged {
+			continue
+		}
+
+		toHeader, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(toBlock))
+		if err != nil {
+			ew.logger.Errorf("Failed to retrieve header of block [%d]. Error: [%s].", toBlock, err)
+			ew.healthService.Heartbeat(ew.healthName, err)
+			ew.sleep(ctx)
+			continue
+		}
+
 		err = ew.processLogs(fromBlock, toBlock, queue)
+		ew.healthService.Heartbeat(ew.healthName, err)
 		if err != nil {
 			ew.logger.Errorf("Failed to process logs. Error: [%s].", err)
-			time.Sleep(ew.sleepDuration)
+			ew.sleep(ctx)
 			continue
 		}
+		ew.recordBlock(toHeader)
+		ew.healthService.Progress(ew.healthName, toBlock, headBlock-toBlock)
+
+		ew.sleep(ctx)
+	}
+}
+
+// recordBlock stores the hash of the last block of the processed range, so that it can be compared
+// with the parent hash of the first block of the next range. Hashes older than the max reorg depth are pruned
+func (ew Watcher) recordBlock(header *types.Header) {
+	number := header.Number.Int64()
+	err := ew.evmBlockRepository.Create(ew.dbIdentifier, number, header.Hash().String())
+	if err != nil {
+		ew.logger.Errorf("Failed to record hash of block [%d]. Error: [%s].", number, err)
+		return
+	}
+
+	err = ew.evmBlockRepository.DeleteBefore(ew.dbIdentifier, number-ew.maxReorgDepth)
+	if err != nil {
+		ew.logger.Errorf("Failed to prune hashes of blocks before [%d]. Error: [%s].", number-ew.maxReorgDepth, err)
+	}
+}
+
+// checkReorg compares the parent hash of the given block with the recorded hash of the last processed block.
+// On mismatch, the watcher is rewound to the common ancestor and true is returned
+func (ew Watcher) checkReorg(ctx context.Context, fromBlock int64) (bool, error) {
+	parent, err := ew.evmBlockRepository.Get(ew.dbIdentifier, fromBlock-1)
+	if err != nil {
+		return false, err
+	}
+	if parent == nil {
+		return false, nil
+	}
+
+	header, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(fromBlock))
+	if err != nil {
+		return false, err
+	}
+	if header.ParentHash.String() == parent.Hash {
+		return false, nil
+	}
+
+	ew.logger.Warnf("Parent hash [%s] of block [%d] does not match the hash [%s] of the processed block. Chain reorganisation detected.",
+		header.ParentHash, fromBlock, parent.Hash)
+	return true, ew.rewind(ctx, parent.Number)
+}
+
+// rewind finds the latest recorded block which is still part of the chain and sets it as the last processed one.
+// If none of the recorded blocks is part of the chain, the watcher is rewound by the max reorg depth instead.
+// Transfers, whose source events were removed by the reorganisation, are marked as reorged
+func (ew Watcher) rewind(ctx context.Context, lastProcessedBlock int64) error {
+	blocks, err := ew.evmBlockRepository.GetAll(ew.dbIdentifier)
+	if err != nil {
+		return err
+	}
+
+	var ancestor *types.Header
+	for _, block := range blocks {
+		header, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(block.Number))
+		if err != nil {
+			return err
+		}
+		if header.Hash().String() == block.Hash {
+			ancestor = header
+			break
+		}
+	}
+	if ancestor == nil {
+		fallbackBlock := lastProcessedBlock - ew.maxReorgDepth
+		if fallbackBlock < 0 {
+			fallbackBlock = 0
+		}
+		ancestor, err = ew.evmClient.HeaderByNumber(ctx, big.NewInt(fallbackBlock))
+		if err != nil {
+			return err
+		}
+		ew.logger.Errorf("No common ancestor found within the max reorg depth of [%d] blocks. Rewinding to block [%d] with hash [%s]. "+
+			"The chain reorganisation may be deeper, transfers before this block must be verified manually!",
+			ew.maxReorgDepth, fallbackBlock, ancestor.Hash())
+	}
+
+	ancestorNumber := ancestor.Number.Int64()
+	depth := lastProcessedBlock - ancestorNumber
+	ew.logger.Errorf("Chain reorganisation of depth [%d] blocks. Rewinding to common ancestor [%d] with hash [%s].", depth, ancestorNumber, ancestor.Hash())
+	ew.reportReorg(depth)
+
+	err = ew.invalidateTransfers(ctx, ancestor)
+	if err != nil {
+		return err
+	}
+
+	err = ew.evmBlockRepository.DeleteAfter(ew.dbIdentifier, ancestorNumber)
+	if err != nil {
+		return err
+	}
+
+	return ew.repository.Update(ew.dbIdentifier, ancestorNumber+1)
+}
+
+// invalidateTransfers marks as reorged the transfers after the common ancestor, whose source event is no longer part of the chain
+func (ew Watcher) invalidateTransfers(ctx context.Context, ancestor *types.Header) error {
+	fromTimestamp := time.Unix(int64(ancestor.Time), 0).UnixNano()
+	transfers, err := ew.transferRepository.GetBySourceChainFromTimestamp(ew.evmClient.GetChainID(), fromTimestamp)
+	if err != nil {
+		return err
+	}
+
+	for _, transfer := range transfers {
+		if transfer.Status == status.Reorged {
+			continue
+		}
+
+		exists, err := ew.sourceEventExists(ctx, transfer.TransactionID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		ew.logger.Errorf("[%s] - Source event was removed by chain reorganisation. Transfer with status [%s] is marked as reorged.", transfer.TransactionID, transfer.Status)
+		err = ew.transferRepository.UpdateStatusReorged(transfer.TransactionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sourceEventExists checks whether the log with the given <tx-hash>-<log-index> transfer id is still part of the chain
+func (ew Watcher) sourceEventExists(ctx context.Context, transactionId string) (bool, error) {
+	parts := strings.Split(transactionId, "-")
+	if len(parts) != 2 {
+		return true, nil
+	}
+	logIndex, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return true, nil
+	}
+
+	receipt, err := ew.evmClient.GetClient().TransactionReceipt(ctx, common.HexToHash(parts[0]))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, l := range receipt.Logs {
+		if uint64(l.Index) == logIndex && l.Address == ew.contracts.Address() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (ew Watcher) reportReorg(depth int64) {
+	if !ew.prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	chainId := ew.evmClient.GetChainID()
+	ew.prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+		Name: fmt.Sprintf(constants.EvmReorgDepthGaugeNameFormat, chainId),
+		Help: constants.EvmReorgDepthGaugeHelp,
+	}).Set(float64(depth))
+	ew.prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+		Name: fmt.Sprintf(constants.EvmReorgsCounterNameFormat, chainId),
+		Help: constants.EvmReorgsCounterHelp,
+	}).Inc()
+}
+
+// sleep waits for the configured sleep duration. Returns false if the context got cancelled meanwhile
+func (ew Watcher) sleep(ctx context.Context) bool {
+	if !ew.subscribeLogs {
+		return syncHelper.Sleep(ctx, ew.sleepDuration)
+	}
+
+	timer := time.NewTimer(ew.sleepDuration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	case <-ew.confirmed:
+		return true
+	}
+}
+
+// beginSubscription subscribes for router logs and wakes up the range polling once a received log gets confirmed.
+// The logs themselves are processed by the range polling, starting from the last processed block, so that no events
+// are missed while the subscription is down
+func (ew Watcher) beginSubscription(ctx context.Context) {
+	query := ethereum.FilterQuery{
+		Addresses: ew.filterConfig.addresses,
+		Topics:    ew.filterConfig.topics,
+	}
+
+	for {
+		logs := make(chan types.Log)
+		subscription, err := ew.evmClient.SubscribeFilterLogs(ctx, query, logs)
+		if err != nil {
+			ew.logger.Warnf("Failed to subscribe for logs, falling back to polling. Error: [%s].", err)
+		} else {
+			ew.logger.Infof("Subscribed for logs at contract [%s]", ew.dbIdentifier)
+			err = ew.listen(ctx, subscription, logs)
+			subscription.Unsubscribe()
+			if err != nil {
+				ew.logger.Warnf("Logs subscription dropped, falling back to polling. Error: [%s].", err)
+			}
+		}
 
-		time.Sleep(ew.sleepDuration)
+		if !syncHelper.Sleep(ctx, ew.sleepDuration) {
+			return
+		}
+	}
+}
+
+// listen waits for logs until the subscription fails or the context gets cancelled
+func (ew Watcher) listen(ctx context.Context, subscription ethereum.Subscription, logs <-chan types.Log) error {
+	lastBlock := uint64(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-subscription.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case l := <-logs:
+			// Removed logs and logs from already awaited blocks do not require processing of a new range
+			if l.Removed || l.BlockNumber <= lastBlock {
+				continue
+			}
+			lastBlock = l.BlockNumber
+			go ew.notifyWhenConfirmed(ctx, l)
+		}
+	}
+}
+
+func (ew Watcher) notifyWhenConfirmed(ctx context.Context, l types.Log) {
+	err := ew.evmClient.WaitForConfirmations(ctx, l)
+	if err != nil {
+		ew.logger.Debugf("[%s] - Log was not confirmed, leaving it to the range polling. Error: [%s].", l.TxHash, err)
+		return
+	}
+
+	select {
+	case ew.confirmed <- struct{}{}:
+	default:
 	}
 }
 
@@ -272,6 +593,61 @@ func (ew Watcher) CheckBlacklistedOriginator(hash common.Hash) (*string, error)
 }
 
 func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
+	_, err := ew.handleLogs(fromBlock, endBlock, queue, false)
+	if err != nil {
+		return err
+	}
+
+	// Given that the log filtering boundaries are inclusive,
+	// the next time log filtering is done will start from the next block,
+	// so that processing of duplicate events does not occur
+	blockToBeUpdated := endBlock + 1
+
+	err = ew.repository.Update(ew.dbIdentifier, blockToBeUpdated)
+	if err != nil {
+		ew.logger.Errorf("Failed to update latest processed block [%d]. Error: [%s]", blockToBeUpdated, err)
+		return err
+	}
+
+	return nil
+}
+
+// Reprocess pushes the events between the `from` and `to` included blocks onto the queue, without moving
+// the checkpoint of the watcher. Returns the number of lock and burn events found
+func (ew Watcher) Reprocess(ctx context.Context, from, to int64, queue qi.Queue) (int, error) {
+	currentBlock, err := ew.evmClient.RetryBlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	headBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
+	if to > headBlock {
+		return 0, fmt.Errorf("block [%d] is not confirmed yet, latest confirmed block is [%d]", to, headBlock)
+	}
+
+	found := 0
+	for fromBlock := from; fromBlock <= to; fromBlock += ew.filterConfig.maxLogsBlocks + 1 {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		toBlock := fromBlock + ew.filterConfig.maxLogsBlocks
+		if toBlock > to {
+			toBlock = to
+		}
+		n, err := ew.handleLogs(fromBlock, toBlock, queue, true)
+		if err != nil {
+			return 0, err
+		}
+		found += n
+	}
+
+	return found, nil
+}
+
+// handleLogs filters the logs of the router between the given included blocks and handles them.
+// Reprocessed events are signed by validators even if they precede the start of the watcher.
+// Returns the number of lock and burn events found
+func (ew Watcher) handleLogs(fromBlock, endBlock int64, queue qi.Queue, reprocess bool) (int, error) {
 	query := ethereum.FilterQuery{
 		FromBlock: new(big.Int).SetInt64(fromBlock),
 		ToBlock:   new(big.Int).SetInt64(endBlock),
@@ -282,9 +658,10 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 	logs, err := ew.evmClient.RetryFilterLogs(query)
 	if err != nil {
 		ew.logger.Errorf("Failed to filter logs. Error: [%s]", err)
-		return err
+		return 0, err
 	}
 
+	found := 0
 	for _, log := range logs {
 		if len(log.Topics) > 0 {
 			if log.Topics[0] == ew.filterConfig.lockHash {
@@ -293,7 +670,8 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 					ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", lock.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleLockLog(lock, queue)
+				found++
+				ew.handleLockLog(lock, queue, reprocess)
 			} else if log.Topics[0] == ew.filterConfig.unlockHash {
 				unlock, err := ew.contracts.ParseUnlockLog(log)
 				if err != nil {
@@ -314,7 +692,8 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 					ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", burn.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleBurnLog(burn, queue)
+				found++
+				ew.handleBurnLog(burn, queue, reprocess)
 			} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
 				go ew.contracts.ReloadMembers()
 			} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
@@ -323,23 +702,13 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 					ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", event.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleBurnERC721(event, queue)
+				found++
+				ew.handleBurnERC721(event, queue, reprocess)
 			}
 		}
 	}
 
-	// Given that the log filtering boundaries are inclusive,
-	// the next time log filtering is done will start from the next block,
-	// so that processing of duplicate events does not occur
-	blockToBeUpdated := endBlock + 1
-
-	err = ew.repository.Update(ew.dbIdentifier, blockToBeUpdated)
-	if err != nil {
-		ew.logger.Errorf("Failed to update latest processed block [%d]. Error: [%s]", blockToBeUpdated, err)
-		return err
-	}
-
-	return nil
+	return found, nil
 }
 
 func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
@@ -356,9 +725,10 @@ func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
 	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
 	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
+	ew.completeTransfer(transactionId)
 }
 
-func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
+func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue, reprocess bool) {
 	ew.logger.Debugf("[%s] - New Burn Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -456,7 +826,7 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
+	if ew.validator && (reprocess || currentBlockNumber >= ew.targetBlock) {
 		if burnEvent.TargetChainId == constants.HederaNetworkId {
 			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.HederaFeeTransfer})
 		} else {
@@ -472,7 +842,7 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 	}
 }
 
-func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
+func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue, reprocess bool) {
 	ew.logger.Debugf("[%s] - New Lock Event Log received.", eventLog.Raw.TxHash)
 
 	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
@@ -563,7 +933,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
+	if ew.validator && (reprocess || currentBlockNumber >= ew.targetBlock) {
 		if tr.TargetChainId == constants.HederaNetworkId {
 			q.Push(&queue.Message{Payload: tr, Topic: constants.HederaMintHtsTransfer})
 		} else {
@@ -579,7 +949,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 	}
 }
 
-func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queue) {
+func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queue, reprocess bool) {
 	ew.logger.Debugf("[%s] - New Burn ERC-721 Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -649,7 +1019,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
+	if ew.validator && (reprocess || currentBlockNumber >= ew.targetBlock) {
 		if transfer.TargetChainId == constants.HederaNetworkId {
 			q.Push(&queue.Message{Payload: transfer, Topic: constants.HederaNftTransfer})
 		} else {
@@ -681,6 +1051,25 @@ func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
 	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
 	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
+	ew.completeTransfer(transactionId)
+}
+
+// completeTransfer marks the transfer as completed once it has been claimed on the router, which is the case for
+// transfers submitted by the relayers
+func (ew *Watcher) completeTransfer(transactionId string) {
+	transfer, err := ew.transferRepository.GetByTransactionId(transactionId)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to query transfer. Error: [%s]", transactionId, err)
+		return
+	}
+	if transfer == nil || transfer.Status == status.Completed {
+		return
+	}
+
+	err = ew.transferRepository.UpdateStatusCompleted(transactionId)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to complete claimed transfer. Error: [%s]", transactionId, err)
+	}
 }
 
 func (ew *Watcher) convertTargetAmount(sourceChainId, targetChainId uint64, sourceAsset, targetAsset string, amount *big.Int) (*big.Int, error) {
@@ -17,21 +17,26 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -105,7 +110,7 @@ func Test_HandleLockLog_Removed_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Raw.Removed = true
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 	lockLog.Raw.Removed = false
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -115,7 +120,7 @@ func Test_HandleLockLog_EmptyReceiver_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Receiver = []byte{}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 	lockLog.Receiver = hederaBytes
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -126,7 +131,7 @@ func Test_HandleLockLog_InvalidReceiver_Fails(t *testing.T) {
 	mocks.MEVMClient.On("GetChainID").Return(uint64(1))
 
 	lockLog.Receiver = []byte{1}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 	lockLog.Receiver = hederaBytes
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -137,7 +142,7 @@ func Test_HandleLockLog_EmptyWrappedAsset_Fails(t *testing.T) {
 	mocks.MEVMClient.On("GetChainID").Return(uint64(2))
 
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, uint64(2), targetChainId).Return("")
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -163,7 +168,30 @@ func Test_HandleLockLog_HappyPath(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.HederaMintHtsTransfer}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
+}
+
+func Test_HandleLockLog_ReprocessBeforeTargetBlock(t *testing.T) {
+	setup()
+	w.targetBlock = lockLog.Raw.BlockNumber + 1
+	key, _ := crypto.GenerateKey()
+	tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{}), types.HomesteadSigner{}, key)
+	targetChainId := lockLog.TargetChain.Uint64()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(int64(lockLog.Raw.BlockNumber))).Return(uint64(1))
+	mocks.MEVMClient.On("RetryTransactionByHash", lockLog.Raw.TxHash).Return(tx, nil)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(constants.Hbar)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, constants.Hbar).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", sourceChainId, tokenAddressString).Return(&asset.NativeAsset{ChainId: sourceChainId, Asset: tokenAddressString})
+	mocks.MPricingService.On("GetTokenPriceInfo", sourceChainId, tokenAddressString).Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(1)}, true)
+	mocks.MQueue.On("Push", mock.Anything).Return()
+
+	w.handleLockLog(lockLog, mocks.MQueue, true)
+
+	mocks.MQueue.AssertCalled(t, "Push", mock.MatchedBy(func(m *queue.Message) bool {
+		return m.Topic == constants.HederaMintHtsTransfer
+	}))
 }
 
 func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
@@ -200,7 +228,7 @@ func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 }
 
 func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
@@ -238,7 +266,7 @@ func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyTransferSave}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
@@ -265,7 +293,7 @@ func Test_HandleLockLog_TopicMessageSubmission(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.TopicMessageSubmission}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(lockLog, mocks.MQueue, false)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
@@ -294,7 +322,7 @@ func Test_HandleBurnLog_HappyPath(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaFeeTransfer}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 }
 
 func Test_HandleBurnLog_InvalidHederaRecipient(t *testing.T) {
@@ -303,7 +331,7 @@ func Test_HandleBurnLog_InvalidHederaRecipient(t *testing.T) {
 	burnLog.Receiver = []byte{1, 2, 3, 4}
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(hbarNativeAsset)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 	burnLog.Receiver = defaultReceiver
 }
 
@@ -337,7 +365,7 @@ func Test_HandleBurnLog_TopicMessageSubmission(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 	burnLog.TargetChain = big.NewInt(0)
 	burnLog.Token = defaultToken
 }
@@ -388,7 +416,7 @@ func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyTransferSave}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 	burnLog.TargetChain = big.NewInt(0)
 	burnLog.Token = defaultToken
 }
@@ -431,7 +459,7 @@ func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
 	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyHederaTransfer}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 }
 
 func Test_HandleBurnLog_Token_Not_Supported(t *testing.T) {
@@ -441,7 +469,7 @@ func Test_HandleBurnLog_Token_Not_Supported(t *testing.T) {
 	defaultToken := burnLog.Token
 	burnLog.Token = common.HexToAddress("0x0123123")
 	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(nilNativeAsset)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -455,7 +483,7 @@ func Test_HandleBurnLog_WrappedToWrapped_Not_Supported(t *testing.T) {
 	defaultTargetChain := burnLog.TargetChain
 	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(nilNativeAsset)
 	burnLog.TargetChain = big.NewInt(1)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 	burnLog.TargetChain = defaultTargetChain
@@ -465,7 +493,7 @@ func Test_HandleBurnLog_Raw_Removed(t *testing.T) {
 	setup()
 	burnLog.Raw.Removed = true
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -477,7 +505,7 @@ func Test_HandleBurnLog_No_Receivers(t *testing.T) {
 	receiver := burnLog.Receiver
 	burnLog.Receiver = []byte{}
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(burnLog, mocks.MQueue, false)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -524,6 +552,8 @@ func TestNewWatcher(t *testing.T) {
 	blacklist := []string{"0.0.444", "0x0123"}
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		evmBlockRepository:  mocks.MEvmBlockRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -536,9 +566,15 @@ func TestNewWatcher(t *testing.T) {
 		sleepDuration:       defaultSleepDuration,
 		filterConfig:        filterCfg,
 		blacklistedAccounts: blacklist,
+		healthService:       mocks.MHealthService,
+		healthName:          fmt.Sprintf(constants.HealthEvmWatcherFormat, dbIdentifier),
+		maxReorgDepth:       defaultMaxReorgDepth,
+		subscribeLogs:       true,
 	}
 
-	actual := NewWatcher(mocks.MStatusRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, blacklist)
+	actual := NewWatcher(mocks.MStatusRepository, mocks.MEvmBlockRepository, mocks.MTransferRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, 0, true, blacklist, mocks.MHealthService)
+	assert.NotNil(t, actual.confirmed)
+	actual.confirmed = nil
 	assert.Equal(t, w, actual)
 }
 
@@ -645,6 +681,248 @@ func Test_ProcessLogs_RepoUpdateFails(t *testing.T) {
 	assert.Equal(t, expectedErr, res)
 }
 
+func Test_Reprocess(t *testing.T) {
+	setup()
+	w.filterConfig.maxLogsBlocks = 2
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	for _, r := range [][2]int64{{0, 2}, {3, 5}} {
+		mocks.MEVMClient.On("RetryFilterLogs", ethereum.FilterQuery{
+			FromBlock: big.NewInt(r[0]),
+			ToBlock:   big.NewInt(r[1]),
+			Addresses: filterConfig.addresses,
+			Topics:    filterConfig.topics,
+		}).Return([]types.Log{}, nil)
+	}
+
+	found, err := w.Reprocess(context.Background(), 0, 5, mocks.MQueue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, found)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "RetryFilterLogs", 2)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_Reprocess_NotConfirmed(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+
+	_, err := w.Reprocess(context.Background(), 0, 6, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryFilterLogs", mock.Anything)
+}
+
+func Test_CheckReorg_NoRecordedParent(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(nil, nil)
+
+	reorged, err := w.checkReorg(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.False(t, reorged)
+	mocks.MEVMClient.AssertNotCalled(t, "HeaderByNumber", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_MatchingParent(t *testing.T) {
+	setup()
+	parent := &types.Header{Number: big.NewInt(9)}
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(&entity.EvmBlock{EntityID: dbIdentifier, Number: 9, Hash: parent.Hash().String()}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(10)).Return(&types.Header{Number: big.NewInt(10), ParentHash: parent.Hash()}, nil)
+
+	reorged, err := w.checkReorg(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.False(t, reorged)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_RewindsToCommonAncestor(t *testing.T) {
+	setup()
+	ancestor := &types.Header{Number: big.NewInt(8), Time: 100}
+	canonical := &types.Header{Number: big.NewInt(9), ParentHash: ancestor.Hash(), Extra: []byte{1}}
+	orphaned := &types.Header{Number: big.NewInt(9), ParentHash: ancestor.Hash()}
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(&entity.EvmBlock{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()}, nil)
+	mocks.MEvmBlockRepository.On("GetAll", dbIdentifier).Return([]*entity.EvmBlock{
+		{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()},
+		{EntityID: dbIdentifier, Number: 8, Hash: ancestor.Hash().String()},
+	}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(10)).Return(&types.Header{Number: big.NewInt(10), ParentHash: canonical.Hash()}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(9)).Return(canonical, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(8)).Return(ancestor, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	removedTxHash := common.HexToHash("0x01")
+	includedTxHash := common.HexToHash("0x02")
+	mocks.MTransferRepository.On("GetBySourceChainFromTimestamp", sourceChainId, int64(100_000_000_000)).Return([]*entity.Transfer{
+		{TransactionID: fmt.Sprintf("%s-1", removedTxHash.String()), Status: status.Completed},
+		{TransactionID: fmt.Sprintf("%s-2", includedTxHash.String()), Status: status.Completed},
+		{TransactionID: "0x03-3", Status: status.Reorged},
+	}, nil)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, removedTxHash).Return(nil, ethereum.NotFound)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, includedTxHash).Return(&types.Receipt{Logs: []*types.Log{{Index: 2, Address: tokenAddress}}}, nil)
+	mocks.MBridgeContractService.On("Address").Return(tokenAddress)
+	mocks.MTransferRepository.On("UpdateStatusReorged", fmt.Sprintf("%s-1", removedTxHash.String())).Return(nil)
+	mocks.MEvmBlockRepository.On("DeleteAfter", dbIdentifier, int64(8)).Return(nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(9)).Return(nil)
+
+	reorged, err := w.checkReorg(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusReorged", 1)
+	mocks.MEvmBlockRepository.AssertCalled(t, "DeleteAfter", dbIdentifier, int64(8))
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(9))
+}
+
+func Test_CheckReorg_NoCommonAncestor(t *testing.T) {
+	setup()
+	orphaned := &types.Header{Number: big.NewInt(9)}
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(&entity.EvmBlock{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()}, nil)
+	mocks.MEvmBlockRepository.On("GetAll", dbIdentifier).Return([]*entity.EvmBlock{
+		{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()},
+	}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(10)).Return(&types.Header{Number: big.NewInt(10)}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(9)).Return(&types.Header{Number: big.NewInt(9), Extra: []byte{1}}, nil)
+	fallback := &types.Header{Number: big.NewInt(4), Time: 100}
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(4)).Return(fallback, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MTransferRepository.On("GetBySourceChainFromTimestamp", sourceChainId, int64(100_000_000_000)).Return([]*entity.Transfer{}, nil)
+	mocks.MEvmBlockRepository.On("DeleteAfter", dbIdentifier, int64(4)).Return(nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(5)).Return(nil)
+	w.maxReorgDepth = 5
+
+	reorged, err := w.checkReorg(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	mocks.MEvmBlockRepository.AssertCalled(t, "DeleteAfter", dbIdentifier, int64(4))
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(5))
+}
+
+func Test_CheckReorg_NoCommonAncestor_FallbackFails(t *testing.T) {
+	setup()
+	orphaned := &types.Header{Number: big.NewInt(9)}
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(&entity.EvmBlock{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()}, nil)
+	mocks.MEvmBlockRepository.On("GetAll", dbIdentifier).Return([]*entity.EvmBlock{
+		{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()},
+	}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(10)).Return(&types.Header{Number: big.NewInt(10)}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(9)).Return(&types.Header{Number: big.NewInt(9), Extra: []byte{1}}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(0)).Return((*types.Header)(nil), errors.New("some-error"))
+
+	reorged, err := w.checkReorg(context.Background(), 10)
+
+	assert.NotNil(t, err)
+	assert.True(t, reorged)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_Cancelled(t *testing.T) {
+	setup()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	orphaned := &types.Header{Number: big.NewInt(9)}
+	mocks.MEvmBlockRepository.On("Get", dbIdentifier, int64(9)).Return(&entity.EvmBlock{EntityID: dbIdentifier, Number: 9, Hash: orphaned.Hash().String()}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", ctx, big.NewInt(10)).Return((*types.Header)(nil), context.Canceled)
+
+	reorged, err := w.checkReorg(ctx, 10)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, reorged)
+	mocks.MEvmBlockRepository.AssertNotCalled(t, "GetAll", mock.Anything)
+}
+
+func Test_RecordBlock(t *testing.T) {
+	setup()
+	header := &types.Header{Number: big.NewInt(1500)}
+	mocks.MEvmBlockRepository.On("Create", dbIdentifier, int64(1500), header.Hash().String()).Return(nil)
+	mocks.MEvmBlockRepository.On("DeleteBefore", dbIdentifier, int64(500)).Return(nil)
+
+	w.recordBlock(header)
+
+	mocks.MEvmBlockRepository.AssertCalled(t, "DeleteBefore", dbIdentifier, int64(500))
+}
+
+type subscription struct {
+	err chan error
+}
+
+func (s subscription) Unsubscribe() {}
+
+func (s subscription) Err() <-chan error {
+	return s.err
+}
+
+func Test_Listen_NotifiesConfirmedLogs(t *testing.T) {
+	setup()
+	w.subscribeLogs = true
+	w.confirmed = make(chan struct{}, 1)
+	sub := subscription{err: make(chan error, 1)}
+	logs := make(chan types.Log)
+	confirmedLog := types.Log{BlockNumber: 5}
+	mocks.MEVMClient.On("WaitForConfirmations", mock.Anything, confirmedLog).Return(nil)
+
+	result := make(chan error)
+	go func() {
+		result <- w.listen(context.Background(), sub, logs)
+	}()
+	logs <- types.Log{BlockNumber: 4, Removed: true}
+	logs <- confirmedLog
+
+	assert.True(t, w.sleep(context.Background()))
+	expectedErr := errors.New("connection lost")
+	sub.err <- expectedErr
+	assert.Equal(t, expectedErr, <-result)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "WaitForConfirmations", 1)
+}
+
+func Test_Listen_UnconfirmedLog(t *testing.T) {
+	setup()
+	w.subscribeLogs = true
+	w.confirmed = make(chan struct{}, 1)
+	unconfirmedLog := types.Log{BlockNumber: 5}
+	mocks.MEVMClient.On("WaitForConfirmations", mock.Anything, unconfirmedLog).Return(ethereum.NotFound)
+
+	w.notifyWhenConfirmed(context.Background(), unconfirmedLog)
+
+	assert.Len(t, w.confirmed, 0)
+}
+
+func Test_BeginSubscription_FallsBackToPolling(t *testing.T) {
+	setup()
+	w.subscribeLogs = true
+	w.sleepDuration = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MEVMClient.On("SubscribeFilterLogs", ctx, mock.Anything, mock.Anything).
+		Return(subscription{}, errors.New("notifications not supported")).
+		Run(func(args mock.Arguments) { cancel() })
+
+	w.beginSubscription(ctx)
+
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SubscribeFilterLogs", 1)
+}
+
+func Test_CompleteTransfer(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", "some-id").Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", "some-id").Return(nil)
+
+	w.completeTransfer("some-id")
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", "some-id")
+}
+
+func Test_CompleteTransfer_AlreadyCompleted(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", "some-id").Return(&entity.Transfer{Status: status.Completed}, nil)
+
+	w.completeTransfer("some-id")
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 
@@ -653,6 +931,8 @@ func setup() {
 
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		evmBlockRepository:  mocks.MEvmBlockRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -664,5 +944,6 @@ func setup() {
 		sleepDuration:       defaultSleepDuration,
 		filterConfig:        filterConfig,
 		blacklistedAccounts: []string{"0x0123", "0x4567"},
+		maxReorgDepth:       defaultMaxReorgDepth,
 	}
 }
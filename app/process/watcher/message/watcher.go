@@ -17,16 +17,20 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNodeMsg "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -37,18 +41,31 @@ import (
 
 type Watcher struct {
 	client           client.MirrorNode
+	node             client.HederaNode
 	topicID          hedera.TopicID
 	statusRepository repository.Status
 	pollingInterval  time.Duration
+	streamMessages   bool
+	healthService    service.Health
+	healthName       string
 	logger           *log.Entry
 }
 
+// The bounds of the exponential backoff between reconnects of the topic messages stream
+const (
+	minStreamBackoff = 1 * time.Second
+	maxStreamBackoff = 5 * time.Minute
+)
+
 func NewWatcher(
 	client client.MirrorNode,
+	node client.HederaNode,
 	topicID string,
 	repository repository.Status,
 	pollingInterval time.Duration,
-	startTimestamp int64) *Watcher {
+	startTimestamp int64,
+	streamMessages bool,
+	healthService service.Health) *Watcher {
 	id, err := hedera.TopicIDFromString(topicID)
 	if err != nil {
 		log.Fatalf("Could not start Consensus Topic Watcher for topic [%s] - Error: [%s]", topicID, err)
@@ -80,20 +97,29 @@ func NewWatcher(
 
 	return &Watcher{
 		client:           client,
+		node:             node,
 		topicID:          id,
 		statusRepository: repository,
 		pollingInterval:  pollingInterval,
+		streamMessages:   streamMessages,
+		healthService:    healthService,
+		healthName:       fmt.Sprintf(constants.HealthMessageWatcherFormat, topicID),
 		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
 
-func (cmw Watcher) Watch(q qi.Queue) {
+func (cmw Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !cmw.client.TopicExists(cmw.topicID) {
 		cmw.logger.Errorf("Could not start monitoring topic [%s] - Topic not found.", cmw.topicID.String())
 		return
 	}
 
-	cmw.beginWatching(q)
+	cmw.healthService.RegisterWatcher(cmw.healthName, cmw.pollingInterval*time.Second)
+	if cmw.streamMessages {
+		cmw.beginStreaming(ctx, q)
+		return
+	}
+	cmw.beginWatching(ctx, q)
 }
 
 func (cmw Watcher) updateStatusTimestamp(ts int64) {
@@ -104,7 +130,7 @@ func (cmw Watcher) updateStatusTimestamp(ts int64) {
 	cmw.logger.Tracef("Updated Topic Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (cmw Watcher) beginWatching(q qi.Queue) {
+func (cmw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := cmw.statusRepository.Get(cmw.topicID.String())
 	if err != nil {
 		cmw.logger.Fatalf("Failed to retrieve Topic Watcher Status timestamp. Error [%s]", err)
@@ -112,38 +138,241 @@ func (cmw Watcher) beginWatching(q qi.Queue) {
 	cmw.logger.Infof("Watching for Messages after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
 	for {
-		messages, err := cmw.client.GetMessagesAfterTimestamp(cmw.topicID, milestoneTimestamp, cmw.client.QueryDefaultLimit())
+		pages := cmw.client.IterateMessagesAfterTimestamp(cmw.topicID, milestoneTimestamp, cmw.client.QueryDefaultLimit())
+		var found int
+		milestoneTimestamp, found, err = cmw.processPages(ctx, pages, milestoneTimestamp, q)
+		cmw.healthService.Heartbeat(cmw.healthName, err)
 		if err != nil {
 			cmw.logger.Errorf("Error while retrieving messages from mirror node. Error [%s]", err)
-			time.Sleep(cmw.pollingInterval * time.Second)
-			go cmw.beginWatching(q)
+			if syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) {
+				go cmw.beginWatching(ctx, q)
+			}
+			return
+		}
+
+		cmw.reportProgress(milestoneTimestamp, found > 0)
+
+		if !syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) {
+			cmw.logger.Infof("Stopped watching for Messages.")
 			return
 		}
+	}
+}
+
+// processPages walks all pages of messages and processes them in order.
+// Returns the consensus timestamp of the last processed message and the number of messages found
+func (cmw Watcher) processPages(ctx context.Context, pages *pagination.Pages[mirrorNodeMsg.Message], milestoneTimestamp int64, q qi.Queue) (int64, int, error) {
+	found := 0
+	for pages.HasNext() && ctx.Err() == nil {
+		messages, err := pages.Next()
+		if err != nil {
+			return milestoneTimestamp, found, err
+		}
 
 		cmw.logger.Tracef("Polling found [%d] Messages", len(messages))
+		milestoneTimestamp = cmw.processMessages(ctx, messages, milestoneTimestamp, q)
+		found += len(messages)
+	}
 
-		for _, msg := range messages {
-			milestoneTimestamp, err = timestamp.FromString(msg.ConsensusTimestamp)
-			if err != nil {
-				cmw.logger.Errorf("Unable to parse latest message timestamp. Error - [%s].", err)
-				continue
-			}
+	return milestoneTimestamp, found, nil
+}
+
+// processMessages processes the given messages in order and persists the consensus timestamp of each one.
+// Returns the consensus timestamp of the last processed message
+func (cmw Watcher) processMessages(ctx context.Context, messages []mirrorNodeMsg.Message, milestoneTimestamp int64, q qi.Queue) int64 {
+	for _, msg := range messages {
+		if ctx.Err() != nil {
+			break
+		}
+
+		ts, err := timestamp.FromString(msg.ConsensusTimestamp)
+		if err != nil {
+			cmw.logger.Errorf("Unable to parse latest message timestamp. Error - [%s].", err)
+			continue
+		}
 
-			cmw.processMessage(msg, q)
+		cmw.processMessage(msg, q)
+		cmw.updateStatusTimestamp(ts)
+		milestoneTimestamp = ts
+	}
+	return milestoneTimestamp
+}
+
+// beginStreaming streams the topic messages from the mirror node gRPC API, resuming from the persisted timestamp.
+// While the stream is unavailable, the messages are polled from the REST API and the stream is reconnected with backoff
+func (cmw Watcher) beginStreaming(ctx context.Context, q qi.Queue) {
+	backoff := minStreamBackoff
+	for {
+		received, err := cmw.stream(ctx, q)
+		if ctx.Err() != nil {
+			cmw.logger.Infof("Stopped watching for Messages.")
+			return
+		}
+		if received {
+			backoff = minStreamBackoff
+		}
+
+		cmw.logger.Warnf("Topic messages stream is unavailable, falling back to polling for [%s]. Error: [%s]", backoff, err)
+		if !cmw.pollUntil(ctx, q, time.Now().Add(backoff)) {
+			cmw.logger.Infof("Stopped watching for Messages.")
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxStreamBackoff {
+			backoff = maxStreamBackoff
+		}
+	}
+}
+
+// stream processes the streamed messages until the subscription fails or the context gets cancelled.
+// Returns whether any message was received through the subscription
+func (cmw Watcher) stream(ctx context.Context, q qi.Queue) (bool, error) {
+	milestoneTimestamp, err := cmw.statusRepository.Get(cmw.topicID.String())
+	if err != nil {
+		return false, err
+	}
+
+	messages := make(chan hedera.TopicMessage)
+	errs := make(chan error, 1)
+	onNext := func(msg hedera.TopicMessage) {
+		select {
+		case messages <- msg:
+		case <-ctx.Done():
+		}
+	}
+	onError := func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}
+
+	handle, err := cmw.node.SubscribeToTopic(cmw.topicID, time.Unix(0, milestoneTimestamp+1), onNext, onError)
+	if err != nil {
+		return false, err
+	}
+	defer handle.Unsubscribe()
+	cmw.logger.Infof("Streaming Messages after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
+
+	// The stream is idle while there are no new messages, so the health is reported on the polling interval
+	ticker := time.NewTicker(cmw.pollingInterval * time.Second)
+	defer ticker.Stop()
+
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return received, ctx.Err()
+		case err := <-errs:
+			return received, err
+		case msg := <-messages:
+			received = true
+			milestoneTimestamp = msg.ConsensusTimestamp.UnixNano()
+			cmw.processStreamedMessage(msg, q)
 			cmw.updateStatusTimestamp(milestoneTimestamp)
+			cmw.healthService.Heartbeat(cmw.healthName, nil)
+			cmw.reportProgress(milestoneTimestamp, true)
+		case <-ticker.C:
+			cmw.healthService.Heartbeat(cmw.healthName, nil)
+			cmw.reportProgress(milestoneTimestamp, false)
+		}
+	}
+}
+
+// pollUntil polls the REST API for messages after the persisted timestamp until the given deadline is reached.
+// Returns false if the context got cancelled meanwhile
+func (cmw Watcher) pollUntil(ctx context.Context, q qi.Queue, deadline time.Time) bool {
+	for {
+		cmw.poll(ctx, q)
+		if !syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) {
+			return false
+		}
+		if !time.Now().Before(deadline) {
+			return true
+		}
+	}
+}
+
+func (cmw Watcher) poll(ctx context.Context, q qi.Queue) {
+	milestoneTimestamp, err := cmw.statusRepository.Get(cmw.topicID.String())
+	if err != nil {
+		cmw.logger.Errorf("Failed to retrieve Topic Watcher Status timestamp. Error [%s]", err)
+		cmw.healthService.Heartbeat(cmw.healthName, err)
+		return
+	}
+
+	pages := cmw.client.IterateMessagesAfterTimestamp(cmw.topicID, milestoneTimestamp, cmw.client.QueryDefaultLimit())
+	milestoneTimestamp, found, err := cmw.processPages(ctx, pages, milestoneTimestamp, q)
+	cmw.healthService.Heartbeat(cmw.healthName, err)
+	if err != nil {
+		cmw.logger.Errorf("Error while retrieving messages from mirror node. Error [%s]", err)
+		return
+	}
+
+	cmw.reportProgress(milestoneTimestamp, found > 0)
+}
+
+// Reprocess pushes the topic messages between the `from` included and `to` excluded consensus timestamps
+// onto the queue, without moving the checkpoint of the watcher. Returns the number of messages found
+func (cmw Watcher) Reprocess(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+	messages, err := cmw.client.GetMessagesForTopicBetween(cmw.topicID, from-1, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, msg := range messages {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
 		}
-		time.Sleep(cmw.pollingInterval * time.Second)
+		cmw.processMessage(msg, q)
+	}
+
+	return len(messages), nil
+}
+
+// reportProgress reports the last processed consensus timestamp and the lag behind it in seconds.
+// The watcher is considered caught up when the last poll returned no messages
+func (cmw Watcher) reportProgress(milestoneTimestamp int64, found bool) {
+	var lag int64
+	if found {
+		lag = int64(time.Since(time.Unix(0, milestoneTimestamp)).Seconds())
 	}
+	cmw.healthService.Progress(cmw.healthName, milestoneTimestamp, lag)
 }
 
 func (cmw Watcher) processMessage(topicMsg mirrorNodeMsg.Message, q qi.Queue) {
 	cmw.logger.Debugf("New Message Received")
 
-	msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
+	messages, err := message.UnpackString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
 	if err != nil {
 		cmw.logger.Errorf("Could not decode incoming message [%s]. Error: [%s]", topicMsg.Contents, err)
 		return
 	}
 
-	q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation})
+	cmw.pushMessages(messages, q)
+}
+
+func (cmw Watcher) processStreamedMessage(topicMsg hedera.TopicMessage, q qi.Queue) {
+	cmw.logger.Debugf("New Message Received")
+
+	messages, err := message.UnpackBytes(topicMsg.Contents, topicMsg.ConsensusTimestamp.UnixNano())
+	if err != nil {
+		cmw.logger.Errorf("Could not decode incoming message [%s]. Error: [%s]", topicMsg.Contents, err)
+		return
+	}
+
+	cmw.pushMessages(messages, q)
+}
+
+// pushMessages queues the signature messages for validation one by one, so that batched signatures get validated
+// the same way as separately submitted ones
+func (cmw Watcher) pushMessages(messages []*message.Message, q qi.Queue) {
+	if len(messages) > 1 {
+		cmw.logger.Debugf("Unpacked batch of [%d] signature messages.", len(messages))
+	}
+
+	for _, msg := range messages {
+		q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation})
+	}
 }
@@ -17,6 +17,8 @@
 package message
 
 import (
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -26,10 +28,14 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 var (
@@ -56,35 +62,61 @@ func Test_ProcessMessage_FromString_Fails(t *testing.T) {
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
+func Test_ProcessMessage_UnpacksBatch(t *testing.T) {
+	setup()
+	messages := []*message.Message{
+		message.NewFungibleSignature(&proto.TopicEthSignatureMessage{TransferID: "0.0.1-1-1", TargetChainId: 80001, Signature: "signature-1"}),
+		message.NewFungibleSignature(&proto.TopicEthSignatureMessage{TransferID: "0.0.1-2-2", TargetChainId: 80001, Signature: "signature-2"}),
+	}
+	bytes, err := message.BatchToBytes(messages, "0xvalidator", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mocks.MQueue.On("Push", mock.Anything)
+
+	w.processMessage(mirrorNodeMsg.Message{Contents: base64.StdEncoding.EncodeToString(bytes), ConsensusTimestamp: consensusTimestamp}, mocks.MQueue)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 2)
+	for i, call := range mocks.MQueue.Calls {
+		msg := call.Arguments.Get(0).(*queue.Message)
+		assert.Equal(t, constants.TopicMessageValidation, msg.Topic)
+		payload := msg.Payload.(*message.Message)
+		assert.Equal(t, messages[i].GetFungibleSignatureMessage().TransferID, payload.GetFungibleSignatureMessage().TransferID)
+		assert.Equal(t, milestoneTimestamp, payload.TransactionTimestamp)
+	}
+}
+
 func Test_NewWatcher(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, mocks.MHederaNodeClient, "0.0.1", mocks.MStatusRepository, 1, 0, false, mocks.MHealthService)
 }
 
 func Test_NewWatcher_Get_Error(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), gorm.ErrRecordNotFound)
 	mocks.MStatusRepository.On("Create", topicID.String(), mock.Anything).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, mocks.MHederaNodeClient, "0.0.1", mocks.MStatusRepository, 1, 0, false, mocks.MHealthService)
 }
 
 func Test_NewWatcher_WithTS(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(6), nil)
 	mocks.MStatusRepository.On("Update", topicID.String(), int64(6)).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6)
+	NewWatcher(mocks.MHederaMirrorClient, mocks.MHederaNodeClient, "0.0.1", mocks.MStatusRepository, 1, 6, false, mocks.MHealthService)
 }
 
 func Test_BeginWatch_FailsMessagesRetrieval(t *testing.T) {
 	setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(5), nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(5), queryDefaultLimit).Return([]mirrorNodeMsg.Message{}, errors.New("some-error"))
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", topicID, int64(5), queryDefaultLimit).Return(helper.MakeFailingPages[mirrorNodeMsg.Message](errors.New("some-error")))
 	mocks.MHederaMirrorClient.On("QueryDefaultLimit").Return(queryDefaultLimit)
-	w.beginWatching(mocks.MQueue)
+	w.beginWatching(context.Background(), mocks.MQueue)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything)
+	mocks.MHealthService.AssertCalled(t, "Heartbeat", w.healthName, errors.New("some-error"))
+	mocks.MHealthService.AssertNotCalled(t, "Progress", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
@@ -108,24 +140,119 @@ func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(2), nil).Once()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(milestoneTimestamp, nil)
 	mocks.MHederaMirrorClient.On("QueryDefaultLimit").Return(queryDefaultLimit)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(2), queryDefaultLimit).Return([]mirrorNodeMsg.Message{m}, nil).Once()
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, milestoneTimestamp, queryDefaultLimit).Return([]mirrorNodeMsg.Message{}, errors.New("some-error"))
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", topicID, int64(2), queryDefaultLimit).Return(helper.MakePages([]mirrorNodeMsg.Message{m})).Once()
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", topicID, milestoneTimestamp, queryDefaultLimit).Return(helper.MakeFailingPages[mirrorNodeMsg.Message](errors.New("some-error")))
 	mocks.MQueue.On("Push", queueMessage)
 	mocks.MStatusRepository.On("Update", topicID.String(), milestoneTimestamp).Return(nil)
 
-	w.beginWatching(mocks.MQueue)
+	w.beginWatching(context.Background(), mocks.MQueue)
 
 	mocks.MQueue.AssertCalled(t, "Push", queueMessage)
 	mocks.MStatusRepository.AssertCalled(t, "Update", topicID.String(), milestoneTimestamp)
+	mocks.MHealthService.AssertCalled(t, "Heartbeat", w.healthName, nil)
+	mocks.MHealthService.AssertCalled(t, "Progress", w.healthName, milestoneTimestamp, mock.Anything)
+}
+
+func Test_Stream_ProcessesMessages(t *testing.T) {
+	setup()
+	contents := "EIHxBBodMC4wLjE4OTMtMTYzMTI2MDg5MC05NDgyMDg5NDkiKjB4MDg3MkI5RjY1OUYwYjQ" +
+		"xNGU1M2ZEYWIyQjY2OThDMzRCYWMxY0I5MCoqMHgwZjJGNjYyM2FDNGI5NGUxZDYxQjRDZD" +
+		"E5NUE2YzI4OTkyMzEwRjk2Mgk5MDAwMDAwMDE6ggE0YThiZmNhMmY2MGVkN2M5NDkwZDBhZ" +
+		"DNiZWNmODk2YmVjMGYxYmYxZmFiOTlhNWQwMmY4ZjZiYzU1NWZmNTA2NzdiOWRkMWJmOTg4" +
+		"OGIxMzZhYjhlMzMzMjE0NjJjMGRkZWNiNWQ5NzE3YTY1OGQxYjYyZTliYTkyY2Q4OTlmYjFj"
+	bytes, _ := base64.StdEncoding.DecodeString(contents)
+	payload, _ := message.FromString(contents, consensusTimestamp)
+	streamErr := errors.New("stream closed")
+	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(2), nil)
+	mocks.MStatusRepository.On("Update", topicID.String(), milestoneTimestamp).Return(nil)
+	mocks.MQueue.On("Push", &queue.Message{Payload: payload, Topic: constants.TopicMessageValidation})
+	mocks.MHederaNodeClient.On("SubscribeToTopic", topicID, time.Unix(0, 3), mock.Anything, mock.Anything).
+		Return(hedera.SubscriptionHandle{}, nil).
+		Run(func(args mock.Arguments) {
+			onNext := args.Get(2).(func(hedera.TopicMessage))
+			onError := args.Get(3).(func(error))
+			go func() {
+				onNext(hedera.TopicMessage{ConsensusTimestamp: time.Unix(0, milestoneTimestamp), Contents: bytes})
+				onError(streamErr)
+			}()
+		})
+
+	received, err := w.stream(context.Background(), mocks.MQueue)
+
+	assert.True(t, received)
+	assert.Equal(t, streamErr, err)
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MStatusRepository.AssertCalled(t, "Update", topicID.String(), milestoneTimestamp)
+	mocks.MHealthService.AssertCalled(t, "Progress", w.healthName, milestoneTimestamp, mock.Anything)
+}
+
+func Test_Stream_SubscribeFails(t *testing.T) {
+	setup()
+	expectedErr := errors.New("unavailable")
+	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(2), nil)
+	mocks.MHederaNodeClient.On("SubscribeToTopic", topicID, time.Unix(0, 3), mock.Anything, mock.Anything).
+		Return(hedera.SubscriptionHandle{}, expectedErr)
+
+	received, err := w.stream(context.Background(), mocks.MQueue)
+
+	assert.False(t, received)
+	assert.Equal(t, expectedErr, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_BeginStreaming_FallsBackToPolling(t *testing.T) {
+	setup()
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(2), nil)
+	mocks.MHederaNodeClient.On("SubscribeToTopic", topicID, time.Unix(0, 3), mock.Anything, mock.Anything).
+		Return(hedera.SubscriptionHandle{}, errors.New("unavailable"))
+	mocks.MHederaMirrorClient.On("QueryDefaultLimit").Return(queryDefaultLimit)
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", topicID, int64(2), queryDefaultLimit).
+		Return(helper.MakePages[mirrorNodeMsg.Message]()).
+		Run(func(args mock.Arguments) { cancel() })
+
+	w.beginStreaming(ctx, mocks.MQueue)
+
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubscribeToTopic", 1)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "IterateMessagesAfterTimestamp", 1)
+	mocks.MHealthService.AssertCalled(t, "Heartbeat", w.healthName, nil)
+}
+
+func Test_Reprocess(t *testing.T) {
+	setup()
+	m := mirrorNodeMsg.Message{
+		ConsensusTimestamp: consensusTimestamp,
+		TopicId:            "0.0.4321",
+		Contents: "EIHxBBodMC4wLjE4OTMtMTYzMTI2MDg5MC05NDgyMDg5NDkiKjB4MDg3MkI5RjY1OUYwYjQ" +
+			"xNGU1M2ZEYWIyQjY2OThDMzRCYWMxY0I5MCoqMHgwZjJGNjYyM2FDNGI5NGUxZDYxQjRDZD" +
+			"E5NUE2YzI4OTkyMzEwRjk2Mgk5MDAwMDAwMDE6ggE0YThiZmNhMmY2MGVkN2M5NDkwZDBhZ" +
+			"DNiZWNmODk2YmVjMGYxYmYxZmFiOTlhNWQwMmY4ZjZiYzU1NWZmNTA2NzdiOWRkMWJmOTg4" +
+			"OGIxMzZhYjhlMzMzMjE0NjJjMGRkZWNiNWQ5NzE3YTY1OGQxYjYyZTliYTkyY2Q4OTlmYjFj",
+	}
+	payload, _ := message.FromString(m.Contents, m.ConsensusTimestamp)
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(1), int64(10)).Return([]mirrorNodeMsg.Message{m}, nil)
+	mocks.MQueue.On("Push", &queue.Message{Payload: payload, Topic: constants.TopicMessageValidation})
+
+	found, err := w.Reprocess(context.Background(), 2, 10, mocks.MQueue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, found)
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 }
 
 func setup() {
 	mocks.Setup()
+	mocks.MHealthService.On("Heartbeat", mock.Anything, mock.Anything).Return()
+	mocks.MHealthService.On("Progress", mock.Anything, mock.Anything, mock.Anything).Return()
 	w = &Watcher{
 		client:           mocks.MHederaMirrorClient,
+		node:             mocks.MHederaNodeClient,
 		topicID:          topicID,
 		statusRepository: mocks.MStatusRepository,
 		pollingInterval:  1,
+		healthService:    mocks.MHealthService,
+		healthName:       fmt.Sprintf(constants.HealthMessageWatcherFormat, topicID),
 		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package peers
+
+import (
+	"context"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// Watcher periodically builds the peers report, so that the peer metrics are kept up to date
+type Watcher struct {
+	peersService    service.Peers
+	pollingInterval time.Duration
+	logger          *log.Entry
+}
+
+func NewWatcher(peersService service.Peers, pollingInterval time.Duration) *Watcher {
+	return &Watcher{
+		peersService:    peersService,
+		pollingInterval: pollingInterval,
+		logger:          config.GetLoggerFor("Peers Watcher"),
+	}
+}
+
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			pw.watchIteration()
+			if !syncHelper.Sleep(ctx, pw.pollingInterval) {
+				pw.logger.Infof("Stopped watching peers.")
+				return
+			}
+		}
+	}()
+}
+
+func (pw *Watcher) watchIteration() {
+	pw.logger.Debugf("Updating peer metrics ...")
+	report, err := pw.peersService.Report()
+	if err != nil {
+		pw.logger.Errorf("Failed to update peer metrics. Error: [%s]", err)
+		return
+	}
+	pw.logger.Debugf("Updated metrics of [%d] peers.", len(report.Peers))
+}
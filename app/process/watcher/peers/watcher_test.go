@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package peers
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	watcher         *Watcher
+	pollingInterval = 5 * time.Minute
+)
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actualWatcher := NewWatcher(mocks.MPeersService, pollingInterval)
+
+	assert.Equal(t, watcher, actualWatcher)
+}
+
+func Test_watchIteration(t *testing.T) {
+	setup()
+	mocks.MPeersService.On("Report").Return(&peer.Report{}, nil)
+
+	watcher.watchIteration()
+
+	mocks.MPeersService.AssertCalled(t, "Report")
+}
+
+func Test_watchIteration_Error(t *testing.T) {
+	setup()
+	mocks.MPeersService.On("Report").Return(nil, errors.New("some error"))
+
+	watcher.watchIteration()
+
+	mocks.MPeersService.AssertCalled(t, "Report")
+}
+
+func Test_Watch_StopsWhenCancelled(t *testing.T) {
+	setup()
+	reported := make(chan struct{})
+	mocks.MPeersService.On("Report").Return(&peer.Report{}, nil).Run(func(args mock.Arguments) {
+		close(reported)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	watcher.Watch(ctx, nil)
+
+	select {
+	case <-reported:
+	case <-time.After(time.Second):
+		t.Fatal("peers report was not built")
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	watcher = &Watcher{
+		peersService:    mocks.MPeersService,
+		pollingInterval: pollingInterval,
+		logger:          config.GetLoggerFor("Peers Watcher"),
+	}
+}
@@ -17,9 +17,12 @@
 package price
 
 import (
+	"context"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	"time"
 )
@@ -30,27 +33,33 @@ var (
 
 type Watcher struct {
 	pricingService service.Pricing
+	healthService  service.Health
 	logger         *log.Entry
 }
 
-func NewWatcher(pricingService service.Pricing) *Watcher {
+func NewWatcher(pricingService service.Pricing, healthService service.Health) *Watcher {
 	return &Watcher{
 		pricingService: pricingService,
+		healthService:  healthService,
 		logger:         config.GetLoggerFor("Price Watcher"),
 	}
 }
 
-func (pw *Watcher) Watch(q qi.Queue) {
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
 	// there will be no handler, so the q is to implement the interface
+	pw.healthService.RegisterWatcher(constants.HealthPriceWatcher, sleepTime)
 	go func() {
 		for {
-			pw.watchIteration()
-			time.Sleep(sleepTime)
+			pw.healthService.Heartbeat(constants.HealthPriceWatcher, pw.watchIteration())
+			if !syncHelper.Sleep(ctx, sleepTime) {
+				pw.logger.Infof("Stopped watching prices.")
+				return
+			}
 		}
 	}()
 }
 
-func (pw *Watcher) watchIteration() {
+func (pw *Watcher) watchIteration() error {
 	pw.logger.Debugf("Fetching and updating USD prices ...")
 	err := pw.pricingService.FetchAndUpdateUsdPrices()
 	if err != nil {
@@ -58,4 +67,6 @@ func (pw *Watcher) watchIteration() {
 	} else {
 		pw.logger.Debugf("Fetching and updating USD prices finished successfully!")
 	}
+
+	return err
 }
@@ -17,11 +17,14 @@
 package price
 
 import (
+	"context"
 	"errors"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 )
 
@@ -32,7 +35,7 @@ var (
 func Test_NewWatcher(t *testing.T) {
 	setup()
 
-	actualWatcher := NewWatcher(mocks.MPricingService)
+	actualWatcher := NewWatcher(mocks.MPricingService, mocks.MHealthService)
 
 	assert.Equal(t, watcher, actualWatcher)
 }
@@ -41,8 +44,9 @@ func Test_watchIteration(t *testing.T) {
 	setup()
 	mocks.MPricingService.On("FetchAndUpdateUsdPrices").Return(nil)
 
-	watcher.watchIteration()
+	err := watcher.watchIteration()
 
+	assert.Nil(t, err)
 	mocks.MPricingService.AssertCalled(t, "FetchAndUpdateUsdPrices")
 }
 
@@ -50,8 +54,9 @@ func Test_watchIteration_Error(t *testing.T) {
 	setup()
 	mocks.MPricingService.On("FetchAndUpdateUsdPrices").Return(errors.New("some error"))
 
-	watcher.watchIteration()
+	err := watcher.watchIteration()
 
+	assert.Error(t, err)
 	mocks.MPricingService.AssertCalled(t, "FetchAndUpdateUsdPrices")
 }
 
@@ -59,14 +64,19 @@ func Test_Watch(t *testing.T) {
 	setup()
 	mocks.MPricingService.On("FetchAndUpdateUsdPrices").Return(nil)
 
-	watcher.Watch(qi.Queue(nil))
+	watcher.Watch(context.Background(), qi.Queue(nil))
+
+	mocks.MHealthService.AssertCalled(t, "RegisterWatcher", constants.HealthPriceWatcher, sleepTime)
 }
 
 func setup() {
 	mocks.Setup()
+	mocks.MHealthService.On("RegisterWatcher", mock.Anything, mock.Anything).Return()
+	mocks.MHealthService.On("Heartbeat", mock.Anything, mock.Anything).Return()
 
 	watcher = &Watcher{
 		pricingService: mocks.MPricingService,
+		healthService:  mocks.MHealthService,
 		logger:         config.GetLoggerFor("Price Watcher"),
 	}
 }
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cryptotransfer
+
+import "sync"
+
+// checkpoint keeps track of the transactions in flight, so that the watcher status is advanced only past
+// transactions, which have either been recorded or rejected. Otherwise, a crash would skip the unfinished ones
+type checkpoint struct {
+	mu sync.Mutex
+	// Consensus timestamps of the transactions in flight by transaction ID
+	inFlight map[string]int64
+	// The latest consensus timestamp, which has been polled
+	latest int64
+	// The last persisted watermark
+	persisted int64
+}
+
+func newCheckpoint(persisted int64) *checkpoint {
+	return &checkpoint{
+		inFlight:  make(map[string]int64),
+		latest:    persisted,
+		persisted: persisted,
+	}
+}
+
+// begin marks the transaction as in flight. Returns false if it is already in flight
+func (c *checkpoint) begin(transactionID string, consensusTimestamp int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.inFlight[transactionID]; ok {
+		return false
+	}
+	c.inFlight[transactionID] = consensusTimestamp
+	if consensusTimestamp > c.latest {
+		c.latest = consensusTimestamp
+	}
+	return true
+}
+
+// advance moves the latest polled timestamp, so that it can be persisted once the transactions before it are done.
+// Returns the latest polled timestamp
+func (c *checkpoint) advance(consensusTimestamp int64) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if consensusTimestamp > c.latest {
+		c.latest = consensusTimestamp
+	}
+	return c.latest
+}
+
+// done marks the transaction as recorded or rejected
+func (c *checkpoint) done(transactionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.inFlight, transactionID)
+}
+
+// persist calls save with the watermark, if it has moved since the last call. The watermark is the timestamp,
+// up to which all polled transactions are done. Transactions after it are polled again on restart
+func (c *checkpoint) persist(save func(int64)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	watermark := c.latest
+	for _, ts := range c.inFlight {
+		if ts-1 < watermark {
+			watermark = ts - 1
+		}
+	}
+
+	if watermark > c.persisted {
+		save(watermark)
+		c.persisted = watermark
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cryptotransfer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Checkpoint_StopsBeforeTransactionsInFlight(t *testing.T) {
+	c := newCheckpoint(10)
+	assert.True(t, c.begin("tx-1", 20))
+	assert.True(t, c.begin("tx-2", 30))
+	c.done("tx-2")
+
+	var saved []int64
+	c.persist(func(ts int64) { saved = append(saved, ts) })
+
+	assert.Equal(t, []int64{19}, saved)
+}
+
+func Test_Checkpoint_AdvancesOnceDone(t *testing.T) {
+	c := newCheckpoint(10)
+	c.begin("tx-1", 20)
+	c.begin("tx-2", 30)
+	assert.Equal(t, int64(40), c.advance(40))
+
+	var saved []int64
+	save := func(ts int64) { saved = append(saved, ts) }
+	c.persist(save)
+	c.done("tx-1")
+	c.persist(save)
+	c.done("tx-2")
+	c.persist(save)
+
+	assert.Equal(t, []int64{19, 29, 40}, saved)
+}
+
+func Test_Checkpoint_NotPersistedUntilMoved(t *testing.T) {
+	c := newCheckpoint(10)
+	c.begin("tx-1", 11)
+
+	c.persist(func(ts int64) { t.Fatalf("unexpected save of [%d]", ts) })
+}
+
+func Test_Checkpoint_BeginInFlightTransaction(t *testing.T) {
+	c := newCheckpoint(10)
+
+	assert.True(t, c.begin("tx-1", 20))
+	assert.False(t, c.begin("tx-1", 20))
+	c.done("tx-1")
+	assert.True(t, c.begin("tx-1", 20))
+}
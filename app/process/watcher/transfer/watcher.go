@@ -17,6 +17,7 @@
 package cryptotransfer
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
@@ -35,6 +37,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -45,6 +48,10 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/blacklist"
 )
 
+// maxTransactionAttempts is the number of polling intervals, during which the watcher attempts to retrieve
+// a transaction, before giving up on it
+const maxTransactionAttempts = 60
+
 type Watcher struct {
 	transfers           service.Transfers
 	client              client.MirrorNode
@@ -59,6 +66,9 @@ type Watcher struct {
 	prometheusService   service.Prometheus
 	pricingService      service.Pricing
 	blacklistedAccounts []string
+	healthService       service.Health
+	healthName          string
+	checkpoint          *checkpoint
 }
 
 func NewWatcher(
@@ -74,6 +84,7 @@ func NewWatcher(
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
 	blacklistedAccounts []string,
+	healthService service.Health,
 ) *Watcher {
 	id, err := hedera.AccountIDFromString(accountID)
 	if err != nil {
@@ -117,19 +128,23 @@ func NewWatcher(
 		pricingService:      pricingService,
 		prometheusService:   prometheusService,
 		blacklistedAccounts: blacklistedAccounts,
+		healthService:       healthService,
+		healthName:          fmt.Sprintf(constants.HealthTransferWatcherFormat, accountID),
+		checkpoint:          newCheckpoint(0),
 	}
 
 	return instance
 
 }
 
-func (ctw Watcher) Watch(q qi.Queue) {
+func (ctw Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !ctw.client.AccountExists(ctw.accountID) {
 		ctw.logger.Errorf("Could not start monitoring account [%s] - Account not found.", ctw.accountID.String())
 		return
 	}
 
-	go ctw.beginWatching(q)
+	ctw.healthService.RegisterWatcher(ctw.healthName, ctw.pollingInterval*time.Second)
+	go ctw.beginWatching(ctx, q)
 }
 
 func (ctw Watcher) updateStatusTimestamp(ts int64) {
@@ -140,66 +155,150 @@ func (ctw Watcher) updateStatusTimestamp(ts int64) {
 	ctw.logger.Tracef("Updated Transfer Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (ctw Watcher) beginWatching(q qi.Queue) {
+func (ctw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := ctw.statusRepository.Get(ctw.accountID.String())
 	if err != nil {
 		ctw.logger.Fatalf("Failed to retrieve Transfer Watcher Status timestamp. Error [%s]", err)
 	}
+	// The persisted timestamp falls behind the polled one while there are transactions in flight
+	milestoneTimestamp = ctw.checkpoint.advance(milestoneTimestamp)
 	ctw.logger.Infof("Watching for Transfers after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
 	for {
-		transactions, e := ctw.client.GetAccountCreditTransactionsAfterTimestamp(ctw.accountID, milestoneTimestamp)
+		pages := ctw.client.IterateAccountCreditTransactionsAfterTimestamp(ctw.accountID, milestoneTimestamp)
+		var found int
+		var e error
+		milestoneTimestamp, found, e = ctw.processPages(ctx, pages, milestoneTimestamp, q)
+		ctw.healthService.Heartbeat(ctw.healthName, e)
 		if e != nil {
 			ctw.logger.Errorf("Suddenly stopped monitoring account. Error: [%s]", e)
-			time.Sleep(ctw.pollingInterval * time.Second)
-			go ctw.beginWatching(q)
+			if syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) {
+				go ctw.beginWatching(ctx, q)
+			}
 			return
 		}
 
-		ctw.logger.Tracef("Polling found [%d] Transactions", len(transactions.Transactions))
-		if len(transactions.Transactions) > 0 {
-			for _, tx := range transactions.Transactions {
-				go ctw.processTransaction(tx.TransactionID, q)
-			}
-			var err error
-			milestoneTimestamp, err = timestamp.FromString(transactions.Transactions[len(transactions.Transactions)-1].ConsensusTimestamp)
+		ctw.reportProgress(milestoneTimestamp, found > 0)
+
+		if !syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) {
+			ctw.logger.Infof("Stopped watching for Transfers.")
+			return
+		}
+	}
+}
+
+// processPages walks all pages of transactions and hands every new one over for processing. The checkpoint is
+// advanced after each page, so a failure mid-way does not lose the progress made on the previous pages.
+// Returns the consensus timestamp of the last transaction and the number of transactions found
+func (ctw Watcher) processPages(ctx context.Context, pages *pagination.Pages[transaction.Transaction], milestoneTimestamp int64, q qi.Queue) (int64, int, error) {
+	found := 0
+	for pages.HasNext() {
+		transactions, err := pages.Next()
+		if err != nil {
+			return milestoneTimestamp, found, err
+		}
+
+		ctw.logger.Tracef("Polling found [%d] Transactions", len(transactions))
+		for _, tx := range transactions {
+			consensusTimestamp, err := timestamp.FromString(tx.ConsensusTimestamp)
 			if err != nil {
-				ctw.logger.Errorf("Unable to parse latest transfer timestamp. Error - [%s].", err)
+				ctw.logger.Errorf("[%s] - Unable to parse transfer timestamp. Error - [%s].", tx.TransactionID, err)
 				continue
 			}
 
-			ctw.updateStatusTimestamp(milestoneTimestamp)
+			milestoneTimestamp = consensusTimestamp
+			if ctw.checkpoint.begin(tx.TransactionID, consensusTimestamp) {
+				go ctw.handleTransaction(ctx, tx.TransactionID, consensusTimestamp, q)
+			}
+		}
+		found += len(transactions)
+
+		ctw.checkpoint.advance(milestoneTimestamp)
+		ctw.checkpoint.persist(ctw.updateStatusTimestamp)
+	}
+
+	return milestoneTimestamp, found, nil
+}
+
+// Reprocess pushes the incoming transfers between the `from` included and `to` excluded consensus timestamps
+// onto the queue, without moving the checkpoint of the watcher. Returns the number of transactions found
+func (ctw Watcher) Reprocess(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+	transactions, err := ctw.client.GetAccountCreditTransactionsBetween(ctw.accountID, from-1, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tx := range transactions {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		if !ctw.processTransaction(tx.TransactionID, q, true) {
+			return 0, fmt.Errorf("failed to process transaction [%s]", tx.TransactionID)
+		}
+	}
+
+	return len(transactions), nil
+}
+
+// reportProgress reports the last processed consensus timestamp and the lag behind it in seconds.
+// The watcher is considered caught up when the last poll returned no transactions
+func (ctw Watcher) reportProgress(milestoneTimestamp int64, found bool) {
+	var lag int64
+	if found {
+		lag = int64(time.Since(time.Unix(0, milestoneTimestamp)).Seconds())
+	}
+	ctw.healthService.Progress(ctw.healthName, milestoneTimestamp, lag)
+}
+
+// handleTransaction processes the transaction until it gets recorded or rejected and then advances the checkpoint.
+// A transaction which cannot be retrieved in maxTransactionAttempts is given up on, so that it does not hold back
+// the checkpoint, and has to be recovered through the reprocess API
+func (ctw Watcher) handleTransaction(ctx context.Context, txID string, consensusTimestamp int64, q qi.Queue) {
+	for attempt := 1; !ctw.processTransaction(txID, q, false); attempt++ {
+		if attempt >= maxTransactionAttempts {
+			ctw.logger.Errorf("[%s] - Giving up on Transaction processing after [%d] attempts. Reprocess it from [%d] to [%d] once it can be retrieved.",
+				txID, attempt, consensusTimestamp, consensusTimestamp+1)
+			break
+		}
+		if !syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) {
+			return
 		}
-		time.Sleep(ctw.pollingInterval * time.Second)
+		ctw.logger.Infof("[%s] - Retrying Transaction processing.", txID)
 	}
+
+	ctw.checkpoint.done(txID)
+	ctw.checkpoint.persist(ctw.updateStatusTimestamp)
 }
 
-func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
+// processTransaction pushes the transfer of the given transaction to the queue. Returns false, if the transaction
+// could not be retrieved and its processing has to be retried, and true once it is either pushed or rejected.
+// Reprocessed transactions are signed by validators even if they precede the start of the watcher
+func (ctw Watcher) processTransaction(txID string, q qi.Queue, reprocess bool) bool {
 	ctw.logger.Infof("New Transaction with ID: [%s]", txID)
 
 	// TX like: [HBAR -> WHBAR || HTS -> WHTS || WEVM -> EVM] (Hereda to EVM)
 	tx, err := ctw.client.GetSuccessfulTransaction(txID)
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to get Transaction. Error: [%s]", txID, err)
-		return
+		return false
 	}
 
 	blackListError := blacklist.CheckTxForBlacklistedAccounts(ctw.blacklistedAccounts, tx)
 	if blackListError != nil {
 		ctw.logger.Errorf(blackListError.Error())
-		return
+		return true
 	}
 
 	parsedTransfer, err := tx.GetIncomingTransfer(ctw.accountID.String())
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Could not extract incoming transfer. Error: [%s]", tx.TransactionID, err)
-		return
+		return true
 	}
 	sourceAsset := parsedTransfer.Asset
 	checkResult := ctw.transfers.SanityCheckTransfer(tx)
 	if checkResult.Err != nil {
 		ctw.logger.Errorf("[%s] - Sanity check failed. Error: [%s]", tx.TransactionID, checkResult.Err)
-		return
+		return true
 	}
 	targetChainId := checkResult.ChainId
 
@@ -219,12 +318,12 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		nativeAsset = ctw.assetsService.WrappedToNative(sourceAsset, constants.HederaNetworkId)
 		if nativeAsset == nil {
 			ctw.logger.Errorf("[%s] - Could not parse asset [%s] to its target chain correlation", tx.TransactionID, sourceAsset)
-			return
+			return true
 		}
 		targetChainAsset = nativeAsset.Asset
 		if nativeAsset.ChainId != targetChainId {
 			ctw.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", tx.TransactionID, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
-			return
+			return true
 		}
 	}
 
@@ -234,18 +333,18 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		nftAssetInfo, ok := ctw.assetsService.NonFungibleAssetInfo(constants.HederaNetworkId, sourceAsset)
 		if !ok {
 			ctw.logger.Errorf("[%s] - Failed to get asset info for NFT [%s] not found.", tx.TransactionID, sourceAsset)
-			return
+			return true
 		}
 
 		feeSent, found := tx.GetHBARTransfer(ctw.accountID.String())
 		if !found {
 			ctw.logger.Errorf("[%s] - Transfer to [%s] not found.", tx.TransactionID, ctw.accountID.String())
-			return
+			return true
 		}
 
 		feeForValidators, ok := ctw.validateNFTFeeSent(sourceAsset, tx, originator, nftAssetInfo, feeSent)
 		if !ok {
-			return
+			return true
 		}
 
 		transferMessage, err = ctw.createNonFungiblePayload(tx.TransactionID, checkResult.EvmAddress, sourceAsset, *nativeAsset, checkResult.NftId.SerialNumber, targetChainId, targetChainAsset, feeForValidators)
@@ -256,20 +355,20 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to create payload. Error: [%s]", tx.TransactionID, err)
-		return
+		return true
 	}
 
 	transactionTimestamp, err := timestamp.FromString(tx.ConsensusTimestamp)
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to parse consensus timestamp [%s]. Error: [%s]", tx.TransactionID, tx.ConsensusTimestamp, err)
-		return
+		return true
 	}
 
 	transferMessage.Timestamp = time.Unix(0, transactionTimestamp)
 	transferMessage.Originator = originator
 
 	topic := ""
-	if ctw.validator && transactionTimestamp > ctw.targetTimestamp {
+	if ctw.validator && (reprocess || transactionTimestamp > ctw.targetTimestamp) {
 		if nativeAsset.ChainId == constants.HederaNetworkId {
 			if checkResult.NftId != nil {
 				topic = constants.HederaNativeNftTransfer
@@ -279,7 +378,7 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		} else {
 			if checkResult.NftId != nil {
 				ctw.logger.Errorf("[%s] - NFT Transfer not supported", tx.TransactionID)
-				return
+				return true
 			}
 			topic = constants.HederaBurnMessageSubmission
 		}
@@ -294,13 +393,14 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		} else {
 			if checkResult.NftId != nil {
 				ctw.logger.Errorf("[%s] - NFT Read-only Transfer not supported", tx.TransactionID)
-				return
+				return true
 			}
 			topic = constants.ReadOnlyHederaBurn
 		}
 	}
 
 	q.Push(&queue.Message{Payload: transferMessage, Topic: topic})
+	return true
 }
 
 func (ctw Watcher) validateNFTFeeSent(sourceAsset string, tx transaction.Transaction, originator string, nftAssetInfo *asset.NonFungibleAssetInfo, feeSent int64) (int64, bool) {
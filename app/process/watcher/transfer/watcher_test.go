@@ -17,6 +17,7 @@
 package cryptotransfer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -30,11 +31,13 @@ import (
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	iservice "github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
@@ -90,7 +93,7 @@ func Test_NewMemo_MissingWrappedCorrelation(t *testing.T) {
 	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(emptyString)
 	mocks.MAssetsService.On("WrappedToNative", nativeTokenAddressNetwork0, network0).Return(nilNativeAsset)
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(tx.TransactionID, mocks.MQueue, false)
 	mocks.MTransferService.AssertCalled(t, "SanityCheckTransfer", tx)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -114,6 +117,7 @@ func Test_NewWatcher_RecordNotFound_Creates(t *testing.T) {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		mocks.MHealthService,
 	)
 
 	mocks.MStatusRepository.AssertCalled(t, "Create", txAccountId, mock.Anything)
@@ -137,6 +141,7 @@ func Test_NewWatcher_NotNilTS_Works(t *testing.T) {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		mocks.MHealthService,
 	)
 
 	mocks.MStatusRepository.AssertCalled(t, "Update", txAccountId, mock.Anything)
@@ -150,7 +155,22 @@ func Test_Watch_AccountNotExist(t *testing.T) {
 		Account: 444444,
 	}
 	mocks.MHederaMirrorClient.On("AccountExists", hederaAcc).Return(false)
-	w.Watch(mocks.MQueue)
+	w.Watch(context.Background(), mocks.MQueue)
+}
+
+func Test_BeginWatching_ReportsHealth(t *testing.T) {
+	w := initializeWatcher()
+	healthName := fmt.Sprintf(constants.HealthTransferWatcherFormat, txAccountId)
+	mocks.MHederaMirrorClient.On("IterateAccountCreditTransactionsAfterTimestamp", mock.Anything, int64(0)).Return(helper.MakePages[transaction.Transaction]())
+	mocks.MHealthService.On("Heartbeat", healthName, nil).Return()
+	mocks.MHealthService.On("Progress", healthName, int64(0), int64(0)).Return()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w.beginWatching(ctx, mocks.MQueue)
+
+	mocks.MHealthService.AssertCalled(t, "Heartbeat", healthName, nil)
+	mocks.MHealthService.AssertCalled(t, "Progress", healthName, int64(0), int64(0))
 }
 
 func Test_ProcessTransaction(t *testing.T) {
@@ -165,7 +185,105 @@ func Test_ProcessTransaction(t *testing.T) {
 	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork3, true)
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	assert.True(t, w.processTransaction(tx.TransactionID, mocks.MQueue, false))
+}
+
+func Test_ProcessTransaction_GetSuccessfulTransaction_Fails(t *testing.T) {
+	w := initializeWatcher()
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(transaction.Transaction{}, errors.New("some-error"))
+
+	assert.False(t, w.processTransaction(tx.TransactionID, mocks.MQueue, false))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleTransaction_GivesUpAfterMaxAttempts(t *testing.T) {
+	w := initializeWatcher()
+	w.pollingInterval = 0
+	w.checkpoint = newCheckpoint(10)
+	w.checkpoint.begin(tx.TransactionID, 20)
+	w.checkpoint.advance(30)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(transaction.Transaction{}, errors.New("some-error"))
+	mocks.MStatusRepository.On("Update", txAccountId, int64(30)).Return(nil)
+
+	w.handleTransaction(context.Background(), tx.TransactionID, 20, mocks.MQueue)
+
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetSuccessfulTransaction", maxTransactionAttempts)
+	mocks.MStatusRepository.AssertCalled(t, "Update", txAccountId, int64(30))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_BeginWatching_CheckpointsBeforeTransactionsInFlight(t *testing.T) {
+	w := initializeWatcher()
+	w.pollingInterval = 60
+	ctx, cancel := context.WithCancel(context.Background())
+	pages := helper.MakePages([]transaction.Transaction{
+		{TransactionID: "0.0.1-1-1", ConsensusTimestamp: "10.000000001"},
+		{TransactionID: "0.0.1-2-2", ConsensusTimestamp: "10.000000002"},
+	})
+	mocks.MHederaMirrorClient.On("IterateAccountCreditTransactionsAfterTimestamp", mock.Anything, int64(0)).Return(pages)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", mock.Anything).Return(transaction.Transaction{}, errors.New("some-error"))
+	mocks.MStatusRepository.On("Update", txAccountId, mock.Anything).Return(nil)
+	mocks.MHealthService.On("Heartbeat", mock.Anything, nil).Return()
+	mocks.MHealthService.On("Progress", mock.Anything, mock.Anything, mock.Anything).Return().Run(func(args mock.Arguments) { cancel() })
+
+	w.beginWatching(ctx, mocks.MQueue)
+
+	mocks.MStatusRepository.AssertCalled(t, "Update", txAccountId, int64(10_000_000_000))
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", txAccountId, int64(10_000_000_002))
+}
+
+func Test_Reprocess(t *testing.T) {
+	w := initializeWatcher()
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(9), int64(20)).Return([]transaction.Transaction{tx}, nil)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
+	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
+	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
+	mocks.MPricingService.On("GetTokenPriceInfo", network0, nativeTokenAddressNetwork0).Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(1)}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork0, true)
+
+	found, err := w.Reprocess(context.Background(), 10, 20, mocks.MQueue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, found)
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_Reprocess_BeforeTargetTimestamp_PushesToSigningTopic(t *testing.T) {
+	w := initializeWatcher()
+	w.targetTimestamp = time.Now().UnixNano()
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(9), int64(20)).Return([]transaction.Transaction{tx}, nil)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
+	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
+	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
+	mocks.MPricingService.On("GetTokenPriceInfo", network0, nativeTokenAddressNetwork0).Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(1)}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork0, true)
+
+	_, err := w.Reprocess(context.Background(), 10, 20, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertCalled(t, "Push", mock.MatchedBy(func(m *queue.Message) bool {
+		return m.Topic == constants.HederaTransferMessageSubmission
+	}))
+}
+
+func Test_Reprocess_GetSuccessfulTransaction_Fails(t *testing.T) {
+	w := initializeWatcher()
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(9), int64(20)).Return([]transaction.Transaction{tx}, nil)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(transaction.Transaction{}, errors.New("some-error"))
+
+	_, err := w.Reprocess(context.Background(), 10, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_ProcessTransaction_WithTS(t *testing.T) {
@@ -182,7 +300,7 @@ func Test_ProcessTransaction_WithTS(t *testing.T) {
 	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork3, true)
 
 	mocks.MQueue.On("Push", mock.Anything).Return()
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	w.processTransaction(anotherTx.TransactionID, mocks.MQueue, false)
 }
 
 func Test_ProcessTransaction_SanityCheckTransfer_Fails(t *testing.T) {
@@ -190,7 +308,7 @@ func Test_ProcessTransaction_SanityCheckTransfer_Fails(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network0, EvmAddress: "", Err: errors.New("some-error")})
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(tx.TransactionID, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -200,7 +318,7 @@ func Test_ProcessTransaction_Blacklist_Fails(t *testing.T) {
 	w.blacklistedAccounts = append(w.blacklistedAccounts, tx.TokenTransfers[0].Account)
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(tx.TransactionID, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -227,7 +345,7 @@ func Test_ProcessNFTTransaction_Blacklist_Fails(t *testing.T) {
 	w := initializeWatcher()
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx_blacklist.TransactionID).Return(tx_blacklist, nil)
 
-	w.processTransaction(tx_blacklist.TransactionID, mocks.MQueue)
+	w.processTransaction(tx_blacklist.TransactionID, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -253,7 +371,7 @@ func Test_ProcessHbarTransaction_Blacklist_Fails(t *testing.T) {
 	w := initializeWatcher()
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx_blacklist.TransactionID).Return(tx_blacklist, nil)
 
-	w.processTransaction(tx_blacklist.TransactionID, mocks.MQueue)
+	w.processTransaction(tx_blacklist.TransactionID, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -264,7 +382,7 @@ func Test_ProcessTransaction_GetIncomingTransfer_Fails(t *testing.T) {
 	anotherTx.Transfers = []transaction.Transfer{}
 	anotherTx.TokenTransfers = []transaction.Transfer{}
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", anotherTx.TransactionID).Return(anotherTx, nil)
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	w.processTransaction(anotherTx.TransactionID, mocks.MQueue, false)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 	mocks.MTransferService.AssertNotCalled(t, "SanityCheckTransfer", mock.Anything)
@@ -391,7 +509,7 @@ func Test_ConsensusTimestamp_Fails(t *testing.T) {
 	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork3, true)
 
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	w.processTransaction(anotherTx.TransactionID, mocks.MQueue, false)
 }
 
 func Test_validateNftTokenCustomFees(t *testing.T) {
@@ -548,5 +666,6 @@ func initializeWatcher() *Watcher {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		mocks.MHealthService,
 	)
 }
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letters
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+var (
+	Route  = "/dead-letters"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+func NewRouter(deadLettersService service.DeadLetters, nodeConfig config.Node) chi.Router {
+	r := chi.NewRouter()
+	r.Use(httpHelper.RequireApiKey(nodeConfig.AdminApiKey))
+	r.Get("/", getDeadLetters(deadLettersService))
+	r.Get("/{id}", getDeadLetter(deadLettersService))
+	r.Post("/{id}/replay", replay(deadLettersService))
+	return r
+}
+
+// GET: .../dead-letters
+func getDeadLetters(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deadLetters, err := deadLettersService.DeadLetters()
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, deadLetters)
+	}
+}
+
+// GET: .../dead-letters/:id
+func getDeadLetter(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := parseID(w, r)
+		if !ok {
+			return
+		}
+
+		deadLetter, err := deadLettersService.DeadLetter(id)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, deadLetter)
+	}
+}
+
+// POST: .../dead-letters/:id/replay
+func replay(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := parseID(w, r)
+		if !ok {
+			return
+		}
+
+		err := deadLettersService.Replay(id)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusAccepted)
+		render.PlainText(w, r, "OK")
+	}
+}
+
+func parseID(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, response.ErrorResponse(service.ErrWrongQuery))
+		return 0, false
+	}
+	return id, true
+}
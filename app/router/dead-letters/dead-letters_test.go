@@ -0,0 +1,160 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letters
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	deadLetterModel "github.com/limechain/hedera-eth-bridge-validator/app/model/dead-letter"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	apiKey = "some-api-key"
+	node   = config.Node{
+		AdminApiKey: apiKey,
+	}
+	deadLetter = &deadLetterModel.DeadLetter{
+		ID:          1,
+		Topic:       "HEDERA_FEE_TRANSFER",
+		PayloadType: "TRANSFER",
+		Payload:     []byte(`{}`),
+		Error:       "some-error",
+		Attempts:    1,
+	}
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MDeadLettersService, node)
+
+	assert.NotNil(t, router)
+}
+
+func Test_Authorize(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("DeadLetters").Return([]*deadLetterModel.DeadLetter{deadLetter}, nil)
+
+	res := serve(NewRouter(mocks.MDeadLettersService, node), http.MethodGet, "/", apiKey)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_Authorize_WrongKey(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(NewRouter(mocks.MDeadLettersService, node), http.MethodGet, "/", "wrong-key")
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MDeadLettersService.AssertNotCalled(t, "DeadLetters")
+}
+
+func Test_Authorize_KeyNotSet(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(NewRouter(mocks.MDeadLettersService, config.Node{}), http.MethodGet, "/", "")
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MDeadLettersService.AssertNotCalled(t, "DeadLetters")
+}
+
+func Test_GetDeadLetters_Fails(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("DeadLetters").Return(nil, errors.New("some-error"))
+
+	w := httptest.NewRecorder()
+	getDeadLetters(mocks.MDeadLettersService)(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func Test_GetDeadLetter(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("DeadLetter", uint64(1)).Return(deadLetter, nil)
+
+	w := httptest.NewRecorder()
+	getDeadLetter(mocks.MDeadLettersService)(w, prepareRequest(http.MethodGet, "1"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_GetDeadLetter_NotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("DeadLetter", uint64(1)).Return(nil, service.ErrNotFound)
+
+	w := httptest.NewRecorder()
+	getDeadLetter(mocks.MDeadLettersService)(w, prepareRequest(http.MethodGet, "1"))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func Test_GetDeadLetter_InvalidId(t *testing.T) {
+	mocks.Setup()
+
+	w := httptest.NewRecorder()
+	getDeadLetter(mocks.MDeadLettersService)(w, prepareRequest(http.MethodGet, "invalid"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mocks.MDeadLettersService.AssertNotCalled(t, "DeadLetter", mock.Anything)
+}
+
+func Test_Replay(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("Replay", uint64(1)).Return(nil)
+
+	w := httptest.NewRecorder()
+	replay(mocks.MDeadLettersService)(w, prepareRequest(http.MethodPost, "1"))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "OK", w.Body.String())
+}
+
+func Test_Replay_NotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MDeadLettersService.On("Replay", uint64(1)).Return(service.ErrNotFound)
+
+	w := httptest.NewRecorder()
+	replay(mocks.MDeadLettersService)(w, prepareRequest(http.MethodPost, "1"))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func serve(router http.Handler, method, path, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if key != "" {
+		req.Header.Set(httpHelper.ApiKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func prepareRequest(method, id string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	req := httptest.NewRequest(method, "/", nil)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
@@ -13,13 +13,13 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  */
-
 package healthcheck
 
 import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
-	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
 	"net/http"
 )
 
@@ -28,17 +28,21 @@ var (
 )
 
 //Router for health check
-func NewRouter() http.Handler {
+func NewRouter(healthService service.Health) http.Handler {
 	r := chi.NewRouter()
-	r.Get("/", healthResponse())
+	r.Get("/", healthResponse(healthService.Liveness))
+	r.Get("/live", healthResponse(healthService.Liveness))
+	r.Get("/ready", healthResponse(healthService.Readiness))
 	return r
 }
 
-// GET: .../health
-func healthResponse() func(w http.ResponseWriter, r *http.Request) {
+// GET: .../health, .../health/live, .../health/ready
+func healthResponse(check func() *health.Status) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		render.JSON(w, r, &response.HealthResponse{
-			Status: "OK",
-		})
+		status := check()
+		if status.Status != health.StatusOK {
+			render.Status(r, http.StatusServiceUnavailable)
+		}
+		render.JSON(w, r, status)
 	}
 }
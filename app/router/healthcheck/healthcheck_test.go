@@ -13,48 +13,92 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  */
-
 package healthcheck
 
 import (
-	"bytes"
 	"encoding/json"
-	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
-	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
-	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	healthy = &health.Status{
+		Status: health.StatusOK,
+		Watchers: map[string]health.Watcher{
+			"price-watcher": {Status: health.StatusOK},
+		},
+	}
+	unhealthy = &health.Status{
+		Status: health.StatusUnhealthy,
+		Clients: map[string]health.Client{
+			"mirror-node": {Status: health.StatusUnhealthy, Calls: 10, ErrorRate: 1, Error: "some-error"},
+		},
+	}
 )
 
 func Test_NewRouter(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(mocks.MHealthService)
 
 	assert.NotNil(t, router)
 }
 
-func Test_configBridgeResponse(t *testing.T) {
+func Test_Health(t *testing.T) {
 	mocks.Setup()
+	mocks.MHealthService.On("Liveness").Return(healthy)
 
-	buf := &bytes.Buffer{}
-	enc := json.NewEncoder(buf)
+	res := serve("/")
 
-	healthCheckResponse := &response.HealthResponse{
-		Status: "OK",
-	}
+	assert.Equal(t, http.StatusOK, res.Code)
+	assertBody(t, healthy, res)
+}
 
-	var err error
-	if err := enc.Encode(healthCheckResponse); err != nil {
-		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
-	}
+func Test_Live(t *testing.T) {
+	mocks.Setup()
+	mocks.MHealthService.On("Liveness").Return(healthy)
 
-	healthCheckResponseAsBytes := buf.Bytes()
-	mocks.MResponseWriter.On("Header").Return(http.Header{})
-	mocks.MResponseWriter.On("Write", healthCheckResponseAsBytes).Return(len(healthCheckResponseAsBytes), nil)
+	res := serve("/live")
 
-	healthCheckResponseHandler := healthResponse()
-	healthCheckResponseHandler(mocks.MResponseWriter, new(http.Request))
+	assert.Equal(t, http.StatusOK, res.Code)
+	assertBody(t, healthy, res)
+	mocks.MHealthService.AssertNotCalled(t, "Readiness")
+}
+
+func Test_Ready(t *testing.T) {
+	mocks.Setup()
+	mocks.MHealthService.On("Readiness").Return(healthy)
+
+	res := serve("/ready")
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assertBody(t, healthy, res)
+	mocks.MHealthService.AssertNotCalled(t, "Liveness")
+}
+
+func Test_Ready_Unhealthy(t *testing.T) {
+	mocks.Setup()
+	mocks.MHealthService.On("Readiness").Return(unhealthy)
+
+	res := serve("/ready")
+
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+	assertBody(t, unhealthy, res)
+}
+
+func serve(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	res := httptest.NewRecorder()
+	NewRouter(mocks.MHealthService).ServeHTTP(res, req)
+	return res
+}
 
+func assertBody(t *testing.T, expected *health.Status, res *httptest.ResponseRecorder) {
+	actual := &health.Status{}
+	err := json.Unmarshal(res.Body.Bytes(), actual)
 	assert.Nil(t, err)
-	assert.NotNil(t, healthCheckResponseHandler)
-	assert.NotNil(t, healthCheckResponseAsBytes)
+	assert.Equal(t, expected, actual)
 }
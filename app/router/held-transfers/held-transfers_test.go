@@ -0,0 +1,89 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package held_transfers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	policyModel "github.com/limechain/hedera-eth-bridge-validator/app/model/policy"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	apiKey = "some-api-key"
+	node   = config.Node{
+		AdminApiKey: apiKey,
+	}
+	heldTransfer = &policyModel.HeldTransfer{
+		TransferId:    "0.0.123-1234567890-123456789",
+		SourceChainId: 296,
+		TargetChainId: 80001,
+		Asset:         "0x0000000000000000000000000000000000000001",
+		Originator:    "0.0.123",
+		Amount:        "1500",
+		UsdAmount:     "1500",
+		Reason:        "amount [1500] exceeds the max amount [1000] of the asset",
+	}
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MPolicyService, node)
+
+	assert.NotNil(t, router)
+}
+
+func Test_GetHeldTransfers(t *testing.T) {
+	mocks.Setup()
+	mocks.MPolicyService.On("Held").Return([]*policyModel.HeldTransfer{heldTransfer}, nil)
+
+	res := serve(NewRouter(mocks.MPolicyService, node), apiKey)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Body.String(), heldTransfer.Reason)
+}
+
+func Test_GetHeldTransfers_WrongKey(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(NewRouter(mocks.MPolicyService, node), "wrong-key")
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MPolicyService.AssertNotCalled(t, "Held")
+}
+
+func Test_GetHeldTransfers_Fails(t *testing.T) {
+	mocks.Setup()
+	mocks.MPolicyService.On("Held").Return(nil, errors.New("some-error"))
+
+	w := httptest.NewRecorder()
+	getHeldTransfers(mocks.MPolicyService)(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func serve(router http.Handler, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(httpHelper.ApiKeyHeader, key)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
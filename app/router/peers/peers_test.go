@@ -0,0 +1,97 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package peers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	address = "0xb083879B1e10C8476802016CB12cd2F25a896691"
+	since   = time.Unix(1700000000, 0).UTC()
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MPeersService)
+
+	assert.NotNil(t, router)
+}
+
+func Test_GetReport(t *testing.T) {
+	mocks.Setup()
+	report := &peer.Report{
+		Since: since,
+		Peers: []*peer.Peer{
+			{
+				Address:               address,
+				Member:                true,
+				Signed:                10,
+				Missed:                1,
+				AverageSigningLatency: 2.5,
+				Rejections:            map[string]int64{peer.ReasonWrongAmount: 2},
+			},
+		},
+	}
+	mocks.MPeersService.On("Report").Return(report, nil)
+
+	res := serve(http.MethodGet, "/")
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"since":"2023-11-14T22:13:20Z","peers":[{"address":"0xb083879B1e10C8476802016CB12cd2F25a896691","member":true,"signed":10,"missed":1,"averageSigningLatency":2.5,"rejections":{"wrong_amount":2}}]}`, res.Body.String())
+}
+
+func Test_GetReport_Fails(t *testing.T) {
+	mocks.Setup()
+	mocks.MPeersService.On("Report").Return(nil, errors.New("some-error"))
+
+	res := serve(http.MethodGet, "/")
+
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_GetRejections(t *testing.T) {
+	mocks.Setup()
+	rejections := []*peer.Rejection{
+		{
+			TransferId: "some-transfer-id",
+			Signer:     address,
+			Signature:  "some-signature",
+			Reason:     peer.ReasonDuplicate,
+			RejectedAt: since,
+		},
+	}
+	mocks.MPeersService.On("Rejections", address).Return(rejections, nil)
+
+	res := serve(http.MethodGet, "/"+address+"/rejections")
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `[{"transferId":"some-transfer-id","signer":"0xb083879B1e10C8476802016CB12cd2F25a896691","signature":"some-signature","reason":"duplicate","rejectedAt":"2023-11-14T22:13:20Z"}]`, res.Body.String())
+}
+
+func serve(method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	res := httptest.NewRecorder()
+	NewRouter(mocks.MPeersService).ServeHTTP(res, req)
+	return res
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reprocess
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	reprocessModel "github.com/limechain/hedera-eth-bridge-validator/app/model/reprocess"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	apiKey = "some-api-key"
+	node   = config.Node{
+		AdminApiKey: apiKey,
+	}
+	body    = `{"source":"296","from":100,"to":200}`
+	request = &reprocessModel.Request{Source: "296", From: 100, To: 200}
+	report  = &reprocessModel.Report{
+		Source:  "296",
+		From:    100,
+		To:      200,
+		Found:   2,
+		Created: []string{"0xhash-1"},
+		Skipped: []string{"0xhash-2"},
+	}
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MReprocessService, node)
+
+	assert.NotNil(t, router)
+}
+
+func Test_Reprocess(t *testing.T) {
+	mocks.Setup()
+	mocks.MReprocessService.On("Reprocess", mock.Anything, request).Return(report, nil)
+
+	res := serve(NewRouter(mocks.MReprocessService, node), body, apiKey)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"source":"296","from":100,"to":200,"found":2,"created":["0xhash-1"],"skipped":["0xhash-2"]}`, res.Body.String())
+}
+
+func Test_Reprocess_Unauthorized(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(NewRouter(mocks.MReprocessService, node), body, "wrong-key")
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MReprocessService.AssertNotCalled(t, "Reprocess", mock.Anything, mock.Anything)
+}
+
+func Test_Reprocess_InvalidBody(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(NewRouter(mocks.MReprocessService, node), "invalid", apiKey)
+
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	mocks.MReprocessService.AssertNotCalled(t, "Reprocess", mock.Anything, mock.Anything)
+}
+
+func Test_Reprocess_UnknownSource(t *testing.T) {
+	mocks.Setup()
+	mocks.MReprocessService.On("Reprocess", mock.Anything, request).Return(nil, service.ErrNotFound)
+
+	res := serve(NewRouter(mocks.MReprocessService, node), body, apiKey)
+
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_Reprocess_Fails(t *testing.T) {
+	mocks.Setup()
+	mocks.MReprocessService.On("Reprocess", mock.Anything, request).Return(nil, errors.New("some-error"))
+
+	res := serve(NewRouter(mocks.MReprocessService, node), body, apiKey)
+
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func serve(router http.Handler, body, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(httpHelper.ApiKeyHeader, key)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
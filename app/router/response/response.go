@@ -36,7 +36,3 @@ func ErrorResponse(err error) *ErrResponse {
 		ErrorMessage: err.Error(),
 	}
 }
-
-type HealthResponse struct {
-	Status string `json:"status"`
-}
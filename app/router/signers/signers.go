@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signers
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/signer"
+)
+
+var (
+	Route = "/signers"
+)
+
+// Router for the EVM signers of the validator
+func NewRouter(signers map[uint64]service.Signer) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/", getSigners(signers))
+	return r
+}
+
+// GET: .../signers
+func getSigners(signers map[uint64]service.Signer) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response := make([]signer.Signer, 0, len(signers))
+		for chainId, evmSigner := range signers {
+			s := signer.Signer{
+				ChainId:       chainId,
+				ActiveAddress: evmSigner.Address(),
+			}
+			if rotating, ok := evmSigner.(service.RotatingSigner); ok {
+				s.NextAddress = rotating.NextAddress()
+			}
+			response = append(response, s)
+		}
+		sort.Slice(response, func(i, j int) bool {
+			return response[i].ChainId < response[j].ChainId
+		})
+
+		render.JSON(w, r, response)
+	}
+}
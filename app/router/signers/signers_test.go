@@ -0,0 +1,63 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(map[uint64]service.Signer{})
+
+	assert.NotNil(t, router)
+}
+
+func Test_GetSigners(t *testing.T) {
+	mocks.Setup()
+	active := &mockAddressSigner{address: "0xactive"}
+	next := &mockAddressSigner{address: "0xnext"}
+	mocks.MSignerService.On("Address").Return("0xsigner")
+	mocks.MBridgeContractService.On("IsMember", "0xactive").Return(true)
+	mocks.MBridgeContractService.On("IsMember", "0xnext").Return(false)
+	signers := map[uint64]service.Signer{
+		296:   evm.NewDualKeySigner(active, next, mocks.MBridgeContractService),
+		80001: mocks.MSignerService,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	res := httptest.NewRecorder()
+	NewRouter(signers).ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `[{"chainId":296,"activeAddress":"0xactive","nextAddress":"0xnext"},{"chainId":80001,"activeAddress":"0xsigner"}]`, res.Body.String())
+}
+
+type mockAddressSigner struct {
+	service.Signer
+	address string
+}
+
+func (s *mockAddressSigner) Address() string {
+	return s.address
+}
@@ -29,6 +29,7 @@ const maxHistoryPageSize = 50
 func NewRouter(service service.Transfers) chi.Router {
 	r := chi.NewRouter()
 	r.Get("/{id}", getTransfer(service))
+	r.Get("/{id}/claim", getClaim(service))
 	r.Post("/history", history(service))
 	return r
 }
@@ -49,6 +50,22 @@ func getTransfer(transfersService service.Transfers) func(w http.ResponseWriter,
 	}
 }
 
+// GET: .../transfers/:id/claim
+func getClaim(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		transferID := chi.URLParam(r, "id")
+
+		claimData, err := transfersService.ClaimData(transferID)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, claimData)
+	}
+}
+
 // POST: .../history
 func history(transferService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -26,6 +26,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -34,6 +35,15 @@ var (
 	transferIdUrlParamKey = "id"
 	transferId            = "1"
 	transfer              = service.TransferData{}
+	claim                 = &service.ClaimData{
+		TransferId:    transferId,
+		ChainId:       80001,
+		RouterAddress: "0x0000000000000000000000000000000000000001",
+		Method:        "mint",
+		Selector:      "0x2148199d",
+		Calldata:      "0x2148199d",
+		GasEstimate:   150000,
+	}
 )
 
 func Test_NewRouter(t *testing.T) {
@@ -127,6 +137,53 @@ func Test_getTransfer_InternalServerErr(t *testing.T) {
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusInternalServerError)
 }
 
+func Test_getClaim(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(claim); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	claimResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("ClaimData", transferId).Return(claim, nil)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", claimResponseAsBytes).Return(len(claimResponseAsBytes), nil)
+
+	claimResponseHandler := getClaim(mocks.MTransferService)
+	claimResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "ClaimData", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "Write", claimResponseAsBytes)
+	mocks.MResponseWriter.AssertNotCalled(t, "WriteHeader", mock.Anything)
+}
+
+func Test_getClaim_ErrMajorityNotReached(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(response.ErrorResponse(service.ErrMajorityNotReached)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	claimResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("ClaimData", transferId).Return(nil, service.ErrMajorityNotReached)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", claimResponseAsBytes).Return(len(claimResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusBadRequest).Return()
+
+	claimResponseHandler := getClaim(mocks.MTransferService)
+	claimResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "ClaimData", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "Write", claimResponseAsBytes)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusBadRequest)
+}
+
 func prepareRequest() *http.Request {
 	request := new(http.Request)
 	chiCtx := &chi.Context{
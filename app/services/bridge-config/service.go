@@ -128,39 +128,25 @@ func (s *Service) fetchAllChunks(topicID hedera.TopicID, lastMessage mirrorNodeM
 	}
 
 	firstConsensusTimestamp, _ := timestamp.FromString(msg.ConsensusTimestamp)
-	if lastMessage.ChunkInfo.Total <= s.queryMaxLimit {
-		allChunks, err := s.mirrorNode.GetMessagesAfterTimestamp(topicID, firstConsensusTimestamp-1, lastMessage.ChunkInfo.Total)
-		if err != nil {
-			errMsg := fmt.Sprintf("Failed to fetch messages after first consensus timestamp - [%d]. Err: [%s]", firstConsensusTimestamp, err)
-			return nil, errors.New(errMsg)
-		}
-		return allChunks, nil
+	limit := lastMessage.ChunkInfo.Total
+	if limit > s.queryMaxLimit {
+		limit = s.queryMaxLimit
 	}
 
-	consensusTimestamp := firstConsensusTimestamp - 1
-	countOfRequestsWithMaxLimit := int(lastMessage.ChunkInfo.Total / s.queryMaxLimit)
-	leftOverChunks := lastMessage.ChunkInfo.Total % s.queryMaxLimit
-	allChunks := make([]mirrorNodeMsg.Message, 0)
-	for i := 0; i < countOfRequestsWithMaxLimit; i++ {
-		currMsgs, err := s.mirrorNode.GetMessagesAfterTimestamp(topicID, consensusTimestamp, s.queryMaxLimit)
+	pages := s.mirrorNode.IterateMessagesAfterTimestamp(topicID, firstConsensusTimestamp-1, limit)
+	allChunks := make([]mirrorNodeMsg.Message, 0, lastMessage.ChunkInfo.Total)
+	for int64(len(allChunks)) < lastMessage.ChunkInfo.Total && pages.HasNext() {
+		currMsgs, err := pages.Next()
 		if err != nil {
-			errMsg := fmt.Sprintf("Failed to fetch messages after first consensus timestamp - [%d]. Err: [%s]", consensusTimestamp, err)
+			errMsg := fmt.Sprintf("Failed to fetch messages after first consensus timestamp - [%d]. Err: [%s]", firstConsensusTimestamp, err)
 			return nil, errors.New(errMsg)
 		}
-
 		allChunks = append(allChunks, currMsgs...)
-		consensusTimestamp, _ = timestamp.FromString(currMsgs[len(currMsgs)-1].ConsensusTimestamp)
 	}
 
-	if leftOverChunks > 0 {
-		currMsgs, err := s.mirrorNode.GetMessagesAfterTimestamp(topicID, consensusTimestamp, leftOverChunks)
-		if err != nil {
-			errMsg := fmt.Sprintf("Failed to fetch messages after first consensus timestamp - [%d]. Err: [%s]", consensusTimestamp, err)
-			return nil, errors.New(errMsg)
-		}
-		allChunks = append(allChunks, currMsgs...)
+	if int64(len(allChunks)) > lastMessage.ChunkInfo.Total {
+		allChunks = allChunks[:lastMessage.ChunkInfo.Total]
 	}
-
 	return allChunks, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
@@ -135,8 +136,7 @@ func Test_ProcessLatestConfig_TwoChunks(t *testing.T) {
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{twoMsgs[1]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&twoMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(1)).Return([]message.Message{twoMsgs[0]}, nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp, int64(1)).Return([]message.Message{twoMsgs[1]}, nil)
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(1)).Return(helper.MakePages([]message.Message{twoMsgs[0]}, []message.Message{twoMsgs[1]}))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 
@@ -150,7 +150,7 @@ func Test_ProcessLatestConfig_TwoChunksWithBiggerMaxLimit(t *testing.T) {
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{twoMsgs[1]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&twoMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(len(twoMsgs))).Return([]message.Message{twoMsgs[0], twoMsgs[1]}, nil)
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(len(twoMsgs))).Return(helper.MakePages([]message.Message{twoMsgs[0], twoMsgs[1]}))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 	serviceInstance.queryMaxLimit = queryMaxLimit
@@ -166,8 +166,7 @@ func Test_ProcessLatestConfig_WaitingChunks(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{twoMsgs[0]}, nil).Once()
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{twoMsgs[1]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&twoMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(1)).Return([]message.Message{twoMsgs[0]}, nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp, int64(1)).Return([]message.Message{twoMsgs[1]}, nil)
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, int64(1)).Return(helper.MakePages([]message.Message{twoMsgs[0]}, []message.Message{twoMsgs[1]}))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 
@@ -181,8 +180,7 @@ func Test_ProcessLatestConfig_ThreeChunksWithLeftOver(t *testing.T) {
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{threeMsgs[2]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&threeMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return([]message.Message{threeMsgs[0], threeMsgs[1]}, nil).Once()
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp+1, int64(1)).Return([]message.Message{threeMsgs[2]}, nil)
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return(helper.MakePages([]message.Message{threeMsgs[0], threeMsgs[1]}, []message.Message{threeMsgs[2]}))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 	serviceInstance.queryMaxLimit = queryMaxLimit
@@ -243,7 +241,7 @@ func Test_ProcessLatestConfig_ErrOneMissingFromThreeChunks(t *testing.T) {
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{threeMsgs[2]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&threeMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return([]message.Message{threeMsgs[0], threeMsgs[2]}, nil).Once()
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return(helper.MakePages([]message.Message{threeMsgs[0], threeMsgs[2]}))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 	serviceInstance.queryMaxLimit = queryMaxLimit
@@ -269,7 +267,7 @@ func Test_ProcessLatestConfig_ErrOnFetchingAllChunks(t *testing.T) {
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{threeMsgs[2]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&threeMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return(nilMsgs, returnErr).Once()
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return(helper.MakeFailingPages[message.Message](returnErr))
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 
@@ -277,14 +275,19 @@ func Test_ProcessLatestConfig_ErrOnFetchingAllChunks(t *testing.T) {
 	assert.Nil(t, parsedBridge)
 }
 
-func Test_ProcessLatestConfig_ErrOnFetchingLeftOverChunks(t *testing.T) {
+func Test_ProcessLatestConfig_ErrOnFetchingNextPage(t *testing.T) {
 	setup()
 	serviceInstance.queryMaxLimit = 2
 
 	mocks.MHederaMirrorClient.On("GetLatestMessages", configTopicId, int64(1)).Return([]message.Message{threeMsgs[2]}, nil)
 	mocks.MHederaMirrorClient.On("GetMessageBySequenceNumber", configTopicId, int64(1)).Return(&threeMsgs[0], nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return([]message.Message{threeMsgs[0], threeMsgs[1]}, nil).Once()
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", configTopicId, consensusTimestamp+1, int64(1)).Return(nilMsgs, returnErr).Once()
+	pages := pagination.New("0", func(url string) ([]message.Message, string, error) {
+		if url == "0" {
+			return []message.Message{threeMsgs[0], threeMsgs[1]}, "1", nil
+		}
+		return nil, "", returnErr
+	})
+	mocks.MHederaMirrorClient.On("IterateMessagesAfterTimestamp", configTopicId, consensusTimestamp-1, serviceInstance.queryMaxLimit).Return(pages)
 
 	parsedBridge, err := serviceInstance.ProcessLatestConfig(configTopicId)
 
@@ -18,6 +18,7 @@ package burn_event
 
 import (
 	"database/sql"
+	"errors"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -48,6 +49,7 @@ type Service struct {
 	transferService    service.Transfers
 	logger             *log.Entry
 	prometheusService  service.Prometheus
+	policyService      service.Policy
 }
 
 func NewService(
@@ -59,7 +61,8 @@ func NewService(
 	scheduled service.Scheduled,
 	feeService service.Fee,
 	transferService service.Transfers,
-	prometheusService service.Prometheus) *Service {
+	prometheusService service.Prometheus,
+	policyService service.Policy) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
 	if err != nil {
@@ -76,46 +79,55 @@ func NewService(
 		scheduledService:   scheduled,
 		transferService:    transferService,
 		prometheusService:  prometheusService,
+		policyService:      policyService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
 }
 
-func (s Service) ProcessEvent(event payload.Transfer) {
+func (s Service) ProcessEvent(event payload.Transfer) error {
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.TargetAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
-		return
+		return err
 	}
 
 	receiver, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
 	}
 
 	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	err = s.policyService.Evaluate(event)
+	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
+		return err
 	}
 
 	fee, splitTransfers, err := s.prepareTransfers(event.NativeAsset, amount, receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to prepare transfers. Error [%s].", event.TransactionId, err)
-		return
+		return err
 	}
 
 	err = s.repository.UpdateFee(event.TransactionId, strconv.FormatInt(fee, 10))
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to update fee [%d]. Error [%s].", event.TransactionId, fee, err)
-		return
+		return err
 	}
 
 	var (
@@ -144,6 +156,8 @@ func (s Service) ProcessEvent(event payload.Transfer) {
 	}
 
 	s.startAwaitingFunctionsForMetrics(event, feeOutParams, userOutParams)
+
+	return nil
 }
 
 func (s Service) startAwaitingFunctionsForMetrics(event payload.Transfer, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) {
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -32,6 +33,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -99,6 +101,7 @@ func Test_ProcessEvent(t *testing.T) {
 	}
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MPolicyService.On("Evaluate", tr).Return(nil)
 	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
 	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return([]transfer.Hedera{}, nil)
@@ -152,6 +155,7 @@ func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
 	}
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MPolicyService.On("Evaluate", tr).Return(nil)
 	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
 	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return(nil, errors.New("invalid-result"))
@@ -160,6 +164,20 @@ func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
 	s.ProcessEvent(tr)
 }
 
+func Test_ProcessEventHeld(t *testing.T) {
+	setup()
+
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MPolicyService.On("Evaluate", tr).Return(service.ErrTransferHeld)
+
+	err := s.ProcessEvent(tr)
+
+	assert.Nil(t, err)
+	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", tr.NativeAsset, burnEventAmount)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", mock.Anything, mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_New(t *testing.T) {
 	setup()
 	actualService := NewService(hederaAccount.String(),
@@ -170,7 +188,8 @@ func Test_New(t *testing.T) {
 		mocks.MScheduledService,
 		mocks.MFeeService,
 		mocks.MTransferService,
-		mocks.MPrometheusService)
+		mocks.MPrometheusService,
+		mocks.MPolicyService)
 	assert.Equal(t, s, actualService)
 }
 
@@ -490,6 +509,7 @@ func setup() {
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
 		prometheusService:  mocks.MPrometheusService,
+		policyService:      mocks.MPolicyService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
 }
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	deadLetterModel "github.com/limechain/hedera-eth-bridge-validator/app/model/dead-letter"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repository repository.DeadLetter
+	queue      qi.Queue
+	logger     *log.Entry
+}
+
+func NewService(repository repository.DeadLetter, queue qi.Queue) *Service {
+	return &Service{
+		repository: repository,
+		queue:      queue,
+		logger:     config.GetLoggerFor("Dead Letters Service"),
+	}
+}
+
+// Capture stores the failed message together with its error. Failures of replayed messages
+// increment the attempts of the dead letter they are replayed from
+func (s *Service) Capture(message *queue.Message, err error) {
+	if message.DeadLetterID != 0 {
+		updateErr := s.repository.UpdateFailure(message.DeadLetterID, err.Error())
+		if updateErr != nil {
+			s.logger.Errorf("[%d] - Failed to update dead letter. Error: [%s]", message.DeadLetterID, updateErr)
+		}
+		return
+	}
+
+	payloadType, data, encodeErr := persistent.EncodePayload(message.Payload)
+	if encodeErr != nil {
+		s.logger.Errorf("[%s] - Failed to encode payload [%v]. Error: [%s]", message.Topic, message.Payload, encodeErr)
+		return
+	}
+
+	deadLetter := &entity.DeadLetter{
+		Topic:       message.Topic,
+		PayloadType: payloadType,
+		Payload:     data,
+		Error:       err.Error(),
+		Attempts:    1,
+	}
+	createErr := s.repository.Create(deadLetter)
+	if createErr != nil {
+		s.logger.Errorf("[%s] - Failed to create dead letter. Error: [%s]", message.Topic, createErr)
+		return
+	}
+	s.logger.Infof("[%s] - Captured failed payload as dead letter [%d].", message.Topic, deadLetter.ID)
+}
+
+// Resolve removes the dead letter, which the successfully handled message was replayed from
+func (s *Service) Resolve(message *queue.Message) {
+	if message.DeadLetterID == 0 {
+		return
+	}
+
+	err := s.repository.Delete(message.DeadLetterID)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to delete resolved dead letter. Error: [%s]", message.DeadLetterID, err)
+		return
+	}
+	s.logger.Infof("[%d] - Dead letter got resolved.", message.DeadLetterID)
+}
+
+func (s *Service) DeadLetters() ([]*deadLetterModel.DeadLetter, error) {
+	deadLetters, err := s.repository.GetAll()
+	if err != nil {
+		s.logger.Errorf("Failed to get dead letters. Error: [%s]", err)
+		return nil, err
+	}
+
+	result := make([]*deadLetterModel.DeadLetter, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		result = append(result, deadLetter.ToDto())
+	}
+	return result, nil
+}
+
+func (s *Service) DeadLetter(id uint64) (*deadLetterModel.DeadLetter, error) {
+	deadLetter, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return deadLetter.ToDto(), nil
+}
+
+// Replay re-enqueues the payload of the dead letter onto its original handler topic
+func (s *Service) Replay(id uint64) error {
+	deadLetter, err := s.get(id)
+	if err != nil {
+		return err
+	}
+
+	payload, err := persistent.DecodePayload(deadLetter.PayloadType, deadLetter.Payload)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to decode dead letter payload. Error: [%s]", id, err)
+		return err
+	}
+
+	s.queue.Push(&queue.Message{Payload: payload, Topic: deadLetter.Topic, DeadLetterID: deadLetter.ID})
+	s.logger.Infof("[%d] - Replayed dead letter on topic [%s].", id, deadLetter.Topic)
+	return nil
+}
+
+func (s *Service) get(id uint64) (*entity.DeadLetter, error) {
+	deadLetter, err := s.repository.Get(id)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to get dead letter. Error: [%s]", id, err)
+		return nil, err
+	}
+	if deadLetter == nil {
+		return nil, service.ErrNotFound
+	}
+	return deadLetter, nil
+}
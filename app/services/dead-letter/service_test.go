@@ -0,0 +1,210 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s        *Service
+	topic    = "HEDERA_FEE_TRANSFER"
+	transfer = &payload.Transfer{
+		TransactionId: "0.0.123123-123321-420",
+		SourceChainId: 0,
+		TargetChainId: 1,
+		NativeChainId: 0,
+		SourceAsset:   "0.0.1111",
+		TargetAsset:   "0xb083879B1e10C8476802016CB12cd2F25a896691",
+		NativeAsset:   "0.0.1111",
+		Receiver:      "0xsomeotherethaddress",
+		Amount:        "100",
+	}
+	handlerErr = errors.New("handler-error")
+)
+
+func Test_New(t *testing.T) {
+	setup()
+
+	expectedService := &Service{
+		repository: mocks.MDeadLetterRepository,
+		queue:      mocks.MQueue,
+		logger:     config.GetLoggerFor("Dead Letters Service"),
+	}
+
+	actualService := NewService(mocks.MDeadLetterRepository, mocks.MQueue)
+
+	assert.Equal(t, expectedService, actualService)
+}
+
+func Test_Capture(t *testing.T) {
+	setup()
+	_, data, _ := persistent.EncodePayload(transfer)
+	mocks.MDeadLetterRepository.On("Create", &entity.DeadLetter{
+		Topic:       topic,
+		PayloadType: persistent.TransferPayload,
+		Payload:     data,
+		Error:       handlerErr.Error(),
+		Attempts:    1,
+	}).Return(nil)
+
+	s.Capture(&queue.Message{Payload: transfer, Topic: topic}, handlerErr)
+
+	mocks.MDeadLetterRepository.AssertExpectations(t)
+}
+
+func Test_Capture_UnsupportedPayload(t *testing.T) {
+	setup()
+
+	s.Capture(&queue.Message{Payload: "invalid-payload", Topic: topic}, handlerErr)
+
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Capture_Replayed(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("UpdateFailure", uint64(1), handlerErr.Error()).Return(nil)
+
+	s.Capture(&queue.Message{Payload: transfer, Topic: topic, DeadLetterID: 1}, handlerErr)
+
+	mocks.MDeadLetterRepository.AssertExpectations(t)
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Resolve(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("Delete", uint64(1)).Return(nil)
+
+	s.Resolve(&queue.Message{Payload: transfer, Topic: topic, DeadLetterID: 1})
+
+	mocks.MDeadLetterRepository.AssertExpectations(t)
+}
+
+func Test_Resolve_NotReplayed(t *testing.T) {
+	setup()
+
+	s.Resolve(&queue.Message{Payload: transfer, Topic: topic})
+
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func Test_DeadLetters(t *testing.T) {
+	setup()
+	deadLetter := someDeadLetter()
+	mocks.MDeadLetterRepository.On("GetAll").Return([]*entity.DeadLetter{deadLetter}, nil)
+
+	actual, err := s.DeadLetters()
+
+	assert.Nil(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, deadLetter.ToDto(), actual[0])
+}
+
+func Test_DeadLetters_Fails(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("GetAll").Return(nil, errors.New("some-error"))
+
+	actual, err := s.DeadLetters()
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_DeadLetter(t *testing.T) {
+	setup()
+	deadLetter := someDeadLetter()
+	mocks.MDeadLetterRepository.On("Get", uint64(1)).Return(deadLetter, nil)
+
+	actual, err := s.DeadLetter(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, deadLetter.ToDto(), actual)
+}
+
+func Test_DeadLetter_NotFound(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("Get", uint64(1)).Return(nil, nil)
+
+	actual, err := s.DeadLetter(1)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Replay(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("Get", uint64(1)).Return(someDeadLetter(), nil)
+	mocks.MQueue.On("Push", &queue.Message{Payload: transfer, Topic: topic, DeadLetterID: 1}).Return()
+
+	err := s.Replay(1)
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertExpectations(t)
+}
+
+func Test_Replay_NotFound(t *testing.T) {
+	setup()
+	mocks.MDeadLetterRepository.On("Get", uint64(1)).Return(nil, nil)
+
+	err := s.Replay(1)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Replay_InvalidPayloadType(t *testing.T) {
+	setup()
+	deadLetter := someDeadLetter()
+	deadLetter.PayloadType = "UNKNOWN"
+	mocks.MDeadLetterRepository.On("Get", uint64(1)).Return(deadLetter, nil)
+
+	err := s.Replay(1)
+
+	assert.Error(t, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func someDeadLetter() *entity.DeadLetter {
+	_, data, _ := persistent.EncodePayload(transfer)
+	return &entity.DeadLetter{
+		ID:          1,
+		Topic:       topic,
+		PayloadType: persistent.TransferPayload,
+		Payload:     data,
+		Error:       handlerErr.Error(),
+		Attempts:    2,
+		CreatedAt:   time.Unix(1, 0),
+		UpdatedAt:   time.Unix(2, 0),
+	}
+}
+
+func setup() {
+	mocks.Setup()
+	s = NewService(mocks.MDeadLetterRepository, mocks.MQueue)
+}
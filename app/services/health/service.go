@@ -0,0 +1,215 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Number of latest calls, over which the error rate of a client is calculated
+	callsWindow = 100
+	// Minimum number of calls, before the error rate of a client is taken into account
+	minCalls = 10
+)
+
+type watcher struct {
+	interval      time.Duration
+	lastHeartbeat time.Time
+	position      int64
+	lag           int64
+	err           string
+}
+
+type client struct {
+	lastSuccess time.Time
+	// Ring buffer of the latest call outcomes, where true marks a failed call
+	failed   [callsWindow]bool
+	next     int
+	calls    int
+	failures int
+	err      string
+}
+
+// Service is a registry of the health of the watchers and clients of the node
+type Service struct {
+	config   config.Health
+	watchers map[string]*watcher
+	clients  map[string]*client
+	mu       sync.RWMutex
+	logger   *log.Entry
+}
+
+func NewService(healthConfig config.Health) *Service {
+	return &Service{
+		config:   healthConfig,
+		watchers: make(map[string]*watcher),
+		clients:  make(map[string]*client),
+		logger:   config.GetLoggerFor("Health Service"),
+	}
+}
+
+func (s *Service) RegisterWatcher(name string, interval time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.watchers[name] = &watcher{
+		interval:      interval,
+		lastHeartbeat: time.Now(),
+	}
+}
+
+func (s *Service) Heartbeat(name string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.watchers[name]
+	if !ok {
+		s.logger.Warnf("Heartbeat of unregistered watcher [%s].", name)
+		return
+	}
+
+	w.lastHeartbeat = time.Now()
+	w.err = ""
+	if err != nil {
+		w.err = err.Error()
+	}
+}
+
+func (s *Service) Progress(name string, position, lag int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.watchers[name]
+	if !ok {
+		s.logger.Warnf("Progress of unregistered watcher [%s].", name)
+		return
+	}
+
+	w.position = position
+	w.lag = lag
+}
+
+func (s *Service) ReportCall(name string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.clients[name]
+	if !ok {
+		c = &client{}
+		s.clients[name] = c
+	}
+
+	if c.calls == callsWindow {
+		if c.failed[c.next] {
+			c.failures--
+		}
+	} else {
+		c.calls++
+	}
+
+	c.failed[c.next] = err != nil
+	c.next = (c.next + 1) % callsWindow
+	if err != nil {
+		c.failures++
+		c.err = err.Error()
+	} else {
+		c.lastSuccess = time.Now()
+	}
+}
+
+func (s *Service) Liveness() *health.Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := &health.Status{
+		Status:   health.StatusOK,
+		Watchers: make(map[string]health.Watcher),
+	}
+	now := time.Now()
+	for name, w := range s.watchers {
+		watcherStatus := s.watcherDto(w)
+		if s.isStale(w, now) {
+			watcherStatus.Status = health.StatusUnhealthy
+			status.Status = health.StatusUnhealthy
+		}
+		status.Watchers[name] = watcherStatus
+	}
+
+	return status
+}
+
+func (s *Service) Readiness() *health.Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := &health.Status{
+		Status:   health.StatusOK,
+		Watchers: make(map[string]health.Watcher),
+		Clients:  make(map[string]health.Client),
+	}
+	now := time.Now()
+	for name, w := range s.watchers {
+		watcherStatus := s.watcherDto(w)
+		if s.isStale(w, now) || w.err != "" {
+			watcherStatus.Status = health.StatusUnhealthy
+			status.Status = health.StatusUnhealthy
+		}
+		status.Watchers[name] = watcherStatus
+	}
+
+	for name, c := range s.clients {
+		clientStatus := health.Client{
+			Status:    health.StatusOK,
+			Calls:     c.calls,
+			ErrorRate: float64(c.failures) / float64(c.calls),
+			Error:     c.err,
+		}
+		if !c.lastSuccess.IsZero() {
+			lastSuccess := c.lastSuccess
+			clientStatus.LastSuccess = &lastSuccess
+		}
+		if c.calls >= minCalls && clientStatus.ErrorRate > s.config.MaxErrorRate {
+			clientStatus.Status = health.StatusUnhealthy
+			status.Status = health.StatusUnhealthy
+		}
+		status.Clients[name] = clientStatus
+	}
+
+	return status
+}
+
+// isStale checks whether the watcher did not complete an iteration within its interval and the heartbeat timeout
+func (s *Service) isStale(w *watcher, now time.Time) bool {
+	return now.Sub(w.lastHeartbeat) > w.interval+s.config.HeartbeatTimeout
+}
+
+func (s *Service) watcherDto(w *watcher) health.Watcher {
+	lastHeartbeat := w.lastHeartbeat
+	return health.Watcher{
+		Status:        health.StatusOK,
+		LastHeartbeat: &lastHeartbeat,
+		Position:      w.position,
+		Lag:           w.lag,
+		Error:         w.err,
+	}
+}
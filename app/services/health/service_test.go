@@ -0,0 +1,161 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	s            *Service
+	healthConfig = config.Health{
+		HeartbeatTimeout: time.Minute,
+		MaxErrorRate:     0.5,
+	}
+	watcherName = "evm-watcher-80001"
+	clientName  = "mirror-node"
+)
+
+func setup() {
+	s = NewService(healthConfig)
+}
+
+func Test_New(t *testing.T) {
+	setup()
+
+	assert.Equal(t, healthConfig, s.config)
+	assert.Empty(t, s.watchers)
+	assert.Empty(t, s.clients)
+}
+
+func Test_Liveness_NoComponents(t *testing.T) {
+	setup()
+
+	assert.Equal(t, health.StatusOK, s.Liveness().Status)
+	assert.Equal(t, health.StatusOK, s.Readiness().Status)
+}
+
+func Test_Watcher(t *testing.T) {
+	setup()
+	s.RegisterWatcher(watcherName, 15*time.Second)
+
+	s.Heartbeat(watcherName, nil)
+	s.Progress(watcherName, 100, 5)
+
+	liveness := s.Liveness()
+	assert.Equal(t, health.StatusOK, liveness.Status)
+	assert.Equal(t, int64(100), liveness.Watchers[watcherName].Position)
+	assert.Equal(t, int64(5), liveness.Watchers[watcherName].Lag)
+	assert.Equal(t, health.StatusOK, s.Readiness().Status)
+}
+
+func Test_Watcher_Failing(t *testing.T) {
+	setup()
+	s.RegisterWatcher(watcherName, 15*time.Second)
+
+	s.Heartbeat(watcherName, errors.New("some-error"))
+
+	assert.Equal(t, health.StatusOK, s.Liveness().Status)
+	readiness := s.Readiness()
+	assert.Equal(t, health.StatusUnhealthy, readiness.Status)
+	assert.Equal(t, "some-error", readiness.Watchers[watcherName].Error)
+
+	s.Heartbeat(watcherName, nil)
+
+	assert.Equal(t, health.StatusOK, s.Readiness().Status)
+}
+
+func Test_Watcher_Stale(t *testing.T) {
+	setup()
+	s.RegisterWatcher(watcherName, 15*time.Second)
+	s.watchers[watcherName].lastHeartbeat = time.Now().Add(-2 * time.Minute)
+
+	liveness := s.Liveness()
+
+	assert.Equal(t, health.StatusUnhealthy, liveness.Status)
+	assert.Equal(t, health.StatusUnhealthy, liveness.Watchers[watcherName].Status)
+	assert.Equal(t, health.StatusUnhealthy, s.Readiness().Status)
+}
+
+func Test_Watcher_Unregistered(t *testing.T) {
+	setup()
+
+	s.Heartbeat(watcherName, nil)
+	s.Progress(watcherName, 100, 5)
+
+	assert.Empty(t, s.Liveness().Watchers)
+}
+
+func Test_Client(t *testing.T) {
+	setup()
+	for i := 0; i < minCalls; i++ {
+		s.ReportCall(clientName, nil)
+	}
+	s.ReportCall(clientName, errors.New("some-error"))
+
+	readiness := s.Readiness()
+
+	assert.Equal(t, health.StatusOK, readiness.Status)
+	assert.Equal(t, minCalls+1, readiness.Clients[clientName].Calls)
+	assert.Equal(t, 1/float64(minCalls+1), readiness.Clients[clientName].ErrorRate)
+	assert.Equal(t, "some-error", readiness.Clients[clientName].Error)
+	assert.NotNil(t, readiness.Clients[clientName].LastSuccess)
+	assert.Empty(t, s.Liveness().Clients)
+}
+
+func Test_Client_ExceedsMaxErrorRate(t *testing.T) {
+	setup()
+	for i := 0; i < minCalls; i++ {
+		s.ReportCall(clientName, errors.New("some-error"))
+	}
+
+	readiness := s.Readiness()
+
+	assert.Equal(t, health.StatusUnhealthy, readiness.Status)
+	assert.Equal(t, float64(1), readiness.Clients[clientName].ErrorRate)
+	assert.Nil(t, readiness.Clients[clientName].LastSuccess)
+	assert.Equal(t, health.StatusOK, s.Liveness().Status)
+}
+
+func Test_Client_BelowMinCalls(t *testing.T) {
+	setup()
+	s.ReportCall(clientName, errors.New("some-error"))
+
+	assert.Equal(t, health.StatusOK, s.Readiness().Status)
+}
+
+func Test_Client_RecoversOverWindow(t *testing.T) {
+	setup()
+	for i := 0; i < callsWindow; i++ {
+		s.ReportCall(clientName, errors.New("some-error"))
+	}
+	for i := 0; i < callsWindow; i++ {
+		s.ReportCall(clientName, nil)
+	}
+
+	readiness := s.Readiness()
+
+	assert.Equal(t, health.StatusOK, readiness.Status)
+	assert.Equal(t, callsWindow, readiness.Clients[clientName].Calls)
+	assert.Equal(t, float64(0), readiness.Clients[clientName].ErrorRate)
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// Service elects the leader among the replicas of the node through a lock in the shared database.
+// The leader verifies that it still holds the lock on every third of the lease time, while the standby
+// replicas attempt to acquire it at the same interval. If leader election is disabled, the node is
+// always the leader.
+type Service struct {
+	lock      repository.Lock
+	config    config.LeaderElection
+	isLeader  prometheus.Gauge
+	elections prometheus.Counter
+	cancel    context.CancelFunc
+	mu        sync.Mutex
+	logger    *log.Entry
+}
+
+func NewService(lock repository.Lock, leaderElection config.LeaderElection, prometheusService service.Prometheus) *Service {
+	s := &Service{
+		lock:   lock,
+		config: leaderElection,
+		logger: config.GetLoggerFor("Leader Election Service"),
+	}
+
+	if prometheusService != nil && prometheusService.GetIsMonitoringEnabled() {
+		s.isLeader = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name: constants.LeaderGaugeName,
+			Help: constants.LeaderGaugeHelp,
+		})
+		s.elections = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.LeaderElectionsCounterName,
+			Help: constants.LeaderElectionsCounterHelp,
+		})
+	}
+
+	return s
+}
+
+// Campaign blocks until the lock is acquired or the context is cancelled.
+// The returned context is cancelled once the lock is no longer held
+func (s *Service) Campaign(ctx context.Context) (context.Context, error) {
+	if !s.config.Enable {
+		s.setLeader(true)
+		return ctx, nil
+	}
+
+	s.setLeader(false)
+	s.logger.Infof("Campaigning for leadership every [%s].", s.interval())
+	for !s.tryAcquire(ctx) {
+		if !syncHelper.Sleep(ctx, s.interval()) {
+			return nil, ctx.Err()
+		}
+	}
+
+	s.logger.Infof("Acquired leadership.")
+	s.setLeader(true)
+	if s.elections != nil {
+		s.elections.Inc()
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	go s.renew(leaderCtx, cancel)
+
+	return leaderCtx, nil
+}
+
+// Resign stops renewing the leadership and releases the lock
+func (s *Service) Resign() {
+	s.setLeader(false)
+	if !s.config.Enable {
+		return
+	}
+
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.interval())
+	defer cancel()
+	err := s.lock.Release(ctx)
+	if err != nil {
+		s.logger.Errorf("Failed to release the leadership lock. Error: [%s]", err)
+		return
+	}
+	s.logger.Infof("Resigned from leadership.")
+}
+
+func (s *Service) tryAcquire(ctx context.Context) bool {
+	acquireCtx, cancel := context.WithTimeout(ctx, s.interval())
+	defer cancel()
+
+	acquired, err := s.lock.TryAcquire(acquireCtx)
+	if err != nil {
+		s.logger.Errorf("Failed to acquire the leadership lock. Error: [%s]", err)
+		return false
+	}
+	return acquired
+}
+
+// renew verifies that the lock is still held until the context is cancelled.
+// The leadership is lost once the lock cannot be verified within the interval
+func (s *Service) renew(ctx context.Context, cancel context.CancelFunc) {
+	defer cancel()
+
+	for syncHelper.Sleep(ctx, s.interval()) {
+		verifyCtx, cancelVerify := context.WithTimeout(ctx, s.interval())
+		err := s.lock.Verify(verifyCtx)
+		cancelVerify()
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Errorf("Lost leadership. Error: [%s]", err)
+			}
+			return
+		}
+	}
+}
+
+func (s *Service) interval() time.Duration {
+	return s.config.LeaseTime / 3
+}
+
+func (s *Service) setLeader(isLeader bool) {
+	if s.isLeader == nil {
+		return
+	}
+	if isLeader {
+		s.isLeader.Set(1)
+	} else {
+		s.isLeader.Set(0)
+	}
+}
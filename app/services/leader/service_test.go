@@ -0,0 +1,115 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package leader
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s              *Service
+	leaderElection = config.LeaderElection{
+		Enable:    true,
+		LeaseTime: 30 * time.Millisecond,
+	}
+)
+
+func setup(leaderElection config.LeaderElection) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	s = NewService(mocks.MLockRepository, leaderElection, mocks.MPrometheusService)
+}
+
+func Test_New(t *testing.T) {
+	setup(leaderElection)
+
+	expected := &Service{
+		lock:   mocks.MLockRepository,
+		config: leaderElection,
+		logger: config.GetLoggerFor("Leader Election Service"),
+	}
+
+	assert.Equal(t, expected, s)
+}
+
+func Test_Campaign_Disabled(t *testing.T) {
+	setup(config.LeaderElection{LeaseTime: leaderElection.LeaseTime})
+	ctx := context.Background()
+
+	leaderCtx, err := s.Campaign(ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ctx, leaderCtx)
+	mocks.MLockRepository.AssertNotCalled(t, "TryAcquire", mock.Anything)
+}
+
+func Test_Campaign_RetriesUntilAcquired(t *testing.T) {
+	setup(leaderElection)
+	mocks.MLockRepository.On("TryAcquire", mock.Anything).Return(false, errors.New("some-error")).Once()
+	mocks.MLockRepository.On("TryAcquire", mock.Anything).Return(false, nil).Once()
+	mocks.MLockRepository.On("TryAcquire", mock.Anything).Return(true, nil).Once()
+	mocks.MLockRepository.On("Verify", mock.Anything).Return(nil)
+	mocks.MLockRepository.On("Release", mock.Anything).Return(nil)
+
+	leaderCtx, err := s.Campaign(context.Background())
+
+	assert.Nil(t, err)
+	assert.Nil(t, leaderCtx.Err())
+	mocks.MLockRepository.AssertNumberOfCalls(t, "TryAcquire", 3)
+
+	s.Resign()
+
+	assert.Error(t, leaderCtx.Err())
+	mocks.MLockRepository.AssertCalled(t, "Release", mock.Anything)
+}
+
+func Test_Campaign_Cancelled(t *testing.T) {
+	setup(leaderElection)
+	mocks.MLockRepository.On("TryAcquire", mock.Anything).Return(false, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	leaderCtx, err := s.Campaign(ctx)
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Nil(t, leaderCtx)
+}
+
+func Test_Campaign_LosesLeadership(t *testing.T) {
+	setup(leaderElection)
+	mocks.MLockRepository.On("TryAcquire", mock.Anything).Return(true, nil)
+	mocks.MLockRepository.On("Verify", mock.Anything).Return(nil).Once()
+	mocks.MLockRepository.On("Verify", mock.Anything).Return(errors.New("connection lost"))
+
+	leaderCtx, err := s.Campaign(context.Background())
+	assert.Nil(t, err)
+
+	select {
+	case <-leaderCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("leadership was not lost")
+	}
+	mocks.MLockRepository.AssertNumberOfCalls(t, "Verify", 2)
+}
@@ -18,6 +18,7 @@ package lock_event
 
 import (
 	"database/sql"
+	"errors"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -42,6 +43,7 @@ type Service struct {
 	transferService    service.Transfers
 	scheduledService   service.Scheduled
 	prometheusService  service.Prometheus
+	policyService      service.Policy
 	logger             *log.Entry
 }
 
@@ -51,7 +53,8 @@ func NewService(
 	scheduleRepository repository.Schedule,
 	scheduled service.Scheduled,
 	transferService service.Transfers,
-	prometheusService service.Prometheus) *Service {
+	prometheusService service.Prometheus,
+	policyService service.Policy) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
 	if err != nil {
@@ -65,11 +68,12 @@ func NewService(
 		scheduledService:   scheduled,
 		transferService:    transferService,
 		prometheusService:  prometheusService,
+		policyService:      policyService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
 }
 
-func (s *Service) ProcessEvent(event payload.Transfer) {
+func (s *Service) ProcessEvent(event payload.Transfer) error {
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.SourceAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
@@ -80,12 +84,20 @@ func (s *Service) ProcessEvent(event payload.Transfer) {
 	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	err = s.policyService.Evaluate(event)
+	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
+		return err
 	}
 
 	status := make(chan string)
@@ -114,13 +126,13 @@ statusBlocker:
 			break statusBlocker
 		case syncHelper.FAIL:
 			s.logger.Errorf("[%s] - Failed to await the execution of Scheduled Mint Transaction.", event.TransactionId)
-			return
+			return errors.New("failed-scheduled-mint")
 		}
 	}
 	accountID, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
 	}
 
 	transfers := []transfer.Hedera{
@@ -146,6 +158,8 @@ statusBlocker:
 		onTransferSuccess,
 		onTransferFail,
 	)
+
+	return nil
 }
 
 func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceChainId, targetChainId uint64, asset string) {
@@ -21,10 +21,14 @@ import (
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -60,7 +64,8 @@ func Test_New(t *testing.T) {
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
-		mocks.MPrometheusService)
+		mocks.MPrometheusService,
+		mocks.MPolicyService)
 	assert.Equal(t, s, actualService)
 }
 
@@ -72,7 +77,8 @@ func Test_ProcessEventFailsOnCreate(t *testing.T) {
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
-		mocks.MPrometheusService)
+		mocks.MPrometheusService,
+		mocks.MPolicyService)
 
 	mocks.MTransferService.On("InitiateNewTransfer", lockEvent).Return(nil, errors.New("new-error"))
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction")
@@ -81,6 +87,18 @@ func Test_ProcessEventFailsOnCreate(t *testing.T) {
 	actualService.ProcessEvent(lockEvent)
 }
 
+func Test_ProcessEventHeld(t *testing.T) {
+	setup()
+
+	mocks.MTransferService.On("InitiateNewTransfer", lockEvent).Return(&entity.Transfer{TransactionID: lockEvent.TransactionId, Status: status.Initial}, nil)
+	mocks.MPolicyService.On("Evaluate", lockEvent).Return(service.ErrTransferHeld)
+
+	err := s.ProcessEvent(lockEvent)
+
+	assert.Nil(t, err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 // TODO: Uncomment when synchronization of scheduled token mint and transfer is ready
 //func Test_ProcessEventFailsOnScheduleMint(t *testing.T) {
 //	setup()
@@ -223,6 +241,7 @@ func setup() {
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
 		prometheusService:  mocks.MPrometheusService,
+		policyService:      mocks.MPolicyService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
 }
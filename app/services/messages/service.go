@@ -18,8 +18,10 @@ package messages
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
@@ -35,6 +37,7 @@ import (
 	ethhelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
@@ -50,6 +53,10 @@ type Service struct {
 	ethClients         map[uint64]client.EVM
 	logger             *log.Entry
 	assetsService      service.Assets
+	policyService      service.Policy
+	signingJournal     service.SigningJournal
+	topicEnvelope      bool
+	peersService       service.Peers
 	retryAttempts      int
 }
 
@@ -62,6 +69,10 @@ func NewService(
 	ethClients map[uint64]client.EVM,
 	topicID string,
 	assetsService service.Assets,
+	policyService service.Policy,
+	signingJournal service.SigningJournal,
+	topicEnvelope bool,
+	peersService service.Peers,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
@@ -78,6 +89,10 @@ func NewService(
 		mirrorClient:       mirrorClient,
 		ethClients:         ethClients,
 		assetsService:      assetsService,
+		policyService:      policyService,
+		signingJournal:     signingJournal,
+		topicEnvelope:      topicEnvelope,
+		peersService:       peersService,
 		retryAttempts:      30,
 	}
 }
@@ -89,6 +104,9 @@ func (ss *Service) SanityCheckFungibleSignature(topicMessage *proto_models.Topic
 	t, err := ss.awaitTransfer(topicMessage.TransferID)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to await incoming transfer and its fee. Error: [%s]", topicMessage.TransferID, err)
+		if errors.Is(err, service.ErrUnknownTransfer) {
+			ss.rejectFungibleSignature(topicMessage, peer.ReasonUnknownTransfer)
+		}
 		return false, err
 	}
 
@@ -116,6 +134,17 @@ func (ss *Service) SanityCheckFungibleSignature(topicMessage *proto_models.Topic
 			topicMessage.SourceChainId == t.SourceChainID &&
 			topicMessage.TransferID == t.TransactionID
 
+	if !match {
+		switch {
+		case topicMessage.Recipient != t.Receiver:
+			ss.rejectFungibleSignature(topicMessage, peer.ReasonWrongRecipient)
+		case topicMessage.Amount != signedAmount:
+			ss.rejectFungibleSignature(topicMessage, peer.ReasonWrongAmount)
+		default:
+			ss.rejectFungibleSignature(topicMessage, peer.ReasonWrongContents)
+		}
+	}
+
 	return match, nil
 }
 
@@ -126,6 +155,9 @@ func (ss *Service) SanityCheckNftSignature(topicMessage *proto_models.TopicEthNf
 	t, err := ss.awaitTransfer(topicMessage.TransferID)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to await incoming transfer and its fee. Error: [%s]", topicMessage.TransferID, err)
+		if errors.Is(err, service.ErrUnknownTransfer) {
+			ss.rejectNftSignature(topicMessage, peer.ReasonUnknownTransfer)
+		}
 		return false, err
 	}
 
@@ -137,17 +169,71 @@ func (ss *Service) SanityCheckNftSignature(topicMessage *proto_models.TopicEthNf
 			topicMessage.TargetChainId == t.TargetChainID &&
 			topicMessage.SourceChainId == t.SourceChainID &&
 			topicMessage.TransferID == t.TransactionID
+
+	if !match {
+		if topicMessage.Recipient != t.Receiver {
+			ss.rejectNftSignature(topicMessage, peer.ReasonWrongRecipient)
+		} else {
+			ss.rejectNftSignature(topicMessage, peer.ReasonWrongContents)
+		}
+	}
+
 	return match, nil
 }
 
+// rejectFungibleSignature reports the signer of the given message, as recovered from its own contents, to the peers service
+func (ss *Service) rejectFungibleSignature(topicMessage *proto_models.TopicEthSignatureMessage, reason string) {
+	authMsgBytes, err := auth_message.EncodeFungibleBytesFrom(topicMessage.SourceChainId, topicMessage.TargetChainId, topicMessage.TransferID, topicMessage.Asset, topicMessage.Recipient, topicMessage.Amount)
+	var signer string
+	if err == nil {
+		signer = recoverSigner(authMsgBytes, topicMessage.Signature)
+	}
+	ss.peersService.Reject(topicMessage.TransferID, signer, topicMessage.Signature, reason)
+}
+
+// rejectNftSignature reports the signer of the given message, as recovered from its own contents, to the peers service
+func (ss *Service) rejectNftSignature(topicMessage *proto_models.TopicEthNftSignatureMessage, reason string) {
+	authMsgBytes, err := auth_message.EncodeNftBytesFrom(topicMessage.SourceChainId, topicMessage.TargetChainId, topicMessage.TransferID, topicMessage.Asset, int64(topicMessage.TokenId), topicMessage.Metadata, topicMessage.Recipient)
+	var signer string
+	if err == nil {
+		signer = recoverSigner(authMsgBytes, topicMessage.Signature)
+	}
+	ss.peersService.Reject(topicMessage.TransferID, signer, topicMessage.Signature, reason)
+}
+
+// recoverSigner returns the address, which signed the given authorisation message. Empty if it cannot be recovered
+func recoverSigner(authMsgBytes []byte, signature string) string {
+	signatureBytes, _, err := ethhelper.DecodeSignature(signature)
+	if err != nil {
+		return ""
+	}
+	signer, err := ethhelper.RecoverSignerFromBytes(authMsgBytes, signatureBytes)
+	if err != nil {
+		return ""
+	}
+	return signer
+}
+
 func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
-	authMsgHash, err := auth_message.EncodeFungibleBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount)
+	err := ss.policyService.Evaluate(tm)
+	if err != nil {
+		return nil, err
+	}
+
+	authMsgData, err := auth_message.EncodeFungibleDataFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
 	}
+	authMsgHash := crypto.Keccak256(authMsgData)
 
-	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
+	err = ss.signingJournal.Record(tm.TransactionId, tm.TargetChainId, authMsgHash)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to record the authorisation signature in the signing journal. Error: [%s]", tm.TransactionId, err)
+		return nil, err
+	}
+
+	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgData)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
@@ -163,7 +249,7 @@ func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
 		Amount:        tm.Amount,
 		Signature:     signature,
 	}
-	msg := message.NewFungibleSignature(topicMsg)
+	msg := ss.withEnvelope(message.NewFungibleSignature(topicMsg), tm.TargetChainId)
 
 	bytes, err := msg.ToBytes()
 	if err != nil {
@@ -174,13 +260,25 @@ func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
 }
 
 func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
-	authMsgHash, err := auth_message.EncodeNftBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.SerialNum, tm.Metadata, tm.Receiver)
+	err := ss.policyService.Evaluate(tm)
+	if err != nil {
+		return nil, err
+	}
+
+	authMsgData, err := auth_message.EncodeNftDataFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.SerialNum, tm.Metadata, tm.Receiver)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
 	}
+	authMsgHash := crypto.Keccak256(authMsgData)
 
-	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
+	err = ss.signingJournal.Record(tm.TransactionId, tm.TargetChainId, authMsgHash)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to record the authorisation signature in the signing journal. Error: [%s]", tm.TransactionId, err)
+		return nil, err
+	}
+
+	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgData)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
@@ -197,7 +295,7 @@ func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
 		Recipient:     tm.Receiver,
 		Signature:     signature,
 	}
-	msg := message.NewNftSignature(topicMessage)
+	msg := ss.withEnvelope(message.NewNftSignature(topicMessage), tm.TargetChainId)
 
 	bytes, err := msg.ToBytes()
 	if err != nil {
@@ -208,13 +306,22 @@ func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
 	return bytes, nil
 }
 
+// withEnvelope wraps the message in the versioned envelope, stating the signer for the target chain as validator
+func (ss *Service) withEnvelope(msg *message.Message, targetChainId uint64) *message.Message {
+	if !ss.topicEnvelope {
+		return msg
+	}
+
+	return msg.WithEnvelope(ss.ethSigners[targetChainId].Address(), time.Now())
+}
+
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-func (ss *Service) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
+func (ss *Service) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte, validator string) error {
 	// Prepare Signature
 	signatureBytes, signatureHex, err := ethhelper.DecodeSignature(signature)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Decoding Signature [%s] for TX failed. Error: [%s]", transferID, signature, err)
-		return err
+		return fmt.Errorf("%w: %s", service.ErrInvalidSignature, err)
 	}
 	authMessageStr := hex.EncodeToString(authMsg)
 
@@ -226,15 +333,28 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 	}
 	if exists {
 		ss.logger.Errorf("[%s] - Signature already received. Signature [%s], Auth Message [%s].", transferID, signatureHex, authMessageStr)
+		ss.rejectDuplicate(transferID, signatureHex, authMessageStr, timestamp)
 		return err
 	}
 
 	// Verify Signature
 	address, err := ss.verifySignature(authMsg, signatureBytes, transferID, targetChainId, authMessageStr)
 	if err != nil {
+		signer := ""
+		if address != (common.Address{}) {
+			signer = address.String()
+		}
+		ss.peersService.Reject(transferID, signer, signatureHex, peer.ReasonWrongSigner)
 		return err
 	}
 
+	// Messages in the legacy format do not state their validator
+	if validator != "" && !strings.EqualFold(validator, address.String()) {
+		ss.logger.Errorf("[%s] - Validator [%s] stated in the envelope differs from the signer [%s].", transferID, validator, address.String())
+		ss.peersService.Reject(transferID, address.String(), signatureHex, peer.ReasonWrongValidator)
+		return fmt.Errorf("%w: validator [%s] differs from the signer [%s]", service.ErrInvalidSignature, validator, address.String())
+	}
+
 	ss.logger.Debugf("[%s] - Successfully verified new Signature from [%s]", transferID, address.String())
 
 	// Persist in DB
@@ -254,22 +374,37 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 	return nil
 }
 
+// rejectDuplicate reports the signer of an already received signature. Signatures of the same topic message are
+// not reported, as they get processed again when the topic gets watched from an earlier timestamp
+func (ss *Service) rejectDuplicate(transferID, signatureHex, authMessageStr string, timestamp int64) {
+	existing, err := ss.messageRepository.GetMessageWith(transferID, signatureHex, authMessageStr)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to get the already received Signature [%s]. Error: [%s]", transferID, signatureHex, err)
+		return
+	}
+	if existing.TransactionTimestamp == timestamp {
+		return
+	}
+
+	ss.peersService.Reject(transferID, existing.Signer, signatureHex, peer.ReasonDuplicate)
+}
+
 func (ss *Service) verifySignature(authMsgBytes []byte, signatureBytes []byte, transferID string, targetChainId uint64, authMessageStr string) (common.Address, error) {
 	publicKey, err := crypto.Ecrecover(authMsgBytes, signatureBytes)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to recover public key. Hash [%s]. Error: [%s]", transferID, authMessageStr, err)
-		return common.Address{}, err
+		return common.Address{}, fmt.Errorf("%w: %s", service.ErrInvalidSignature, err)
 	}
 	unmarshalledPublicKey, err := crypto.UnmarshalPubkey(publicKey)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to unmarshall public key. Error: [%s]", transferID, err)
-		return common.Address{}, err
+		return common.Address{}, fmt.Errorf("%w: %s", service.ErrInvalidSignature, err)
 	}
 	address := crypto.PubkeyToAddress(*unmarshalledPublicKey)
 
 	if !ss.contractServices[targetChainId].IsMember(address.String()) {
 		ss.logger.Errorf("[%s] - Received Signature [%s] is not signed by Bridge member", transferID, authMessageStr)
-		return common.Address{}, fmt.Errorf("signer is not signatures member")
+		return address, fmt.Errorf("%w: signer is not signatures member", service.ErrInvalidSignature)
 	}
 	return address, nil
 }
@@ -277,6 +412,7 @@ func (ss *Service) verifySignature(authMsgBytes []byte, signatureBytes []byte, t
 // awaitTransfer checks until given transfer is found
 func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 	i := 0
+	found := false
 	for i < ss.retryAttempts {
 		t, err := ss.transferRepository.GetByTransactionId(transferID)
 		if err != nil {
@@ -285,6 +421,7 @@ func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 		}
 
 		if t != nil {
+			found = true
 			if t.NativeChainID != constants.HederaNetworkId {
 				return t, nil
 			}
@@ -298,6 +435,10 @@ func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 		i++
 	}
 
+	if !found {
+		return nil, fmt.Errorf("[%s] - Failed to retrieve Transaction Record, dropping transaction: %w", transferID, service.ErrUnknownTransfer)
+	}
+
 	err := fmt.Errorf("[%s] - Failed to retrieve Transaction Record, dropping transaction", transferID)
 	return nil, err
 }
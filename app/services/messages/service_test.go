@@ -17,15 +17,20 @@
 package messages
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -96,6 +101,10 @@ func Test_NewService(t *testing.T) {
 		ethClients,
 		"0.0.1",
 		mocks.MAssetsService,
+		mocks.MPolicyService,
+		mocks.MSigningJournalService,
+		false,
+		mocks.MPeersService,
 	)
 	actualService.retryAttempts = 1
 
@@ -117,7 +126,42 @@ func Test_awaitTransfer_ShouldDropTransfer(t *testing.T) {
 
 	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return((*entity.Transfer)(nil), nil)
 	_, err := serviceInstance.awaitTransfer(topicFungibleMessage.GetFungibleSignatureMessage().TransferID)
-	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, service.ErrUnknownTransfer)
+}
+
+func Test_SanityCheckFungibleSignature_UnknownTransfer(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return((*entity.Transfer)(nil), nil)
+	mocks.MPeersService.On("Reject", topicEthFungibleMessage.TransferID, "", topicEthFungibleMessage.Signature, peer.ReasonUnknownTransfer).Return()
+
+	ok, err := serviceInstance.SanityCheckFungibleSignature(topicFungibleMessage.GetFungibleSignatureMessage())
+	assert.False(t, ok)
+	assert.ErrorIs(t, err, service.ErrUnknownTransfer)
+	mocks.MPeersService.AssertCalled(t, "Reject", topicEthFungibleMessage.TransferID, "", topicEthFungibleMessage.Signature, peer.ReasonUnknownTransfer)
+}
+
+func Test_SanityCheckFungibleSignature_WrongAmount(t *testing.T) {
+	setup()
+
+	transfer := &entity.Transfer{
+		TransactionID: topicEthFungibleMessage.TransferID,
+		SourceChainID: topicEthFungibleMessage.SourceChainId,
+		TargetChainID: topicEthFungibleMessage.TargetChainId,
+		NativeChainID: constants.HederaNetworkId,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Amount:        "200",
+		Fee:           "5",
+		Receiver:      topicEthFungibleMessage.Recipient,
+	}
+
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return(transfer, nil)
+	mocks.MPeersService.On("Reject", topicEthFungibleMessage.TransferID, "", topicEthFungibleMessage.Signature, peer.ReasonWrongAmount).Return()
+
+	ok, err := serviceInstance.SanityCheckFungibleSignature(topicFungibleMessage.GetFungibleSignatureMessage())
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	mocks.MPeersService.AssertCalled(t, "Reject", topicEthFungibleMessage.TransferID, "", topicEthFungibleMessage.Signature, peer.ReasonWrongAmount)
 }
 
 func Test_SanityCheckFungibleSignature_ShouldReturnTrue(t *testing.T) {
@@ -172,8 +216,33 @@ func Test_SanityCheckNftSignature(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_SanityCheckNftSignature_WrongRecipient(t *testing.T) {
+	setup()
+
+	transfer := &entity.Transfer{
+		Receiver:      "0x0000000000000000000000000000000000000001",
+		SerialNumber:  int64(topicEthNftMessage.TokenId),
+		Metadata:      topicEthNftMessage.Metadata,
+		TargetAsset:   topicEthNftMessage.Asset,
+		TargetChainID: topicEthNftMessage.TargetChainId,
+		SourceChainID: topicEthNftMessage.SourceChainId,
+		TransactionID: topicEthNftMessage.TransferID,
+		NativeChainID: uint64(296296),
+	}
+
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthNftMessage.TransferID).Return(transfer, nil)
+	mocks.MPeersService.On("Reject", topicEthNftMessage.TransferID, "", topicEthNftMessage.Signature, peer.ReasonWrongRecipient).Return()
+
+	ok, err := serviceInstance.SanityCheckNftSignature(topicNftMessage.GetNftSignatureMessage())
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	mocks.MPeersService.AssertCalled(t, "Reject", topicEthNftMessage.TransferID, "", topicEthNftMessage.Signature, peer.ReasonWrongRecipient)
+}
+
 func Test_SignFungibleMessage_ShouldReturnError(t *testing.T) {
 	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+	mocks.MSigningJournalService.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	tm := payload.Transfer{}
 
@@ -199,6 +268,8 @@ func Test_SignFungibleMessage_ShouldReturnError(t *testing.T) {
 
 func Test_SignFungibleMessage(t *testing.T) {
 	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+	mocks.MSigningJournalService.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	tm := payload.Transfer{
 		SourceChainId: topicEthFungibleMessage.SourceChainId,
@@ -214,10 +285,82 @@ func Test_SignFungibleMessage(t *testing.T) {
 	bytes, err := serviceInstance.SignFungibleMessage(tm)
 	assert.NotNil(t, bytes)
 	assert.Nil(t, err)
+	authMsgData, _ := auth_message.EncodeFungibleDataFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount)
+	mocks.MSignerService.AssertCalled(t, "Sign", authMsgData)
+	mocks.MSigningJournalService.AssertCalled(t, "Record", tm.TransactionId, tm.TargetChainId, crypto.Keccak256(authMsgData))
+}
+
+func Test_SignFungibleMessage_Held(t *testing.T) {
+	setup()
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthFungibleMessage.SourceChainId,
+		TargetChainId: topicEthFungibleMessage.TargetChainId,
+		TransactionId: topicEthFungibleMessage.TransferID,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Receiver:      topicEthFungibleMessage.Recipient,
+		Amount:        topicEthFungibleMessage.Amount,
+	}
+	mocks.MPolicyService.On("Evaluate", tm).Return(service.ErrTransferHeld)
+
+	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	assert.Nil(t, bytes)
+	assert.Equal(t, service.ErrTransferHeld, err)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
+func Test_SignFungibleMessage_DoubleSign(t *testing.T) {
+	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthFungibleMessage.SourceChainId,
+		TargetChainId: topicEthFungibleMessage.TargetChainId,
+		TransactionId: topicEthFungibleMessage.TransferID,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Receiver:      topicEthFungibleMessage.Recipient,
+		Amount:        topicEthFungibleMessage.Amount,
+	}
+	mocks.MSigningJournalService.On("Record", tm.TransactionId, tm.TargetChainId, mock.Anything).Return(service.ErrDoubleSign)
+
+	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	assert.Nil(t, bytes)
+	assert.Equal(t, service.ErrDoubleSign, err)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
+func Test_SignFungibleMessage_TopicEnvelope(t *testing.T) {
+	setup()
+	serviceInstance.topicEnvelope = true
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+	mocks.MSigningJournalService.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mocks.MSignerService.On("Sign", mock.Anything).Return([]byte{}, nil)
+	mocks.MSignerService.On("Address").Return("0xsomeaddress")
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthFungibleMessage.SourceChainId,
+		TargetChainId: topicEthFungibleMessage.TargetChainId,
+		TransactionId: topicEthFungibleMessage.TransferID,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Receiver:      topicEthFungibleMessage.Recipient,
+		Amount:        topicEthFungibleMessage.Amount,
+	}
+
+	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	assert.Nil(t, err)
+
+	msg, err := message.FromBytes(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, message.EnvelopeVersion, msg.Version)
+	assert.Equal(t, "0xsomeaddress", msg.Validator)
+	assert.NotZero(t, msg.CreatedAt)
+	assert.Equal(t, tm.TransactionId, msg.GetFungibleSignatureMessage().TransferID)
 }
 
 func Test_SignNftMessage_ShouldReturnError(t *testing.T) {
 	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+	mocks.MSigningJournalService.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	tm := payload.Transfer{
 		SourceChainId: topicEthNftMessage.SourceChainId,
@@ -238,6 +381,8 @@ func Test_SignNftMessage_ShouldReturnError(t *testing.T) {
 
 func Test_SignNftMessage(t *testing.T) {
 	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+	mocks.MSigningJournalService.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	tm := payload.Transfer{
 		SourceChainId: topicEthNftMessage.SourceChainId,
@@ -256,6 +401,47 @@ func Test_SignNftMessage(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_SignNftMessage_Held(t *testing.T) {
+	setup()
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthNftMessage.SourceChainId,
+		TargetChainId: topicEthNftMessage.TargetChainId,
+		TransactionId: topicEthNftMessage.TransferID,
+		TargetAsset:   topicEthNftMessage.Asset,
+		Receiver:      topicEthNftMessage.Recipient,
+		SerialNum:     int64(topicEthNftMessage.TokenId),
+		IsNft:         true,
+	}
+	mocks.MPolicyService.On("Evaluate", tm).Return(service.ErrTransferHeld)
+
+	bytes, err := serviceInstance.SignNftMessage(tm)
+	assert.Nil(t, bytes)
+	assert.Equal(t, service.ErrTransferHeld, err)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
+func Test_SignNftMessage_DoubleSign(t *testing.T) {
+	setup()
+	mocks.MPolicyService.On("Evaluate", mock.Anything).Return(nil)
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthNftMessage.SourceChainId,
+		TargetChainId: topicEthNftMessage.TargetChainId,
+		TransactionId: topicEthNftMessage.TransferID,
+		TargetAsset:   topicEthNftMessage.Asset,
+		Receiver:      topicEthNftMessage.Recipient,
+		SerialNum:     int64(topicEthNftMessage.TokenId),
+		IsNft:         true,
+	}
+	mocks.MSigningJournalService.On("Record", tm.TransactionId, tm.TargetChainId, mock.Anything).Return(service.ErrDoubleSign)
+
+	bytes, err := serviceInstance.SignNftMessage(tm)
+	assert.Nil(t, bytes)
+	assert.Equal(t, service.ErrDoubleSign, err)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
 func Test_ProcessSignature(t *testing.T) {
 	setup()
 
@@ -265,11 +451,46 @@ func Test_ProcessSignature(t *testing.T) {
 		topicEthNftMessage.TargetChainId,
 		time.Now().UnixNano(),
 		[]byte{},
+		"",
 	)
 
 	assert.NotNil(t, err)
 }
 
+func Test_ProcessSignature_ValidatorDiffersFromSigner(t *testing.T) {
+	setup()
+	key, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(key.PublicKey).String()
+	authMsg := crypto.Keccak256([]byte("auth-message"))
+	signature, _ := crypto.Sign(authMsg, key)
+	mocks.MMessageRepository.On("Exist", topicEthFungibleMessage.TransferID, mock.Anything, hex.EncodeToString(authMsg)).Return(false, nil)
+	mocks.MBridgeContractService.On("IsMember", signer).Return(true)
+	mocks.MPeersService.On("Reject", topicEthFungibleMessage.TransferID, signer, mock.Anything, peer.ReasonWrongValidator).Return()
+
+	err := serviceInstance.ProcessSignature(topicEthFungibleMessage.TransferID, hex.EncodeToString(signature), targetChainId, time.Now().UnixNano(), authMsg, "0x0000000000000000000000000000000000000001")
+
+	assert.ErrorIs(t, err, service.ErrInvalidSignature)
+	mocks.MPeersService.AssertCalled(t, "Reject", topicEthFungibleMessage.TransferID, signer, mock.Anything, peer.ReasonWrongValidator)
+	mocks.MMessageRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_ProcessSignature_ValidatorIsSigner(t *testing.T) {
+	setup()
+	key, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(key.PublicKey).String()
+	authMsg := crypto.Keccak256([]byte("auth-message"))
+	signature, _ := crypto.Sign(authMsg, key)
+	mocks.MMessageRepository.On("Exist", topicEthFungibleMessage.TransferID, mock.Anything, hex.EncodeToString(authMsg)).Return(false, nil)
+	mocks.MBridgeContractService.On("IsMember", signer).Return(true)
+	mocks.MMessageRepository.On("Create", mock.Anything).Return(nil)
+
+	err := serviceInstance.ProcessSignature(topicEthFungibleMessage.TransferID, hex.EncodeToString(signature), targetChainId, time.Now().UnixNano(), authMsg, strings.ToLower(signer))
+
+	assert.Nil(t, err)
+	mocks.MMessageRepository.AssertCalled(t, "Create", mock.Anything)
+	mocks.MPeersService.AssertNotCalled(t, "Reject", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 
@@ -301,6 +522,9 @@ func setup() {
 		ethClients:         ethClients,
 		logger:             config.GetLoggerFor(fmt.Sprintf("Messages Service")),
 		assetsService:      mocks.MAssetsService,
+		policyService:      mocks.MPolicyService,
+		signingJournal:     mocks.MSigningJournalService,
+		peersService:       mocks.MPeersService,
 		retryAttempts:      1,
 	}
 }
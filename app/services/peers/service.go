@@ -0,0 +1,191 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	cfg               config.Peers
+	repository        repository.Peer
+	contractServices  map[uint64]service.Contracts
+	prometheusService service.Prometheus
+	logger            *log.Entry
+}
+
+func NewService(
+	cfg config.Peers,
+	repository repository.Peer,
+	contractServices map[uint64]service.Contracts,
+	prometheusService service.Prometheus,
+) *Service {
+	return &Service{
+		cfg:               cfg,
+		repository:        repository,
+		contractServices:  contractServices,
+		prometheusService: prometheusService,
+		logger:            config.GetLoggerFor("Peers Service"),
+	}
+}
+
+// Reject records a rejected topic message of the given signer together with the reason for its rejection
+func (s *Service) Reject(transferID, signer, signature, reason string) {
+	if signer == "" {
+		signer = peer.UnknownSigner
+	}
+	s.logger.Warnf("[%s] - Rejected topic message of peer [%s]. Reason: [%s].", transferID, signer, reason)
+
+	err := s.repository.CreateRejection(&entity.PeerRejection{
+		TransferID: transferID,
+		Signer:     signer,
+		Signature:  signature,
+		Reason:     reason,
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to persist rejected topic message of peer [%s]. Error: [%s].", transferID, signer, err)
+	}
+
+	if s.monitoringEnabled() {
+		s.prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: fmt.Sprintf(constants.PeerRejectedMessagesCounterNameFormat, metricName(signer), reason),
+			Help: constants.PeerRejectedMessagesCounterHelp,
+		}).Inc()
+	}
+}
+
+// Report returns the signing statistics of each peer validator within the configured window.
+// Transfers within the grace period are not counted as missed, as the peers might not have signed them yet
+func (s *Service) Report() (*peer.Report, error) {
+	now := time.Now()
+	since := now.Add(-s.cfg.Window)
+	until := now.Add(-s.cfg.GracePeriod)
+
+	peers := make(map[string]*peer.Peer)
+	peerFor := func(address string) *peer.Peer {
+		key := strings.ToLower(address)
+		if _, ok := peers[key]; !ok {
+			peers[key] = &peer.Peer{Address: address, Rejections: make(map[string]int64)}
+		}
+		return peers[key]
+	}
+
+	for chainId, contractService := range s.contractServices {
+		for _, member := range contractService.GetMembers() {
+			p := peerFor(member)
+			p.Member = true
+
+			missed, err := s.repository.CountMissed(member, chainId, since.UnixNano(), until.UnixNano())
+			if err != nil {
+				s.logger.Errorf("Failed to count the missed transfers of peer [%s] to chain [%d]. Error: [%s].", member, chainId, err)
+				return nil, err
+			}
+			p.Missed += missed
+		}
+	}
+
+	stats, err := s.repository.GetSigningStatsSince(since.UnixNano())
+	if err != nil {
+		s.logger.Errorf("Failed to get the signing statistics of the peers. Error: [%s].", err)
+		return nil, err
+	}
+	for _, stat := range stats {
+		p := peerFor(stat.Signer)
+		p.Signed = stat.Signed
+		p.AverageSigningLatency = stat.AverageLatency / float64(time.Second)
+		lastSignedAt := time.Unix(0, stat.LastSigned).UTC()
+		p.LastSignedAt = &lastSignedAt
+	}
+
+	rejections, err := s.repository.GetRejectionsSince(since)
+	if err != nil {
+		s.logger.Errorf("Failed to get the rejected topic messages of the peers. Error: [%s].", err)
+		return nil, err
+	}
+	for _, rejection := range rejections {
+		peerFor(rejection.Signer).Rejections[rejection.Reason]++
+	}
+
+	report := &peer.Report{Since: since.UTC(), Peers: make([]*peer.Peer, 0, len(peers))}
+	for _, p := range peers {
+		report.Peers = append(report.Peers, p)
+	}
+	sort.Slice(report.Peers, func(i, j int) bool {
+		return strings.ToLower(report.Peers[i].Address) < strings.ToLower(report.Peers[j].Address)
+	})
+
+	s.setMetrics(report)
+	return report, nil
+}
+
+// Rejections returns the rejected topic messages of the given peer validator within the configured window
+func (s *Service) Rejections(address string) ([]*peer.Rejection, error) {
+	rejections, err := s.repository.GetRejectionsSince(time.Now().Add(-s.cfg.Window))
+	if err != nil {
+		s.logger.Errorf("Failed to get the rejected topic messages of peer [%s]. Error: [%s].", address, err)
+		return nil, err
+	}
+
+	result := make([]*peer.Rejection, 0)
+	for _, rejection := range rejections {
+		if strings.EqualFold(rejection.Signer, address) {
+			result = append(result, rejection.ToDto())
+		}
+	}
+	return result, nil
+}
+
+func (s *Service) setMetrics(report *peer.Report) {
+	if !s.monitoringEnabled() {
+		return
+	}
+
+	for _, p := range report.Peers {
+		if !p.Member {
+			continue
+		}
+		name := metricName(p.Address)
+		s.prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name: fmt.Sprintf(constants.PeerMissedTransfersGaugeNameFormat, name),
+			Help: constants.PeerMissedTransfersGaugeHelp,
+		}).Set(float64(p.Missed))
+		s.prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name: fmt.Sprintf(constants.PeerSigningLatencyGaugeNameFormat, name),
+			Help: constants.PeerSigningLatencyGaugeHelp,
+		}).Set(p.AverageSigningLatency)
+	}
+}
+
+func (s *Service) monitoringEnabled() bool {
+	return s.prometheusService != nil && s.prometheusService.GetIsMonitoringEnabled()
+}
+
+func metricName(address string) string {
+	return strings.ToLower(address)
+}
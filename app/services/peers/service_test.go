@@ -0,0 +1,210 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	serviceInstance *Service
+	cfg             = config.Peers{Window: 24 * time.Hour, GracePeriod: 10 * time.Minute}
+	member          = "0xAbC0000000000000000000000000000000000001"
+	otherMember     = "0xaBc0000000000000000000000000000000000002"
+	outsider        = "0xAbC0000000000000000000000000000000000003"
+	transferID      = "0.0.123-1234567890-123456789"
+	lastSigned      = int64(1680000005000000000)
+)
+
+func setup() {
+	mocks.Setup()
+	serviceInstance = &Service{
+		cfg:               cfg,
+		repository:        mocks.MPeerRepository,
+		contractServices:  map[uint64]service.Contracts{80001: mocks.MBridgeContractService},
+		prometheusService: mocks.MPrometheusService,
+		logger:            config.GetLoggerFor("Peers Service"),
+	}
+}
+
+func Test_NewService(t *testing.T) {
+	setup()
+
+	actual := NewService(cfg, mocks.MPeerRepository, map[uint64]service.Contracts{80001: mocks.MBridgeContractService}, mocks.MPrometheusService)
+
+	assert.Equal(t, serviceInstance, actual)
+}
+
+func Test_Reject(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MPeerRepository.On("CreateRejection", &entity.PeerRejection{
+		TransferID: transferID,
+		Signer:     outsider,
+		Signature:  "some-signature",
+		Reason:     peer.ReasonWrongSigner,
+	}).Return(nil)
+
+	serviceInstance.Reject(transferID, outsider, "some-signature", peer.ReasonWrongSigner)
+
+	mocks.MPeerRepository.AssertNumberOfCalls(t, "CreateRejection", 1)
+}
+
+func Test_Reject_UnknownSignerWithMetrics(t *testing.T) {
+	setup()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "rejected"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", prometheus.CounterOpts{
+		Name: fmt.Sprintf(constants.PeerRejectedMessagesCounterNameFormat, peer.UnknownSigner, peer.ReasonWrongSigner),
+		Help: constants.PeerRejectedMessagesCounterHelp,
+	}).Return(counter)
+	mocks.MPeerRepository.On("CreateRejection", mock.Anything).Return(errors.New("some-error"))
+
+	serviceInstance.Reject(transferID, "", "some-signature", peer.ReasonWrongSigner)
+
+	rejection := mocks.MPeerRepository.Calls[0].Arguments.Get(0).(*entity.PeerRejection)
+	assert.Equal(t, peer.UnknownSigner, rejection.Signer)
+	mocks.MPrometheusService.AssertNumberOfCalls(t, "CreateCounterIfNotExists", 1)
+}
+
+func Test_Report(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{member, otherMember})
+	mocks.MPeerRepository.On("CountMissed", member, uint64(80001), mock.Anything, mock.Anything).Return(int64(0), nil)
+	mocks.MPeerRepository.On("CountMissed", otherMember, uint64(80001), mock.Anything, mock.Anything).Return(int64(4), nil)
+	mocks.MPeerRepository.On("GetSigningStatsSince", mock.Anything).Return([]*entity.PeerSigningStats{
+		{Signer: member, Signed: 10, AverageLatency: float64(3 * time.Second), LastSigned: lastSigned},
+	}, nil)
+	mocks.MPeerRepository.On("GetRejectionsSince", mock.Anything).Return([]*entity.PeerRejection{
+		{TransferID: transferID, Signer: otherMember, Reason: peer.ReasonWrongAmount},
+		{TransferID: transferID, Signer: outsider, Reason: peer.ReasonWrongSigner},
+		{TransferID: transferID, Signer: outsider, Reason: peer.ReasonWrongSigner},
+	}, nil)
+
+	report, err := serviceInstance.Report()
+
+	assert.Nil(t, err)
+	lastSignedAt := time.Unix(0, lastSigned).UTC()
+	assert.Equal(t, []*peer.Peer{
+		{Address: member, Member: true, Signed: 10, AverageSigningLatency: 3, LastSignedAt: &lastSignedAt, Rejections: map[string]int64{}},
+		{Address: otherMember, Member: true, Missed: 4, Rejections: map[string]int64{peer.ReasonWrongAmount: 1}},
+		{Address: outsider, Rejections: map[string]int64{peer.ReasonWrongSigner: 2}},
+	}, report.Peers)
+	assert.WithinDuration(t, time.Now().Add(-cfg.Window), report.Since, time.Minute)
+
+	from := mocks.MPeerRepository.Calls[0].Arguments.Get(2).(int64)
+	to := mocks.MPeerRepository.Calls[0].Arguments.Get(3).(int64)
+	assert.Equal(t, (cfg.Window - cfg.GracePeriod).Nanoseconds(), to-from)
+}
+
+func Test_Report_SetsMetrics(t *testing.T) {
+	setup()
+	missedGauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "missed"})
+	latencyGauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "latency"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{member})
+	mocks.MPeerRepository.On("CountMissed", member, uint64(80001), mock.Anything, mock.Anything).Return(int64(2), nil)
+	mocks.MPeerRepository.On("GetSigningStatsSince", mock.Anything).Return([]*entity.PeerSigningStats{}, nil)
+	mocks.MPeerRepository.On("GetRejectionsSince", mock.Anything).Return([]*entity.PeerRejection{}, nil)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", prometheus.GaugeOpts{
+		Name: fmt.Sprintf(constants.PeerMissedTransfersGaugeNameFormat, "0xabc0000000000000000000000000000000000001"),
+		Help: constants.PeerMissedTransfersGaugeHelp,
+	}).Return(missedGauge)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", prometheus.GaugeOpts{
+		Name: fmt.Sprintf(constants.PeerSigningLatencyGaugeNameFormat, "0xabc0000000000000000000000000000000000001"),
+		Help: constants.PeerSigningLatencyGaugeHelp,
+	}).Return(latencyGauge)
+
+	_, err := serviceInstance.Report()
+
+	assert.Nil(t, err)
+	mocks.MPrometheusService.AssertNumberOfCalls(t, "CreateGaugeIfNotExists", 2)
+}
+
+func Test_Report_CountMissedFails(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{member})
+	mocks.MPeerRepository.On("CountMissed", member, uint64(80001), mock.Anything, mock.Anything).Return(int64(0), errors.New("some-error"))
+
+	report, err := serviceInstance.Report()
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func Test_Report_GetSigningStatsFails(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{})
+	mocks.MPeerRepository.On("GetSigningStatsSince", mock.Anything).Return(nil, errors.New("some-error"))
+
+	report, err := serviceInstance.Report()
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func Test_Report_GetRejectionsFails(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{})
+	mocks.MPeerRepository.On("GetSigningStatsSince", mock.Anything).Return([]*entity.PeerSigningStats{}, nil)
+	mocks.MPeerRepository.On("GetRejectionsSince", mock.Anything).Return(nil, errors.New("some-error"))
+
+	report, err := serviceInstance.Report()
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func Test_Rejections(t *testing.T) {
+	setup()
+	createdAt := time.Unix(1680000000, 0).UTC()
+	mocks.MPeerRepository.On("GetRejectionsSince", mock.Anything).Return([]*entity.PeerRejection{
+		{TransferID: transferID, Signer: member, Signature: "some-signature", Reason: peer.ReasonDuplicate, CreatedAt: createdAt},
+		{TransferID: transferID, Signer: outsider, Reason: peer.ReasonWrongSigner, CreatedAt: createdAt},
+	}, nil)
+
+	actual, err := serviceInstance.Rejections("0xabc0000000000000000000000000000000000001")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*peer.Rejection{
+		{TransferId: transferID, Signer: member, Signature: "some-signature", Reason: peer.ReasonDuplicate, RejectedAt: createdAt},
+	}, actual)
+}
+
+func Test_Rejections_Fails(t *testing.T) {
+	setup()
+	mocks.MPeerRepository.On("GetRejectionsSince", mock.Anything).Return(nil, errors.New("some-error"))
+
+	actual, err := serviceInstance.Rejections(member)
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package policy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	policyModel "github.com/limechain/hedera-eth-bridge-validator/app/model/policy"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	hourlyWindow = time.Hour
+	dailyWindow  = 24 * time.Hour
+)
+
+type Service struct {
+	mutex              sync.Mutex
+	enabled            bool
+	assets             map[uint64]map[string]config.AssetPolicy
+	routes             []config.RoutePolicy
+	originator         config.OriginatorPolicy
+	repository         repository.PolicyDecision
+	transferRepository repository.Transfer
+	assetsService      service.Assets
+	pricingService     service.Pricing
+	logger             *log.Entry
+}
+
+func NewService(
+	cfg config.SigningPolicy,
+	repository repository.PolicyDecision,
+	transferRepository repository.Transfer,
+	assetsService service.Assets,
+	pricingService service.Pricing) *Service {
+	assets := make(map[uint64]map[string]config.AssetPolicy)
+	for chainId, policies := range cfg.Assets {
+		assets[chainId] = make(map[string]config.AssetPolicy)
+		for asset, assetPolicy := range policies {
+			assets[chainId][assetKey(asset)] = assetPolicy
+		}
+	}
+
+	return &Service{
+		enabled:            cfg.Enabled,
+		assets:             assets,
+		routes:             cfg.Routes,
+		originator:         cfg.Originator,
+		repository:         repository,
+		transferRepository: transferRepository,
+		assetsService:      assetsService,
+		pricingService:     pricingService,
+		logger:             config.GetLoggerFor("Policy Service"),
+	}
+}
+
+// Evaluate checks the transfer against the configured limits before it gets signed or scheduled.
+// Returns ErrTransferHeld if the transfer breaks any of them, in which case the transfer is marked as held
+// and must not be signed. Evaluating the same transfer again returns the initial decision
+func (s *Service) Evaluate(transfer payload.Transfer) error {
+	if !s.enabled {
+		return nil
+	}
+
+	// Decisions are serialised, so that concurrently evaluated transfers are accounted in each other's windows
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.repository.Get(transfer.TransactionId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get policy decision. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+	if existing != nil {
+		if existing.Held {
+			return service.ErrTransferHeld
+		}
+		return nil
+	}
+
+	decision := &entity.PolicyDecision{
+		TransferID:    transfer.TransactionId,
+		SourceChainID: transfer.SourceChainId,
+		TargetChainID: transfer.TargetChainId,
+		Asset:         transfer.TargetAsset,
+		Originator:    transfer.Originator,
+	}
+	reason, err := s.check(transfer, decision)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		decision.Held = true
+		decision.Reason = reason
+	}
+
+	err = s.repository.Create(decision)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to save policy decision. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+
+	if !decision.Held {
+		return nil
+	}
+
+	s.logger.Warnf("[%s] - Transfer held by the signing policy. Reason: [%s]", transfer.TransactionId, reason)
+	err = s.transferRepository.UpdateStatusHeld(transfer.TransactionId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update status to held. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+
+	return service.ErrTransferHeld
+}
+
+// Held returns the transfers held by the signing policy
+func (s *Service) Held() ([]*policyModel.HeldTransfer, error) {
+	decisions, err := s.repository.GetHeld()
+	if err != nil {
+		s.logger.Errorf("Failed to get held transfers. Error: [%s]", err)
+		return nil, err
+	}
+
+	held := make([]*policyModel.HeldTransfer, 0, len(decisions))
+	for _, decision := range decisions {
+		held = append(held, decision.ToDto())
+	}
+	return held, nil
+}
+
+// check fills in the value of the transfer and returns the reason for holding it, if it breaks any limit
+func (s *Service) check(transfer payload.Transfer, decision *entity.PolicyDecision) (string, error) {
+	assetPolicy, hasAssetPolicy := s.assets[transfer.TargetChainId][assetKey(transfer.TargetAsset)]
+	routePolicy, hasRoutePolicy := s.routePolicy(transfer.SourceChainId, transfer.TargetChainId)
+
+	var amount, usdAmount decimal.Decimal
+	if !transfer.IsNft {
+		var err error
+		amount, err = s.tokenAmount(transfer)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to calculate transfer amount. Error: [%s]", transfer.TransactionId, err)
+			return "", err
+		}
+		decision.Amount = amount.String()
+
+		priceInfo, exists := s.pricingService.GetTokenPriceInfo(transfer.TargetChainId, transfer.TargetAsset)
+		if exists && priceInfo.UsdPrice.IsPositive() {
+			usdAmount = amount.Mul(priceInfo.UsdPrice)
+			decision.UsdAmount = usdAmount.String()
+		}
+
+		requiresUsd := (hasAssetPolicy && !assetPolicy.MaxUsd.IsZero()) ||
+			(hasRoutePolicy && (!routePolicy.HourlyUsdCap.IsZero() || !routePolicy.DailyUsdCap.IsZero()))
+		if requiresUsd && decision.UsdAmount == "" {
+			return "no USD price is available for the asset", nil
+		}
+
+		if hasAssetPolicy {
+			if exceeds(amount, assetPolicy.MaxAmount) {
+				return fmt.Sprintf("amount [%s] exceeds the max amount [%s] of the asset", amount, assetPolicy.MaxAmount), nil
+			}
+			if exceeds(usdAmount, assetPolicy.MaxUsd) {
+				return fmt.Sprintf("USD value [%s] exceeds the max USD value [%s] of the asset", usdAmount, assetPolicy.MaxUsd), nil
+			}
+		}
+	}
+
+	now := time.Now()
+	lookback := dailyWindow
+	if s.originator.Window > lookback {
+		lookback = s.originator.Window
+	}
+	decisions, err := s.repository.GetAllowedSince(now.Add(-lookback))
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get previous policy decisions. Error: [%s]", transfer.TransactionId, err)
+		return "", err
+	}
+
+	var (
+		assetHourly, assetDaily decimal.Decimal
+		routeHourly, routeDaily decimal.Decimal
+		originatorTransfers     uint64
+	)
+	for _, d := range decisions {
+		if transfer.Originator != "" && d.Originator == transfer.Originator && !d.CreatedAt.Before(now.Add(-s.originator.Window)) {
+			originatorTransfers++
+		}
+		if d.CreatedAt.Before(now.Add(-dailyWindow)) {
+			continue
+		}
+		inHour := !d.CreatedAt.Before(now.Add(-hourlyWindow))
+
+		if d.TargetChainID == transfer.TargetChainId && assetKey(d.Asset) == assetKey(transfer.TargetAsset) {
+			assetDaily = assetDaily.Add(parseDecimal(d.Amount))
+			if inHour {
+				assetHourly = assetHourly.Add(parseDecimal(d.Amount))
+			}
+		}
+		if d.SourceChainID == transfer.SourceChainId && d.TargetChainID == transfer.TargetChainId {
+			routeDaily = routeDaily.Add(parseDecimal(d.UsdAmount))
+			if inHour {
+				routeHourly = routeHourly.Add(parseDecimal(d.UsdAmount))
+			}
+		}
+	}
+
+	if hasAssetPolicy && !transfer.IsNft {
+		if exceeds(assetHourly.Add(amount), assetPolicy.HourlyCap) {
+			return fmt.Sprintf("hourly outflow cap [%s] of the asset would be exceeded", assetPolicy.HourlyCap), nil
+		}
+		if exceeds(assetDaily.Add(amount), assetPolicy.DailyCap) {
+			return fmt.Sprintf("daily outflow cap [%s] of the asset would be exceeded", assetPolicy.DailyCap), nil
+		}
+	}
+
+	if hasRoutePolicy && !transfer.IsNft {
+		if exceeds(routeHourly.Add(usdAmount), routePolicy.HourlyUsdCap) {
+			return fmt.Sprintf("hourly USD outflow cap [%s] of the route would be exceeded", routePolicy.HourlyUsdCap), nil
+		}
+		if exceeds(routeDaily.Add(usdAmount), routePolicy.DailyUsdCap) {
+			return fmt.Sprintf("daily USD outflow cap [%s] of the route would be exceeded", routePolicy.DailyUsdCap), nil
+		}
+	}
+
+	if transfer.Originator != "" && s.originator.MaxTransfers > 0 && originatorTransfers >= s.originator.MaxTransfers {
+		return fmt.Sprintf("originator exceeded [%d] transfers within [%s]", s.originator.MaxTransfers, s.originator.Window), nil
+	}
+
+	return "", nil
+}
+
+// tokenAmount converts the amount of the transfer to whole token units of the target asset
+func (s *Service) tokenAmount(transfer payload.Transfer) (decimal.Decimal, error) {
+	amount, err := big_numbers.ToBigInt(transfer.Amount)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	assetInfo, exists := s.assetsService.FungibleAssetInfo(transfer.TargetChainId, transfer.TargetAsset)
+	if !exists {
+		return decimal.Zero, fmt.Errorf("fungible asset info of [%s] not found", transfer.TargetAsset)
+	}
+
+	return decimal.NewFromBigInt(amount, -int32(assetInfo.Decimals)), nil
+}
+
+func (s *Service) routePolicy(sourceChainId, targetChainId uint64) (config.RoutePolicy, bool) {
+	for _, route := range s.routes {
+		if route.SourceChainId == sourceChainId && route.TargetChainId == targetChainId {
+			return route, true
+		}
+	}
+	return config.RoutePolicy{}, false
+}
+
+// exceeds checks whether the value is greater than the limit. A zero limit means no limit
+func exceeds(value, limit decimal.Decimal) bool {
+	return !limit.IsZero() && value.GreaterThan(limit)
+}
+
+func parseDecimal(value string) decimal.Decimal {
+	result, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return result
+}
+
+// assetKey normalises EVM addresses, so that assets are matched regardless of their case
+func assetKey(asset string) string {
+	if common.IsHexAddress(asset) {
+		return common.HexToAddress(asset).String()
+	}
+	return asset
+}
@@ -0,0 +1,370 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package policy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	assetModel "github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	policyModel "github.com/limechain/hedera-eth-bridge-validator/app/model/policy"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s             *Service
+	sourceChainId = uint64(296)
+	targetChainId = uint64(80001)
+	targetAsset   = "0x0000000000000000000000000000000000000aBc"
+	originator    = "0.0.123"
+	transfer      = payload.Transfer{
+		TransactionId: "0.0.123-1234567890-123456789",
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		TargetAsset:   targetAsset,
+		Originator:    originator,
+		// 150 whole tokens with 8 decimals
+		Amount: "15000000000",
+	}
+	policyConfig = config.SigningPolicy{
+		Enabled: true,
+		Assets: map[uint64]map[string]config.AssetPolicy{
+			targetChainId: {
+				// Configured in lower case, while transfers carry the checksum address
+				"0x0000000000000000000000000000000000000abc": {
+					MaxAmount: decimal.NewFromInt(1000),
+					MaxUsd:    decimal.NewFromInt(500),
+					HourlyCap: decimal.NewFromInt(2000),
+					DailyCap:  decimal.NewFromInt(5000),
+				},
+			},
+		},
+		Routes: []config.RoutePolicy{
+			{
+				SourceChainId: sourceChainId,
+				TargetChainId: targetChainId,
+				HourlyUsdCap:  decimal.NewFromInt(1000),
+				DailyUsdCap:   decimal.NewFromInt(3000),
+			},
+		},
+		Originator: config.OriginatorPolicy{
+			MaxTransfers: 3,
+			Window:       time.Hour,
+		},
+	}
+	usdPrice = decimal.NewFromFloat(2.5)
+)
+
+func Test_NewService(t *testing.T) {
+	setup(policyConfig)
+
+	assert.Equal(t, policyConfig.Assets[targetChainId]["0x0000000000000000000000000000000000000abc"], s.assets[targetChainId][targetAsset])
+	assert.Equal(t, policyConfig.Routes, s.routes)
+	assert.Equal(t, policyConfig.Originator, s.originator)
+}
+
+func Test_Evaluate_Disabled(t *testing.T) {
+	setup(config.SigningPolicy{})
+
+	err := s.Evaluate(transfer)
+
+	assert.Nil(t, err)
+	mocks.MPolicyDecisionRepository.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Evaluate_Allowed(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(nil, nil)
+	mocks.MPolicyDecisionRepository.On("GetAllowedSince", mock.Anything).Return([]*entity.PolicyDecision{}, nil)
+	mocks.MPolicyDecisionRepository.On("Create", mock.MatchedBy(func(d *entity.PolicyDecision) bool {
+		return !d.Held && d.Amount == "150" && d.UsdAmount == "375" && d.Asset == targetAsset && d.Originator == originator
+	})).Return(nil)
+
+	err := s.Evaluate(transfer)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusHeld", mock.Anything)
+}
+
+func Test_Evaluate_PreviouslyAllowed(t *testing.T) {
+	setup(policyConfig)
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(&entity.PolicyDecision{TransferID: transfer.TransactionId}, nil)
+
+	err := s.Evaluate(transfer)
+
+	assert.Nil(t, err)
+	mocks.MPolicyDecisionRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Evaluate_PreviouslyHeld(t *testing.T) {
+	setup(policyConfig)
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(&entity.PolicyDecision{TransferID: transfer.TransactionId, Held: true}, nil)
+
+	err := s.Evaluate(transfer)
+
+	assert.Equal(t, service.ErrTransferHeld, err)
+	mocks.MPolicyDecisionRepository.AssertNotCalled(t, "Create", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusHeld", mock.Anything)
+}
+
+func Test_Evaluate_GetFails(t *testing.T) {
+	setup(policyConfig)
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(nil, errors.New("some-error"))
+
+	err := s.Evaluate(transfer)
+
+	assert.NotNil(t, err)
+	assert.NotEqual(t, service.ErrTransferHeld, err)
+}
+
+func Test_Evaluate_MaxAmount(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	tm := transfer
+	tm.Amount = "100100000000"
+
+	assertHeld(t, tm, nil, "max amount")
+}
+
+func Test_Evaluate_MaxUsd(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	tm := transfer
+	tm.Amount = "30000000000"
+
+	assertHeld(t, tm, nil, "max USD value")
+}
+
+func Test_Evaluate_NoPrice(t *testing.T) {
+	setup(policyConfig)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, targetAsset).Return(&assetModel.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", targetChainId, targetAsset).Return(pricing.TokenPriceInfo{}, false)
+
+	assertHeld(t, transfer, nil, "no USD price")
+}
+
+func Test_Evaluate_UnknownAsset(t *testing.T) {
+	setup(policyConfig)
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(nil, nil)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, targetAsset).Return(&assetModel.FungibleAssetInfo{}, false)
+
+	err := s.Evaluate(transfer)
+
+	assert.NotNil(t, err)
+	assert.NotEqual(t, service.ErrTransferHeld, err)
+	mocks.MPolicyDecisionRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Evaluate_HourlyCap(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("1900", "0", time.Now().Add(-30*time.Minute)),
+	}
+
+	assertHeld(t, transfer, previous, "hourly outflow cap")
+}
+
+func Test_Evaluate_DailyCap(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("1900", "0", time.Now().Add(-2*time.Hour)),
+		decision("1900", "0", time.Now().Add(-5*time.Hour)),
+		decision("1100", "0", time.Now().Add(-10*time.Hour)),
+		// Outside of the daily window
+		decision("4000", "0", time.Now().Add(-25*time.Hour)),
+	}
+
+	assertHeld(t, transfer, previous, "daily outflow cap")
+}
+
+func Test_Evaluate_RouteHourlyUsdCap(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("0", "700", time.Now().Add(-10*time.Minute)),
+	}
+
+	assertHeld(t, transfer, previous, "hourly USD outflow cap")
+}
+
+func Test_Evaluate_RouteDailyUsdCap(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("0", "900", time.Now().Add(-3*time.Hour)),
+		decision("0", "900", time.Now().Add(-6*time.Hour)),
+		decision("0", "900", time.Now().Add(-9*time.Hour)),
+	}
+
+	assertHeld(t, transfer, previous, "daily USD outflow cap")
+}
+
+func Test_Evaluate_OriginatorVelocity(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("1", "1", time.Now().Add(-10*time.Minute)),
+		decision("1", "1", time.Now().Add(-20*time.Minute)),
+		decision("1", "1", time.Now().Add(-30*time.Minute)),
+	}
+
+	assertHeld(t, transfer, previous, "originator exceeded")
+}
+
+func Test_Evaluate_OriginatorVelocityOutsideWindow(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	previous := []*entity.PolicyDecision{
+		decision("1", "1", time.Now().Add(-10*time.Minute)),
+		decision("1", "1", time.Now().Add(-20*time.Minute)),
+		decision("1", "1", time.Now().Add(-2*time.Hour)),
+	}
+	mocks.MPolicyDecisionRepository.On("Get", transfer.TransactionId).Return(nil, nil)
+	mocks.MPolicyDecisionRepository.On("GetAllowedSince", mock.Anything).Return(previous, nil)
+	mocks.MPolicyDecisionRepository.On("Create", mock.Anything).Return(nil)
+
+	err := s.Evaluate(transfer)
+
+	assert.Nil(t, err)
+}
+
+func Test_Evaluate_Nft(t *testing.T) {
+	setup(policyConfig)
+	tm := transfer
+	tm.Amount = ""
+	tm.IsNft = true
+	tm.SerialNum = 1
+	mocks.MPolicyDecisionRepository.On("Get", tm.TransactionId).Return(nil, nil)
+	mocks.MPolicyDecisionRepository.On("GetAllowedSince", mock.Anything).Return([]*entity.PolicyDecision{}, nil)
+	mocks.MPolicyDecisionRepository.On("Create", mock.MatchedBy(func(d *entity.PolicyDecision) bool {
+		return !d.Held && d.Amount == "" && d.UsdAmount == ""
+	})).Return(nil)
+
+	err := s.Evaluate(tm)
+
+	assert.Nil(t, err)
+	mocks.MAssetsService.AssertNotCalled(t, "FungibleAssetInfo", mock.Anything, mock.Anything)
+}
+
+func Test_Evaluate_UpdateStatusHeldFails(t *testing.T) {
+	setup(policyConfig)
+	setupValue()
+	tm := transfer
+	tm.Amount = "100100000000"
+	mocks.MPolicyDecisionRepository.On("Get", tm.TransactionId).Return(nil, nil)
+	mocks.MPolicyDecisionRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusHeld", tm.TransactionId).Return(errors.New("some-error"))
+
+	err := s.Evaluate(tm)
+
+	assert.NotNil(t, err)
+	assert.NotEqual(t, service.ErrTransferHeld, err)
+}
+
+func Test_Held(t *testing.T) {
+	setup(policyConfig)
+	heldAt := time.Now()
+	mocks.MPolicyDecisionRepository.On("GetHeld").Return([]*entity.PolicyDecision{
+		{
+			TransferID:    transfer.TransactionId,
+			SourceChainID: sourceChainId,
+			TargetChainID: targetChainId,
+			Asset:         targetAsset,
+			Originator:    originator,
+			Amount:        "1001",
+			UsdAmount:     "2502.5",
+			Held:          true,
+			Reason:        "some-reason",
+			CreatedAt:     heldAt,
+		},
+	}, nil)
+
+	actual, err := s.Held()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*policyModel.HeldTransfer{
+		{
+			TransferId:    transfer.TransactionId,
+			SourceChainId: sourceChainId,
+			TargetChainId: targetChainId,
+			Asset:         targetAsset,
+			Originator:    originator,
+			Amount:        "1001",
+			UsdAmount:     "2502.5",
+			Reason:        "some-reason",
+			HeldAt:        heldAt,
+		},
+	}, actual)
+}
+
+func Test_Held_Fails(t *testing.T) {
+	setup(policyConfig)
+	mocks.MPolicyDecisionRepository.On("GetHeld").Return(nil, errors.New("some-error"))
+
+	actual, err := s.Held()
+
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func assertHeld(t *testing.T, tm payload.Transfer, previous []*entity.PolicyDecision, reason string) {
+	mocks.MPolicyDecisionRepository.On("Get", tm.TransactionId).Return(nil, nil)
+	mocks.MPolicyDecisionRepository.On("GetAllowedSince", mock.Anything).Return(previous, nil)
+	mocks.MPolicyDecisionRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusHeld", tm.TransactionId).Return(nil)
+
+	err := s.Evaluate(tm)
+
+	assert.Equal(t, service.ErrTransferHeld, err)
+	created := mocks.MPolicyDecisionRepository.Calls[len(mocks.MPolicyDecisionRepository.Calls)-1].Arguments.Get(0).(*entity.PolicyDecision)
+	assert.True(t, created.Held)
+	assert.Contains(t, created.Reason, reason)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusHeld", tm.TransactionId)
+}
+
+func decision(amount, usdAmount string, createdAt time.Time) *entity.PolicyDecision {
+	return &entity.PolicyDecision{
+		SourceChainID: sourceChainId,
+		TargetChainID: targetChainId,
+		Asset:         targetAsset,
+		Originator:    originator,
+		Amount:        amount,
+		UsdAmount:     usdAmount,
+		CreatedAt:     createdAt,
+	}
+}
+
+func setupValue() {
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, targetAsset).Return(&assetModel.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", targetChainId, targetAsset).Return(pricing.TokenPriceInfo{UsdPrice: usdPrice}, true)
+}
+
+func setup(cfg config.SigningPolicy) {
+	mocks.Setup()
+	s = NewService(cfg, mocks.MPolicyDecisionRepository, mocks.MTransferRepository, mocks.MAssetsService, mocks.MPricingService)
+}
@@ -37,6 +37,7 @@ var (
 	gaugeSuffix                  = "gauge_suffix"
 	counterOpts                  = prometheus.CounterOpts{Name: "CounterName", Help: "CounterHelp"}
 	counterSuffix                = "counter_suffix"
+	histogramOpts                = prometheus.HistogramOpts{Name: "HistogramName", Help: "HistogramHelp"}
 	sourceNetworkId              = constants.HederaNetworkId
 	sourceNetworkName            = testConstants.Networks[constants.HederaNetworkId].Name
 	targetNetworkId              = testConstants.EthereumNetworkId
@@ -171,6 +172,37 @@ func Test_DeleteCounter(t *testing.T) {
 	assert.Nil(t, counterInMapping)
 }
 
+func Test_CreateHistogramIfNotExists(t *testing.T) {
+	setup()
+
+	histogram := serviceInstance.CreateHistogramIfNotExists(histogramOpts)
+	defer serviceInstance.DeleteHistogram(histogramOpts.Name)
+
+	assert.NotNil(t, histogram)
+	assert.Equal(t, histogram, serviceInstance.CreateHistogramIfNotExists(histogramOpts))
+}
+
+func Test_GetHistogram(t *testing.T) {
+	setup()
+
+	serviceInstance.CreateHistogramIfNotExists(histogramOpts)
+	defer serviceInstance.DeleteHistogram(histogramOpts.Name)
+	histogramInMapping := serviceInstance.GetHistogram(histogramOpts.Name)
+
+	assert.NotNil(t, histogramInMapping)
+}
+
+func Test_DeleteHistogram(t *testing.T) {
+	setup()
+
+	serviceInstance.CreateHistogramIfNotExists(histogramOpts)
+	serviceInstance.DeleteHistogram(histogramOpts.Name)
+
+	histogramInMapping := serviceInstance.GetHistogram(histogramOpts.Name)
+
+	assert.Nil(t, histogramInMapping)
+}
+
 func setup() {
 	mocks.Setup()
 	helper.SetupNetworks()
@@ -179,6 +211,7 @@ func setup() {
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
 		counters:            map[string]prometheus.Counter{},
+		histograms:          map[string]prometheus.Histogram{},
 		assetsService:       mocks.MAssetsService,
 		isMonitoringEnabled: isMonitoringEnabled,
 	}
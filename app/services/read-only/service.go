@@ -22,6 +22,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -58,59 +59,62 @@ func (s Service) FindAssetTransfer(
 	transferID string,
 	asset string,
 	expectedTransfers []model.Hedera,
-	fetch func() (*mirrorNodeTransaction.Response, error),
+	pages func() *pagination.Pages[mirrorNodeTransaction.Transaction],
 	save func(transactionID, scheduleID, status string) error) {
 	for {
-		response, err := fetch()
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
-			continue
-		}
-
 		finished := false
-		for _, transaction := range response.Transactions {
-			isFound := false
-			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+		transactions := pages()
+		for !finished && transactions.HasNext() {
+			page, err := transactions.Next()
 			if err != nil {
-				s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
-				continue
+				s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
+				break
 			}
-			for _, tx := range scheduledTx.Transactions {
-				if tx.Result == hedera.StatusSuccess.String() {
-					scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
-					if err != nil {
-						s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
-						break
-					}
-					if scheduleID.Memo == transferID {
-						isFound = true
-					}
+
+			for _, transaction := range page {
+				isFound := false
+				scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+				if err != nil {
+					s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
+					continue
 				}
-				if isFound && transfersAreFound(expectedTransfers, asset, transaction) {
-					s.logger.Infof("[%s] - Found a corresponding transaction [%s], ScheduleID [%s].", transferID, transaction.TransactionID, tx.EntityId)
-					finished = true
-					isSuccessful := transaction.Result == hedera.StatusSuccess.String()
-					txStatus := status.Completed
-					if !isSuccessful {
-						txStatus = status.Failed
+				for _, tx := range scheduledTx.Transactions {
+					if tx.Result == hedera.StatusSuccess.String() {
+						scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
+						if err != nil {
+							s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
+							break
+						}
+						if scheduleID.Memo == transferID {
+							isFound = true
+						}
 					}
+					if isFound && transfersAreFound(expectedTransfers, asset, transaction) {
+						s.logger.Infof("[%s] - Found a corresponding transaction [%s], ScheduleID [%s].", transferID, transaction.TransactionID, tx.EntityId)
+						finished = true
+						isSuccessful := transaction.Result == hedera.StatusSuccess.String()
+						txStatus := status.Completed
+						if !isSuccessful {
+							txStatus = status.Failed
+						}
 
-					err := save(transaction.TransactionID, tx.EntityId, txStatus)
-					if err != nil {
-						s.logger.Errorf("[%s] - Failed to save entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
-						break
-					}
+						err := save(transaction.TransactionID, tx.EntityId, txStatus)
+						if err != nil {
+							s.logger.Errorf("[%s] - Failed to save entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
+							break
+						}
 
-					if isSuccessful {
-						err = s.transferRepository.UpdateStatusCompleted(transferID)
-					} else {
-						err = s.transferRepository.UpdateStatusFailed(transferID)
-					}
-					if err != nil {
-						s.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferID, err)
+						if isSuccessful {
+							err = s.transferRepository.UpdateStatusCompleted(transferID)
+						} else {
+							err = s.transferRepository.UpdateStatusFailed(transferID)
+						}
+						if err != nil {
+							s.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferID, err)
+							break
+						}
 						break
 					}
-					break
 				}
 			}
 		}
@@ -238,50 +242,53 @@ func (s Service) FindNftTransfer(
 
 func (s Service) FindTransfer(
 	transferID string,
-	fetch func() (*mirrorNodeTransaction.Response, error),
+	pages func() *pagination.Pages[mirrorNodeTransaction.Transaction],
 	save func(transactionID, scheduleID, status string) error) {
 	for {
-		response, err := fetch()
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
-			continue
-		}
-
 		finished := false
-		for _, transaction := range response.Transactions {
-			isFound := false
-			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+		transactions := pages()
+		for !finished && transactions.HasNext() {
+			page, err := transactions.Next()
 			if err != nil {
-				s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
-				continue
+				s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
+				break
 			}
-			for _, tx := range scheduledTx.Transactions {
-				if tx.Result == hedera.StatusSuccess.String() {
-					scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
-					if err != nil {
-						s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, scheduleID, err)
-						break
-					}
-					if scheduleID.Memo == transferID {
-						isFound = true
-					}
+
+			for _, transaction := range page {
+				isFound := false
+				scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+				if err != nil {
+					s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
+					continue
 				}
-				if isFound {
-					s.logger.Infof("[%s] - Found a corresponding transaction [%s], ScheduleID [%s].", transferID, transaction.TransactionID, tx.EntityId)
-					finished = true
-					isSuccessful := transaction.Result == hedera.StatusSuccess.String()
-					txStatus := status.Completed
-					if !isSuccessful {
-						txStatus = status.Failed
+				for _, tx := range scheduledTx.Transactions {
+					if tx.Result == hedera.StatusSuccess.String() {
+						scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
+						if err != nil {
+							s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, scheduleID, err)
+							break
+						}
+						if scheduleID.Memo == transferID {
+							isFound = true
+						}
 					}
+					if isFound {
+						s.logger.Infof("[%s] - Found a corresponding transaction [%s], ScheduleID [%s].", transferID, transaction.TransactionID, tx.EntityId)
+						finished = true
+						isSuccessful := transaction.Result == hedera.StatusSuccess.String()
+						txStatus := status.Completed
+						if !isSuccessful {
+							txStatus = status.Failed
+						}
+
+						err := save(transaction.TransactionID, tx.EntityId, txStatus)
+						if err != nil {
+							s.logger.Errorf("[%s] - Failed to save entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
+							break
+						}
 
-					err := save(transaction.TransactionID, tx.EntityId, txStatus)
-					if err != nil {
-						s.logger.Errorf("[%s] - Failed to save entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
 						break
 					}
-
-					break
 				}
 			}
 		}
@@ -0,0 +1,381 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	receiptPollingInterval = 5 * time.Second
+	// gasLimitMargin is the percentage added on top of the gas estimate of the claim transaction
+	gasLimitMargin = 20
+)
+
+// nonce tracks the next nonce of the relayer account on a chain, so that concurrent claims do not reuse it
+type nonce struct {
+	mutex sync.Mutex
+	next  uint64
+}
+
+// Service relays the claim transactions of fungible transfers to their target chain. The relayer of a transfer is
+// elected by the hash of its ID modulo the number of router members, ordered by address. The following members
+// take over one after another, each one after the deadline of the previous one has passed without the transfer
+// being completed. Transfers get completed once the EVM watcher observes their Mint/Unlock event.
+type Service struct {
+	config             config.Relayer
+	signers            map[uint64]service.Signer
+	contractServices   map[uint64]service.Contracts
+	evmClients         map[uint64]client.EVM
+	transfersService   service.Transfers
+	transferRepository repository.Transfer
+	pollingInterval    time.Duration
+	mutex              sync.Mutex
+	pending            map[string]bool
+	nonces             map[uint64]*nonce
+	logger             *log.Entry
+}
+
+func NewService(
+	cfg config.Relayer,
+	signers map[uint64]service.Signer,
+	contractServices map[uint64]service.Contracts,
+	evmClients map[uint64]client.EVM,
+	transfersService service.Transfers,
+	transferRepository repository.Transfer) *Service {
+	nonces := make(map[uint64]*nonce)
+	for chainId := range signers {
+		nonces[chainId] = &nonce{}
+	}
+
+	return &Service{
+		config:             cfg,
+		signers:            signers,
+		contractServices:   contractServices,
+		evmClients:         evmClients,
+		transfersService:   transfersService,
+		transferRepository: transferRepository,
+		pollingInterval:    receiptPollingInterval,
+		pending:            make(map[string]bool),
+		nonces:             nonces,
+		logger:             config.GetLoggerFor("Relayer Service"),
+	}
+}
+
+// Relay schedules the submission of the claim transaction of the given transfer, which reached super majority
+// at the given consensus timestamp. Transfers already scheduled are skipped
+func (s *Service) Relay(ctx context.Context, transferID string, targetChainId uint64, majorityTimestamp int64) {
+	s.mutex.Lock()
+	if s.pending[transferID] {
+		s.mutex.Unlock()
+		return
+	}
+	s.pending[transferID] = true
+	s.mutex.Unlock()
+
+	go func() {
+		defer func() {
+			s.mutex.Lock()
+			delete(s.pending, transferID)
+			s.mutex.Unlock()
+		}()
+		s.relay(ctx, transferID, targetChainId, time.Unix(0, majorityTimestamp))
+	}()
+}
+
+// Resume relays the fungible transfers, which reached super majority, but did not get completed,
+// e.g. because the node got restarted while relaying them
+func (s *Service) Resume(ctx context.Context) {
+	transfers, err := s.transferRepository.GetUncompletedSigned()
+	if err != nil {
+		s.logger.Errorf("Failed to query the uncompleted transfers. Error: [%s]", err)
+		return
+	}
+
+	for _, transfer := range transfers {
+		majorityTimestamp, reached, err := s.majorityTimestamp(transfer)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to determine whether majority was reached. Error: [%s]", transfer.TransactionID, err)
+			continue
+		}
+		if !reached {
+			continue
+		}
+
+		s.logger.Infof("[%s] - Resuming the relay of the transfer, which reached majority at [%d].", transfer.TransactionID, majorityTimestamp)
+		s.Relay(ctx, transfer.TransactionID, transfer.TargetChainID, majorityTimestamp)
+	}
+}
+
+// majorityTimestamp returns the consensus timestamp of the signature, with which the transfer reached super majority.
+// Returns false if the collected signatures are not enough
+func (s *Service) majorityTimestamp(transfer *entity.Transfer) (int64, bool, error) {
+	contractService, ok := s.contractServices[transfer.TargetChainID]
+	if !ok {
+		return 0, false, fmt.Errorf("no router for chain [%d]", transfer.TargetChainID)
+	}
+
+	messages := make([]entity.Message, len(transfer.Messages))
+	copy(messages, transfer.Messages)
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].TransactionTimestamp < messages[j].TransactionTimestamp
+	})
+
+	for i, message := range messages {
+		reached, err := contractService.HasValidSignaturesLength(big.NewInt(int64(i + 1)))
+		if err != nil {
+			return 0, false, err
+		}
+		if reached {
+			return message.TransactionTimestamp, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (s *Service) relay(ctx context.Context, transferID string, targetChainId uint64, majorityAt time.Time) {
+	rank, err := s.rank(transferID, targetChainId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to determine the relayer turn. Error: [%s]", transferID, err)
+		return
+	}
+
+	turn := majorityAt.Add(time.Duration(rank) * s.config.Deadline)
+	if rank > 0 {
+		s.logger.Debugf("[%s] - Relayer turn [%d] starts at [%s].", transferID, rank, turn)
+	}
+	if !syncHelper.Sleep(ctx, time.Until(turn)) {
+		return
+	}
+
+	transfer, err := s.transferRepository.GetByTransactionId(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to query transfer. Error: [%s]", transferID, err)
+		return
+	}
+	if transfer == nil || transfer.Status == status.Completed {
+		return
+	}
+
+	claim, err := s.transfersService.ClaimData(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to prepare the claim transaction. Error: [%s]", transferID, err)
+		return
+	}
+
+	err = s.submit(ctx, transferID, targetChainId, claim)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to relay the claim transaction. Error: [%s]", transferID, err)
+	}
+}
+
+// rank returns the position of the validator after the elected relayer of the transfer among the router members
+func (s *Service) rank(transferID string, targetChainId uint64) (int, error) {
+	contractService, ok := s.contractServices[targetChainId]
+	if !ok {
+		return 0, fmt.Errorf("no router for chain [%d]", targetChainId)
+	}
+	signer, ok := s.signers[targetChainId]
+	if !ok {
+		return 0, fmt.Errorf("no signer for chain [%d]", targetChainId)
+	}
+
+	members := contractService.GetMembers()
+	addresses := make([]common.Address, 0, len(members))
+	for _, member := range members {
+		addresses = append(addresses, common.HexToAddress(member))
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	own := common.HexToAddress(signer.Address())
+	index := -1
+	for i, address := range addresses {
+		if address == own {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return 0, fmt.Errorf("[%s] is not a member of the router on chain [%d]", own, targetChainId)
+	}
+
+	count := big.NewInt(int64(len(addresses)))
+	elected := int(new(big.Int).Mod(new(big.Int).SetBytes(crypto.Keccak256([]byte(transferID))), count).Int64())
+
+	return (index - elected + len(addresses)) % len(addresses), nil
+}
+
+// submit sends the claim transaction and waits for it to be mined, replacing it with a higher gas price while pending
+func (s *Service) submit(ctx context.Context, transferID string, targetChainId uint64, claim *service.ClaimData) error {
+	evmClient, ok := s.evmClients[targetChainId]
+	if !ok {
+		return fmt.Errorf("no client for chain [%d]", targetChainId)
+	}
+
+	opts, err := s.signers[targetChainId].NewKeyTransactor(new(big.Int).SetUint64(targetChainId))
+	if err != nil {
+		return err
+	}
+
+	data, err := hexutil.Decode(claim.Calldata)
+	if err != nil {
+		return err
+	}
+
+	gasPrice, err := evmClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	router := common.HexToAddress(claim.RouterAddress)
+	gasLimit := claim.GasEstimate * (100 + gasLimitMargin) / 100
+	newTx := func(nonce uint64, gasPrice *big.Int) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       &router,
+			Data:     data,
+		})
+	}
+
+	n := s.nonces[targetChainId]
+	n.mutex.Lock()
+	txNonce, err := s.nextNonce(ctx, evmClient, opts.From, n)
+	if err != nil {
+		n.mutex.Unlock()
+		return err
+	}
+	tx, err := s.send(ctx, evmClient, opts, newTx(txNonce, gasPrice))
+	if err != nil {
+		// The nonce gets read from the chain again on the next submission
+		n.next = 0
+		n.mutex.Unlock()
+		return err
+	}
+	n.next = txNonce + 1
+	n.mutex.Unlock()
+
+	s.logger.Infof("[%s] - Submitted [%s] claim transaction [%s] with nonce [%d].", transferID, claim.Method, tx.Hash(), txNonce)
+
+	hashes := []common.Hash{tx.Hash()}
+	for bumps := 0; ; bumps++ {
+		receipt := s.awaitReceipt(ctx, evmClient, hashes, s.config.StuckTimeout)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if receipt != nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return fmt.Errorf("claim transaction [%s] reverted", receipt.TxHash)
+			}
+			s.logger.Infof("[%s] - Claim transaction [%s] mined.", transferID, receipt.TxHash)
+			return nil
+		}
+
+		if bumps >= s.config.MaxGasPriceBumps {
+			return fmt.Errorf("claim transaction not mined after [%d] gas price bumps", bumps)
+		}
+
+		gasPrice = bumpGasPrice(gasPrice, s.config.GasPriceBump)
+		tx, err = s.send(ctx, evmClient, opts, newTx(txNonce, gasPrice))
+		if err != nil {
+			// The stuck transaction may have been mined in the meantime
+			s.logger.Warnf("[%s] - Failed to replace the stuck claim transaction. Error: [%s]", transferID, err)
+			continue
+		}
+		s.logger.Infof("[%s] - Replaced the stuck claim transaction with [%s] and gas price [%s].", transferID, tx.Hash(), gasPrice)
+		hashes = append(hashes, tx.Hash())
+	}
+}
+
+// nextNonce returns the next nonce of the account, not lower than the pending nonce on the chain
+func (s *Service) nextNonce(ctx context.Context, evmClient client.EVM, from common.Address, n *nonce) (uint64, error) {
+	pending, err := evmClient.PendingNonceAt(ctx, from)
+	if err != nil {
+		return 0, err
+	}
+	if pending > n.next {
+		n.next = pending
+	}
+	return n.next, nil
+}
+
+func (s *Service) send(ctx context.Context, evmClient client.EVM, opts *bind.TransactOpts, tx *types.Transaction) (*types.Transaction, error) {
+	signedTx, err := opts.Signer(opts.From, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = evmClient.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// awaitReceipt polls for the receipt of any of the transactions until the timeout or until the context is cancelled.
+// Returns nil if none got mined
+func (s *Service) awaitReceipt(ctx context.Context, evmClient client.EVM, hashes []common.Hash, timeout time.Duration) *types.Receipt {
+	deadline := time.Now().Add(timeout)
+	for {
+		for _, hash := range hashes {
+			receipt, err := evmClient.GetClient().TransactionReceipt(ctx, hash)
+			if err == nil && receipt != nil {
+				return receipt
+			}
+			if err != nil && !errors.Is(err, ethereum.NotFound) {
+				s.logger.Warnf("Failed to query receipt of [%s]. Error: [%s]", hash, err)
+			}
+		}
+
+		if time.Now().After(deadline) || !syncHelper.Sleep(ctx, s.pollingInterval) {
+			return nil
+		}
+	}
+}
+
+func bumpGasPrice(gasPrice *big.Int, percentage uint64) *big.Int {
+	bumped := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(100+percentage))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(gasPrice) <= 0 {
+		bumped.Add(gasPrice, big.NewInt(1))
+	}
+	return bumped
+}
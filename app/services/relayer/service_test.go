@@ -0,0 +1,354 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package relayer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s             *Service
+	transferId    = "0.0.123-1658483845-123456789"
+	targetChainId = uint64(80001)
+	relayerConfig = config.Relayer{
+		Enabled:          true,
+		Deadline:         time.Hour,
+		StuckTimeout:     0,
+		GasPriceBump:     20,
+		MaxGasPriceBumps: 1,
+	}
+	claim = &service.ClaimData{
+		TransferId:    transferId,
+		ChainId:       targetChainId,
+		RouterAddress: "0x0000000000000000000000000000000000000001",
+		Method:        "mint",
+		Selector:      "0x2148199d",
+		Calldata:      "0x2148199d",
+		GasEstimate:   100000,
+	}
+	members = []string{
+		"0x0000000000000000000000000000000000000003",
+		"0x0000000000000000000000000000000000000001",
+		"0x0000000000000000000000000000000000000002",
+	}
+)
+
+func setup() {
+	mocks.Setup()
+	s = NewService(
+		relayerConfig,
+		map[uint64]service.Signer{targetChainId: mocks.MSignerService},
+		map[uint64]service.Contracts{targetChainId: mocks.MBridgeContractService},
+		map[uint64]client.EVM{targetChainId: mocks.MEVMClient},
+		mocks.MTransferService,
+		mocks.MTransferRepository)
+	s.pollingInterval = time.Millisecond
+}
+
+func setupTransactor(t *testing.T) *bind.TransactOpts {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(targetChainId))
+	assert.Nil(t, err)
+
+	mocks.MSignerService.On("NewKeyTransactor", new(big.Int).SetUint64(targetChainId)).Return(opts, nil)
+	mocks.MEVMClient.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(100), nil)
+	mocks.MEVMClient.On("PendingNonceAt", mock.Anything, opts.From).Return(uint64(7), nil)
+	mocks.MEVMClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	return opts
+}
+
+func Test_NewService(t *testing.T) {
+	setup()
+
+	assert.Equal(t, relayerConfig, s.config)
+	assert.Equal(t, receiptPollingInterval, NewService(relayerConfig, nil, nil, nil, nil, nil).pollingInterval)
+	assert.NotNil(t, s.nonces[targetChainId])
+	assert.Empty(t, s.pending)
+}
+
+func Test_rank(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+
+	ranks := make(map[int]bool)
+	for _, member := range members {
+		mocks.MSignerService.ExpectedCalls = nil
+		mocks.MSignerService.On("Address").Return(member)
+
+		rank, err := s.rank(transferId, targetChainId)
+
+		assert.Nil(t, err)
+		ranks[rank] = true
+	}
+
+	assert.Equal(t, map[int]bool{0: true, 1: true, 2: true}, ranks)
+}
+
+func Test_rank_NotMember(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	mocks.MSignerService.On("Address").Return("0x0000000000000000000000000000000000000004")
+
+	_, err := s.rank(transferId, targetChainId)
+
+	assert.Error(t, err)
+}
+
+func Test_relay_Completed(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	elected := electedMember(t)
+	mocks.MSignerService.On("Address").Return(elected)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{Status: status.Completed}, nil)
+
+	s.relay(context.Background(), transferId, targetChainId, time.Now())
+
+	mocks.MTransferService.AssertNotCalled(t, "ClaimData", mock.Anything)
+}
+
+func Test_relay(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	elected := electedMember(t)
+	mocks.MSignerService.On("Address").Return(elected)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferService.On("ClaimData", transferId).Return(claim, nil)
+	setupTransactor(t)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+	s.relay(context.Background(), transferId, targetChainId, time.Now())
+
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SendTransaction", 1)
+	tx := sentTransactions()[0]
+	assert.Equal(t, uint64(7), tx.Nonce())
+	assert.Equal(t, uint64(120000), tx.Gas())
+	assert.Equal(t, big.NewInt(100), tx.GasPrice())
+	assert.Equal(t, uint64(8), s.nonces[targetChainId].next)
+}
+
+func Test_relay_Cancelled(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	elected := electedMember(t)
+	mocks.MSignerService.On("Address").Return(elected)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.relay(ctx, transferId, targetChainId, time.Now().Add(time.Hour))
+
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", mock.Anything)
+}
+
+func Test_relay_ClaimDataFails(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	elected := electedMember(t)
+	mocks.MSignerService.On("Address").Return(elected)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferService.On("ClaimData", transferId).Return(nil, errors.New("execution reverted"))
+
+	s.relay(context.Background(), transferId, targetChainId, time.Now())
+
+	mocks.MEVMClient.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+}
+
+func Test_Resume(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	elected := electedMember(t)
+	for _, member := range members {
+		if member != elected {
+			mocks.MSignerService.On("Address").Return(member)
+			break
+		}
+	}
+	now := time.Now().UnixNano()
+	mocks.MTransferRepository.On("GetUncompletedSigned").Return([]*entity.Transfer{
+		{
+			TransactionID: transferId,
+			TargetChainID: targetChainId,
+			Messages:      []entity.Message{{TransactionTimestamp: now}, {TransactionTimestamp: now - 2}, {TransactionTimestamp: now - 1}},
+		},
+		{
+			TransactionID: "0.0.123-1658483845-987654321",
+			TargetChainID: targetChainId,
+			Messages:      []entity.Message{{TransactionTimestamp: now}},
+		},
+	}, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(true, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Resume(ctx)
+
+	// The relay waits for the turn of the validator, which is not the elected one
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	assert.Equal(t, map[string]bool{transferId: true}, s.pending)
+}
+
+func Test_Resume_QueryFails(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetUncompletedSigned").Return(nil, errors.New("some-error"))
+
+	s.Resume(context.Background())
+
+	assert.Empty(t, s.pending)
+	mocks.MBridgeContractService.AssertNotCalled(t, "HasValidSignaturesLength", mock.Anything)
+}
+
+func Test_majorityTimestamp(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(true, nil)
+
+	timestamp, reached, err := s.majorityTimestamp(&entity.Transfer{
+		TargetChainID: targetChainId,
+		Messages:      []entity.Message{{TransactionTimestamp: 3}, {TransactionTimestamp: 1}, {TransactionTimestamp: 2}},
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, reached)
+	assert.Equal(t, int64(2), timestamp)
+}
+
+func Test_majorityTimestamp_NotReached(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+
+	_, reached, err := s.majorityTimestamp(&entity.Transfer{
+		TargetChainID: targetChainId,
+		Messages:      []entity.Message{{TransactionTimestamp: 1}},
+	})
+
+	assert.Nil(t, err)
+	assert.False(t, reached)
+}
+
+func Test_submit_BumpsGasPrice(t *testing.T) {
+	setup()
+	setupTransactor(t)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound).Once()
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+	err := s.submit(context.Background(), transferId, targetChainId, claim)
+
+	assert.Nil(t, err)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SendTransaction", 2)
+	sent := sentTransactions()
+	assert.Equal(t, sent[0].Nonce(), sent[1].Nonce())
+	assert.Equal(t, big.NewInt(120), sent[1].GasPrice())
+}
+
+func Test_submit_NotMined(t *testing.T) {
+	setup()
+	setupTransactor(t)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+
+	err := s.submit(context.Background(), transferId, targetChainId, claim)
+
+	assert.Error(t, err)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SendTransaction", 2)
+}
+
+func Test_submit_Cancelled(t *testing.T) {
+	setup()
+	setupTransactor(t)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.submit(ctx, transferId, targetChainId, claim)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SendTransaction", 1)
+}
+
+func Test_submit_Reverted(t *testing.T) {
+	setup()
+	setupTransactor(t)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+
+	err := s.submit(context.Background(), transferId, targetChainId, claim)
+
+	assert.Error(t, err)
+}
+
+func Test_submit_SendFails(t *testing.T) {
+	setup()
+	key, _ := crypto.GenerateKey()
+	opts, _ := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(targetChainId))
+	mocks.MSignerService.On("NewKeyTransactor", new(big.Int).SetUint64(targetChainId)).Return(opts, nil)
+	mocks.MEVMClient.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(100), nil)
+	mocks.MEVMClient.On("PendingNonceAt", mock.Anything, opts.From).Return(uint64(7), nil)
+	mocks.MEVMClient.On("SendTransaction", mock.Anything, mock.Anything).Return(errors.New("nonce too low"))
+
+	err := s.submit(context.Background(), transferId, targetChainId, claim)
+
+	assert.Error(t, err)
+	assert.Equal(t, uint64(0), s.nonces[targetChainId].next)
+}
+
+func Test_bumpGasPrice(t *testing.T) {
+	assert.Equal(t, big.NewInt(120), bumpGasPrice(big.NewInt(100), 20))
+	assert.Equal(t, big.NewInt(2), bumpGasPrice(big.NewInt(1), 20))
+}
+
+func sentTransactions() []*types.Transaction {
+	var sent []*types.Transaction
+	for _, call := range mocks.MEVMClient.Calls {
+		if call.Method == "SendTransaction" {
+			sent = append(sent, call.Arguments.Get(1).(*types.Transaction))
+		}
+	}
+	return sent
+}
+
+// electedMember returns the member elected as relayer of the transfer
+func electedMember(t *testing.T) string {
+	for _, member := range members {
+		mocks.MSignerService.ExpectedCalls = nil
+		mocks.MSignerService.On("Address").Return(member)
+		rank, err := s.rank(transferId, targetChainId)
+		assert.Nil(t, err)
+		if rank == 0 {
+			mocks.MSignerService.ExpectedCalls = nil
+			return member
+		}
+	}
+	t.Fatal("no elected member")
+	return ""
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reprocess
+
+import (
+	"context"
+	"sync"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/reprocess"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	transferRepository repository.Transfer
+	messageRepository  repository.Message
+	queue              qi.Queue
+	reprocessors       map[string]service.Reprocessor
+	mutex              sync.RWMutex
+	logger             *log.Entry
+}
+
+func NewService(transferRepository repository.Transfer, messageRepository repository.Message, queue qi.Queue) *Service {
+	return &Service{
+		transferRepository: transferRepository,
+		messageRepository:  messageRepository,
+		queue:              queue,
+		reprocessors:       make(map[string]service.Reprocessor),
+		logger:             config.GetLoggerFor("Reprocess Service"),
+	}
+}
+
+// Register makes the source reprocessable by the given reprocessor
+func (s *Service) Register(source string, reprocessor service.Reprocessor) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.reprocessors[source] = reprocessor
+}
+
+// Reprocess runs the reprocessor of the requested source over the requested window, skipping the
+// transfers and messages already in the database. Returns ErrNotFound if the source is not registered
+func (s *Service) Reprocess(ctx context.Context, request *reprocess.Request) (*reprocess.Report, error) {
+	if request.From < 0 || request.From > request.To {
+		return nil, service.ErrWrongQuery
+	}
+
+	s.mutex.RLock()
+	reprocessor, ok := s.reprocessors[request.Source]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+
+	s.logger.Infof("[%s] - Reprocessing from [%d] to [%d].", request.Source, request.From, request.To)
+	q := &filteringQueue{
+		Queue:   s.queue,
+		service: s,
+		report: &reprocess.Report{
+			Source:  request.Source,
+			From:    request.From,
+			To:      request.To,
+			Created: []string{},
+			Skipped: []string{},
+		},
+	}
+
+	found, err := reprocessor.Reprocess(ctx, request.From, request.To, q)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to reprocess from [%d] to [%d]. Error: [%s]", request.Source, request.From, request.To, err)
+		return nil, err
+	}
+	q.report.Found = found
+
+	s.logger.Infof("[%s] - Reprocessing found [%d], created [%d] and skipped [%d].", request.Source, found, len(q.report.Created), len(q.report.Skipped))
+	return q.report, nil
+}
+
+// exists returns the ID of the transfer or message, carried by the payload, and whether it is already in the database
+func (s *Service) exists(p interface{}) (string, bool, error) {
+	switch p := p.(type) {
+	case *payload.Transfer:
+		transfer, err := s.transferRepository.GetByTransactionId(p.TransactionId)
+		return p.TransactionId, transfer != nil, err
+	case *message.Message:
+		transferID, signature := signatureOf(p)
+		messages, err := s.messageRepository.Get(transferID)
+		if err != nil {
+			return transferID, false, err
+		}
+		for _, m := range messages {
+			if m.Signature == signature {
+				return transferID, true, nil
+			}
+		}
+		return transferID, false, nil
+	default:
+		return "", false, nil
+	}
+}
+
+func signatureOf(m *message.Message) (string, string) {
+	if nft := m.GetNftSignatureMessage(); nft != nil {
+		return nft.GetTransferID(), nft.GetSignature()
+	}
+	fungible := m.GetFungibleSignatureMessage()
+	return fungible.GetTransferID(), fungible.GetSignature()
+}
+
+// filteringQueue forwards to the underlying queue only the payloads, which are not in the database yet,
+// and records the outcome of each push in the report
+type filteringQueue struct {
+	qi.Queue
+	service *Service
+	report  *reprocess.Report
+}
+
+func (q *filteringQueue) Push(message *queue.Message) {
+	id, exists, err := q.service.exists(message.Payload)
+	if err != nil {
+		q.service.logger.Errorf("[%s] - Failed to check whether the payload was already processed. Error: [%s]", id, err)
+	}
+	if exists {
+		q.report.Skipped = append(q.report.Skipped, id)
+		return
+	}
+
+	q.Queue.Push(message)
+	if id != "" {
+		q.report.Created = append(q.report.Created, id)
+	}
+}
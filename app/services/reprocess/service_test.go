@@ -0,0 +1,140 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reprocess
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/reprocess"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	s       *Service
+	source  = "0.0.123"
+	request = &reprocess.Request{Source: source, From: 1, To: 10}
+)
+
+type reprocessorFunc func(ctx context.Context, from, to int64, q qi.Queue) (int, error)
+
+func (f reprocessorFunc) Reprocess(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+	return f(ctx, from, to, q)
+}
+
+func Test_Reprocess(t *testing.T) {
+	setup()
+	newTransfer := &queue.Message{Payload: &payload.Transfer{TransactionId: "0.0.1-1-1"}, Topic: constants.TopicMessageSubmission}
+	existingTransfer := &queue.Message{Payload: &payload.Transfer{TransactionId: "0.0.1-2-2"}, Topic: constants.TopicMessageSubmission}
+	mocks.MTransferRepository.On("GetByTransactionId", "0.0.1-1-1").Return((*entity.Transfer)(nil), nil)
+	mocks.MTransferRepository.On("GetByTransactionId", "0.0.1-2-2").Return(&entity.Transfer{TransactionID: "0.0.1-2-2"}, nil)
+	mocks.MQueue.On("Push", newTransfer).Return()
+	s.Register(source, reprocessorFunc(func(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+		assert.Equal(t, request.From, from)
+		assert.Equal(t, request.To, to)
+		q.Push(newTransfer)
+		q.Push(existingTransfer)
+		return 3, nil
+	}))
+
+	report, err := s.Reprocess(context.Background(), request)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &reprocess.Report{
+		Source:  source,
+		From:    request.From,
+		To:      request.To,
+		Found:   3,
+		Created: []string{"0.0.1-1-1"},
+		Skipped: []string{"0.0.1-2-2"},
+	}, report)
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+}
+
+func Test_Reprocess_SkipsExistingSignatures(t *testing.T) {
+	setup()
+	msg := &queue.Message{
+		Payload: &message.Message{TopicMessage: &proto.TopicMessage{
+			Message: &proto.TopicMessage_FungibleSignatureMessage{
+				FungibleSignatureMessage: &proto.TopicEthSignatureMessage{TransferID: "0.0.1-1-1", Signature: "signature"},
+			},
+		}},
+		Topic: constants.TopicMessageValidation,
+	}
+	mocks.MMessageRepository.On("Get", "0.0.1-1-1").Return([]entity.Message{{TransferID: "0.0.1-1-1", Signature: "signature"}}, nil)
+	s.Register(source, reprocessorFunc(func(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+		q.Push(msg)
+		return 1, nil
+	}))
+
+	report, err := s.Reprocess(context.Background(), request)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0.0.1-1-1"}, report.Skipped)
+	assert.Empty(t, report.Created)
+	mocks.MQueue.AssertNotCalled(t, "Push", msg)
+}
+
+func Test_Reprocess_UnknownSource(t *testing.T) {
+	setup()
+
+	report, err := s.Reprocess(context.Background(), request)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	assert.Nil(t, report)
+}
+
+func Test_Reprocess_InvalidWindow(t *testing.T) {
+	setup()
+	s.Register(source, reprocessorFunc(func(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+		t.Fatal("unexpected reprocessing")
+		return 0, nil
+	}))
+
+	report, err := s.Reprocess(context.Background(), &reprocess.Request{Source: source, From: 10, To: 1})
+
+	assert.Equal(t, service.ErrWrongQuery, err)
+	assert.Nil(t, report)
+}
+
+func Test_Reprocess_Fails(t *testing.T) {
+	setup()
+	expectedErr := errors.New("some-error")
+	s.Register(source, reprocessorFunc(func(ctx context.Context, from, to int64, q qi.Queue) (int, error) {
+		return 0, expectedErr
+	}))
+
+	report, err := s.Reprocess(context.Background(), request)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, report)
+}
+
+func setup() {
+	mocks.Setup()
+	s = NewService(mocks.MTransferRepository, mocks.MMessageRepository, mocks.MQueue)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// DualKeySigner holds the active and the next key of the validator during a key rotation. It signs with the
+// active key until the router members get updated so that the next key is a member and the active one is not.
+// The keys are then swapped, which allows switching back if the member update gets reverted
+type DualKeySigner struct {
+	mutex     sync.Mutex
+	active    service.Signer
+	next      service.Signer
+	contracts service.Contracts
+	logger    *log.Entry
+}
+
+func NewDualKeySigner(active, next service.Signer, contracts service.Contracts) *DualKeySigner {
+	return &DualKeySigner{
+		active:    active,
+		next:      next,
+		contracts: contracts,
+		logger:    config.GetLoggerFor("Dual Key Signer"),
+	}
+}
+
+func (s *DualKeySigner) Sign(data []byte) ([]byte, error) {
+	return s.current().Sign(data)
+}
+
+func (s *DualKeySigner) NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error) {
+	return s.current().NewKeyTransactor(chainId)
+}
+
+// Address returns the address of the active key
+func (s *DualKeySigner) Address() string {
+	return s.current().Address()
+}
+
+// NextAddress returns the address of the key, which is not used for signing yet
+func (s *DualKeySigner) NextAddress() string {
+	s.current()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.next.Address()
+}
+
+// current returns the active signer, switching over to the next one if the router members were updated
+// by a MemberUpdated event, so that only the next key is a member
+func (s *DualKeySigner) current() service.Signer {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.contracts.IsMember(s.active.Address()) && s.contracts.IsMember(s.next.Address()) {
+		s.logger.Infof("Switching signing key from [%s] to [%s].", s.active.Address(), s.next.Address())
+		s.active, s.next = s.next, s.active
+	}
+
+	return s.active
+}
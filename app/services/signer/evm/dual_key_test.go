@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupDualKeySigner() (*DualKeySigner, string, string) {
+	mocks.Setup()
+	active, _ := mockSigner()
+	next, _ := mockSigner()
+	return NewDualKeySigner(active, next, mocks.MBridgeContractService), active.Address(), next.Address()
+}
+
+func Test_DualKeySigner_SignsWithActiveKey(t *testing.T) {
+	s, active, next := setupDualKeySigner()
+	mocks.MBridgeContractService.On("IsMember", active).Return(true)
+	mocks.MBridgeContractService.On("IsMember", next).Return(false)
+
+	res, err := s.Sign([]byte("12345678123456781234567812345678"))
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res)
+	assert.Equal(t, active, s.Address())
+	assert.Equal(t, next, s.NextAddress())
+}
+
+func Test_DualKeySigner_SwitchesToNextKey(t *testing.T) {
+	s, active, next := setupDualKeySigner()
+	mocks.MBridgeContractService.On("IsMember", active).Return(false)
+	mocks.MBridgeContractService.On("IsMember", next).Return(true)
+
+	res, err := s.NewKeyTransactor(big.NewInt(80001))
+
+	assert.Nil(t, err)
+	assert.Equal(t, next, res.From.String())
+	assert.Equal(t, next, s.Address())
+	assert.Equal(t, active, s.NextAddress())
+}
+
+func Test_DualKeySigner_KeepsActiveKeyWhenNoneIsMember(t *testing.T) {
+	s, active, next := setupDualKeySigner()
+	mocks.MBridgeContractService.On("IsMember", active).Return(false)
+	mocks.MBridgeContractService.On("IsMember", next).Return(false)
+
+	assert.Equal(t, active, s.Address())
+	assert.Equal(t, next, s.NextAddress())
+}
@@ -36,8 +36,9 @@ func NewEVMSigner(privateKey string) *Signer {
 	return &Signer{privateKey: pk}
 }
 
-func (s *Signer) Sign(msg []byte) ([]byte, error) {
-	signature, err := crypto.Sign(msg, s.privateKey)
+// Sign returns the signature of the keccak256 hash of the given data with a recovery id of 27 or 28
+func (s *Signer) Sign(data []byte) ([]byte, error) {
+	signature, err := crypto.Sign(crypto.Keccak256(data), s.privateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -34,12 +34,17 @@ func mockSigner() (service.Signer, *ecdsa.PrivateKey) {
 }
 
 func Test_Sign(t *testing.T) {
-	s, _ := mockSigner()
+	s, pk := mockSigner()
 
 	msg := []byte("12345678123456781234567812345678")
 	res, err := s.Sign(msg)
 	assert.Empty(t, err)
 	assert.NotEmpty(t, res)
+
+	res[64] -= 27
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(msg), res)
+	assert.Nil(t, err)
+	assert.Equal(t, pk.PublicKey, *publicKey)
 }
 
 func TestSigner_NewKeyTransactor(t *testing.T) {
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hsm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrRecoveryFailed = errors.New("signature-recovery-failed")
+
+// Signer signs EVM hashes and transactions with a secp256k1 key, kept in a PKCS#11 token
+type Signer struct {
+	hsm      client.Hsm
+	keyLabel string
+	address  common.Address
+}
+
+func NewSigner(hsm client.Hsm, keyLabel string) *Signer {
+	publicKey, err := hsm.PublicKey(keyLabel)
+	if err != nil {
+		log.Fatalf("Failed to load HSM key [%s]. Error: [%s]", keyLabel, err)
+	}
+	ecdsaKey, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		log.Fatalf("HSM key [%s] is not a secp256k1 key.", keyLabel)
+	}
+
+	return &Signer{
+		hsm:      hsm,
+		keyLabel: keyLabel,
+		address:  crypto.PubkeyToAddress(*ecdsaKey),
+	}
+}
+
+// Sign returns the signature of the keccak256 hash of the given data with a recovery id of 27 or 28
+func (s *Signer) Sign(data []byte) ([]byte, error) {
+	signature, err := s.sign(crypto.Keccak256(data))
+	if err != nil {
+		return nil, err
+	}
+	// note: https://github.com/ethereum/go-ethereum/issues/19751
+	signature[64] += 27
+
+	return signature, nil
+}
+
+func (s *Signer) NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error) {
+	if chainId == nil {
+		return nil, bind.ErrNoChainID
+	}
+	txSigner := types.LatestSignerForChainID(chainId)
+
+	return &bind.TransactOpts{
+		From: s.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.address {
+				return nil, bind.ErrNotAuthorized
+			}
+			signature, err := s.sign(txSigner.Hash(tx).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(txSigner, signature)
+		},
+		Context: context.Background(),
+	}, nil
+}
+
+func (s *Signer) Address() string {
+	return s.address.String()
+}
+
+// sign returns the signature of the hash with a recovery id of 0 or 1. PKCS#11 returns only `r` and `s`,
+// so the recovery id is the one, for which the signature recovers to the address of the key
+func (s *Signer) sign(hash []byte) ([]byte, error) {
+	rs, err := s.hsm.SignECDSA(s.keyLabel, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	copy(signature, rs)
+	for v := byte(0); v < 2; v++ {
+		signature[64] = v
+		publicKey, err := crypto.SigToPub(hash, signature)
+		if err == nil && crypto.PubkeyToAddress(*publicKey) == s.address {
+			return signature, nil
+		}
+	}
+
+	return nil, ErrRecoveryFailed
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hsm
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	hsmClient "github.com/limechain/hedera-eth-bridge-validator/app/clients/hsm"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyLabel = "evm-key"
+	data     = []byte("message")
+	hash     = crypto.Keccak256(data)
+	chainId  = big.NewInt(80001)
+)
+
+func setup() (*Signer, *ecdsa.PrivateKey) {
+	mocks.Setup()
+	key, _ := crypto.GenerateKey()
+	mocks.MHsmClient.On("PublicKey", keyLabel).Return(&key.PublicKey, nil)
+	return NewSigner(mocks.MHsmClient, keyLabel), key
+}
+
+// expectSignature sets up the HSM to return the `r` and `s` of the signature of the digest
+func expectSignature(key *ecdsa.PrivateKey, digest []byte) {
+	signature, _ := crypto.Sign(digest, key)
+	mocks.MHsmClient.On("SignECDSA", keyLabel, digest).Return(signature[:64], nil)
+}
+
+func Test_Sign(t *testing.T) {
+	s, key := setup()
+	expectSignature(key, hash)
+
+	signature, err := s.Sign(data)
+
+	assert.Nil(t, err)
+	assert.Contains(t, []byte{27, 28}, signature[64])
+	signature[64] -= 27
+	publicKey, err := crypto.SigToPub(hash, signature)
+	assert.Nil(t, err)
+	assert.Equal(t, s.Address(), crypto.PubkeyToAddress(*publicKey).String())
+}
+
+func Test_Sign_Fails(t *testing.T) {
+	s, _ := setup()
+	mocks.MHsmClient.On("SignECDSA", keyLabel, hash).Return(nil, errors.New("some-error"))
+
+	signature, err := s.Sign(data)
+
+	assert.Nil(t, signature)
+	assert.EqualError(t, err, "some-error")
+}
+
+func Test_Sign_NotFromKey(t *testing.T) {
+	s, _ := setup()
+	otherKey, _ := crypto.GenerateKey()
+	expectSignature(otherKey, hash)
+
+	signature, err := s.Sign(data)
+
+	assert.Nil(t, signature)
+	assert.Equal(t, ErrRecoveryFailed, err)
+}
+
+func Test_NewKeyTransactor(t *testing.T) {
+	s, key := setup()
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	expectSignature(key, types.LatestSignerForChainID(chainId).Hash(tx).Bytes())
+
+	opts, err := s.NewKeyTransactor(chainId)
+	assert.Nil(t, err)
+	signedTx, err := opts.Signer(opts.From, tx)
+
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, s.Address(), sender.String())
+}
+
+func Test_SoftHsm(t *testing.T) {
+	client := hsmClient.NewClient(helper.SetupSoftHsm(t))
+	defer client.Close()
+	s := NewSigner(client, helper.SoftHsmSecp256k1KeyLabel)
+
+	for i := 0; i < 10; i++ {
+		data := big.NewInt(int64(i)).Bytes()
+		digest := crypto.Keccak256(data)
+		signature, err := s.Sign(data)
+		assert.Nil(t, err)
+
+		signature[64] -= 27
+		publicKey, err := crypto.SigToPub(digest, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, s.Address(), crypto.PubkeyToAddress(*publicKey).String())
+	}
+}
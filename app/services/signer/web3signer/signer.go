@@ -0,0 +1,256 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const signPath = "/api/v1/eth1/sign/"
+
+var ErrSignerMismatch = errors.New("signature-not-from-signer")
+
+// Signer forwards the signing of data and transactions to a Web3Signer compatible HTTP service,
+// which keeps the EVM key of the validator. The service signs the keccak256 hash of the received data,
+// so the data is sent instead of its hash and transactions are sent as their signing payload
+type Signer struct {
+	url        string
+	identifier string
+	address    common.Address
+	httpClient *http.Client
+	logger     *log.Entry
+}
+
+type signRequest struct {
+	Data string `json:"data"`
+}
+
+func NewSigner(cfg config.EvmSigner) *Signer {
+	publicKey, err := parsePublicKey(cfg.PublicKey)
+	if err != nil {
+		log.Fatalf("Invalid Web3Signer Public Key provided: [%s]. Error: [%s]", cfg.PublicKey, err)
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load the TLS configuration of Web3Signer [%s]. Error: [%s]", cfg.Url, err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &Signer{
+		url:        strings.TrimSuffix(cfg.Url, "/"),
+		identifier: hexutil.Encode(crypto.FromECDSAPub(publicKey)[1:]),
+		address:    crypto.PubkeyToAddress(*publicKey),
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.RequestTimeout,
+		},
+		logger: config.GetLoggerFor("Web3Signer"),
+	}
+}
+
+// Sign returns the signature of the keccak256 hash of the given data with a recovery id of 27 or 28
+func (s *Signer) Sign(data []byte) ([]byte, error) {
+	signature, err := s.sign(data)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+
+	return signature, nil
+}
+
+func (s *Signer) NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error) {
+	if chainId == nil {
+		return nil, bind.ErrNoChainID
+	}
+	txSigner := types.LatestSignerForChainID(chainId)
+
+	return &bind.TransactOpts{
+		From: s.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.address {
+				return nil, bind.ErrNotAuthorized
+			}
+			data, err := signingPayload(txSigner, tx)
+			if err != nil {
+				return nil, err
+			}
+			signature, err := s.sign(data)
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(txSigner, signature)
+		},
+		Context: context.Background(),
+	}, nil
+}
+
+func (s *Signer) Address() string {
+	return s.address.String()
+}
+
+// sign requests the signature of the keccak256 hash of the data and verifies that it was produced by the
+// configured key. The returned signature has a recovery id of 0 or 1
+func (s *Signer) sign(data []byte) ([]byte, error) {
+	body, err := json.Marshal(signRequest{Data: hexutil.Encode(data)})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.httpClient.Post(s.url+signPath+s.identifier, "application/json", bytes.NewReader(body))
+	if err != nil {
+		s.logger.Errorf("Failed to request signature from [%s]. Error: [%s]", s.url, err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("web3signer responded with status [%d]: [%s]", response.StatusCode, strings.TrimSpace(string(responseBody)))
+	}
+
+	signature, err := parseSignature(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(data), signature)
+	if err != nil {
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(*publicKey) != s.address {
+		s.logger.Errorf("Signature from [%s] is not produced by [%s].", s.url, s.address)
+		return nil, ErrSignerMismatch
+	}
+
+	return signature, nil
+}
+
+// signingPayload returns the data, the keccak256 hash of which is the signing hash of the transaction
+func signingPayload(txSigner types.Signer, tx *types.Transaction) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch tx.Type() {
+	case types.LegacyTxType:
+		data, err = rlp.EncodeToBytes([]interface{}{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), txSigner.ChainID(), uint(0), uint(0),
+		})
+	case types.AccessListTxType:
+		data, err = rlp.EncodeToBytes([]interface{}{
+			txSigner.ChainID(), tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		data = append([]byte{tx.Type()}, data...)
+	case types.DynamicFeeTxType:
+		data, err = rlp.EncodeToBytes([]interface{}{
+			txSigner.ChainID(), tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		data = append([]byte{tx.Type()}, data...)
+	default:
+		return nil, types.ErrTxTypeNotSupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if crypto.Keccak256Hash(data) != txSigner.Hash(tx) {
+		return nil, fmt.Errorf("signing payload of transaction [%s] does not match its signing hash", tx.Hash())
+	}
+	return data, nil
+}
+
+// parsePublicKey decodes the hex secp256k1 public key, either with or without the 0x04 prefix
+func parsePublicKey(hex string) (*ecdsa.PublicKey, error) {
+	publicKey, err := hexutil.Decode(hex)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKey) == 64 {
+		publicKey = append([]byte{4}, publicKey...)
+	}
+	return crypto.UnmarshalPubkey(publicKey)
+}
+
+// parseSignature decodes the hex signature from the response, normalising its recovery id to 0 or 1
+func parseSignature(body []byte) ([]byte, error) {
+	signature, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(body)), `"`))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length [%d]", len(signature))
+	}
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	return signature, nil
+}
+
+func newTLSConfig(cfg config.EvmSigner) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.TlsCa != "" {
+		ca, err := os.ReadFile(cfg.TlsCa)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in [%s]", cfg.TlsCa)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.TlsCert != "" || cfg.TlsKey != "" {
+		certificate, err := tls.LoadX509KeyPair(cfg.TlsCert, cfg.TlsKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web3signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	data    = []byte("message")
+	chainId = big.NewInt(80001)
+)
+
+// fakeWeb3Signer serves the eth1 sign endpoint of the public key of the given key,
+// signing the keccak256 hash of the received data with it
+func fakeWeb3Signer(t *testing.T, key *ecdsa.PrivateKey) http.Handler {
+	identifier := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)[1:])
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != signPath+identifier {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var request signRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		signature, err := crypto.Sign(crypto.Keccak256(hexutil.MustDecode(request.Data)), key)
+		assert.Nil(t, err)
+		signature[64] += 27
+		_, _ = w.Write([]byte(hexutil.Encode(signature)))
+	})
+}
+
+func publicKey(key *ecdsa.PrivateKey) string {
+	return hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))
+}
+
+func setup(t *testing.T) (*ecdsa.PrivateKey, *httptest.Server, *Signer) {
+	key, _ := crypto.GenerateKey()
+	server := httptest.NewServer(fakeWeb3Signer(t, key))
+	t.Cleanup(server.Close)
+	signer := NewSigner(config.EvmSigner{
+		Url:            server.URL,
+		PublicKey:      publicKey(key),
+		RequestTimeout: time.Second,
+	})
+	return key, server, signer
+}
+
+func Test_Sign(t *testing.T) {
+	key, _, signer := setup(t)
+
+	signature, err := signer.Sign(data)
+
+	assert.Nil(t, err)
+	expected, _ := crypto.Sign(crypto.Keccak256(data), key)
+	expected[64] += 27
+	assert.Equal(t, expected, signature)
+}
+
+func Test_Sign_NotFromSigner(t *testing.T) {
+	otherKey, _ := crypto.GenerateKey()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature, _ := crypto.Sign(crypto.Keccak256(data), otherKey)
+		_, _ = w.Write([]byte(hexutil.Encode(signature)))
+	}))
+	defer server.Close()
+	key, _ := crypto.GenerateKey()
+	signer := NewSigner(config.EvmSigner{Url: server.URL, PublicKey: publicKey(key)})
+
+	signature, err := signer.Sign(data)
+
+	assert.Nil(t, signature)
+	assert.Equal(t, ErrSignerMismatch, err)
+}
+
+func Test_Sign_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Signer not found"))
+	}))
+	defer server.Close()
+	key, _ := crypto.GenerateKey()
+	signer := NewSigner(config.EvmSigner{Url: server.URL, PublicKey: publicKey(key)})
+
+	signature, err := signer.Sign(data)
+
+	assert.Nil(t, signature)
+	assert.EqualError(t, err, "web3signer responded with status [404]: [Signer not found]")
+}
+
+func Test_Sign_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+	key, _ := crypto.GenerateKey()
+	signer := NewSigner(config.EvmSigner{Url: server.URL, PublicKey: publicKey(key), RequestTimeout: 10 * time.Millisecond})
+
+	signature, err := signer.Sign(data)
+
+	assert.Nil(t, signature)
+	assert.NotNil(t, err)
+}
+
+func Test_NewKeyTransactor(t *testing.T) {
+	key, _, signer := setup(t)
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	opts, err := signer.NewKeyTransactor(chainId)
+	assert.Nil(t, err)
+	signedTx, err := opts.Signer(opts.From, tx)
+
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+}
+
+func Test_NewKeyTransactor_DynamicFee(t *testing.T) {
+	key, _, signer := setup(t)
+	to := common.HexToAddress("0x1")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:    chainId,
+		Nonce:      1,
+		GasTipCap:  big.NewInt(1),
+		GasFeeCap:  big.NewInt(2),
+		Gas:        21000,
+		To:         &to,
+		Value:      big.NewInt(1),
+		Data:       []byte{1, 2, 3},
+		AccessList: types.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+	})
+
+	opts, err := signer.NewKeyTransactor(chainId)
+	assert.Nil(t, err)
+	signedTx, err := opts.Signer(opts.From, tx)
+
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+}
+
+func Test_NewKeyTransactor_AccessList(t *testing.T) {
+	key, _, signer := setup(t)
+	tx := types.NewTx(&types.AccessListTx{
+		ChainID:  chainId,
+		Nonce:    1,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		Value:    big.NewInt(1),
+	})
+
+	opts, err := signer.NewKeyTransactor(chainId)
+	assert.Nil(t, err)
+	signedTx, err := opts.Signer(opts.From, tx)
+
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+}
+
+func Test_NewKeyTransactor_NotAuthorized(t *testing.T) {
+	_, _, signer := setup(t)
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	opts, _ := signer.NewKeyTransactor(chainId)
+	signedTx, err := opts.Signer(common.HexToAddress("0x1"), tx)
+
+	assert.Nil(t, signedTx)
+	assert.NotNil(t, err)
+}
+
+func Test_Address(t *testing.T) {
+	key, _, signer := setup(t)
+
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey).String(), signer.Address())
+}
+
+func Test_ParsePublicKey(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	uncompressed := crypto.FromECDSAPub(&key.PublicKey)
+
+	for _, hex := range []string{hexutil.Encode(uncompressed), hexutil.Encode(uncompressed[1:])} {
+		parsed, err := parsePublicKey(hex)
+
+		assert.Nil(t, err)
+		assert.Equal(t, key.PublicKey, *parsed)
+	}
+}
+
+func Test_ParsePublicKey_Invalid(t *testing.T) {
+	_, err := parsePublicKey(common.HexToAddress("0x1").String())
+
+	assert.NotNil(t, err)
+}
+
+func Test_ParseSignature_InvalidLength(t *testing.T) {
+	_, err := parseSignature([]byte(`"0x` + strings.Repeat("ab", 64) + `"`))
+
+	assert.EqualError(t, err, "invalid signature length [64]")
+}
+
+func Test_Sign_MutualTLS(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	certFile, keyFile, certificate := writeCertificate(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate.Leaf)
+
+	server := httptest.NewUnstartedServer(fakeWeb3Signer(t, key))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cfg := config.EvmSigner{
+		Url:            server.URL,
+		PublicKey:      publicKey(key),
+		RequestTimeout: time.Second,
+		TlsCa:          certFile,
+	}
+	_, err := NewSigner(cfg).Sign(data)
+	assert.NotNil(t, err)
+
+	cfg.TlsCert = certFile
+	cfg.TlsKey = keyFile
+	signature, err := NewSigner(cfg).Sign(data)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, signature)
+}
+
+// writeCertificate writes a self-signed certificate for 127.0.0.1, used by both the server and the client
+func writeCertificate(t *testing.T) (string, string, tls.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "web3signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	assert.Nil(t, os.WriteFile(certFile, certPem, 0600))
+	assert.Nil(t, os.WriteFile(keyFile, keyPem, 0600))
+
+	certificate, err := tls.X509KeyPair(certPem, keyPem)
+	assert.Nil(t, err)
+	certificate.Leaf, err = x509.ParseCertificate(der)
+	assert.Nil(t, err)
+
+	return certFile, keyFile, certificate
+}
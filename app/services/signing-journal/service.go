@@ -0,0 +1,211 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package signing_journal
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// entry is a single line of the journal file
+type entry struct {
+	TransferId    string    `json:"transferId"`
+	TargetChainId uint64    `json:"targetChainId"`
+	Hash          string    `json:"hash"`
+	SignedAt      time.Time `json:"signedAt"`
+}
+
+// Service keeps an append-only journal of the authorisation messages signed by the node in a local file.
+// The journal is independent of the database, so that restoring the database or rewinding a watcher
+// cannot make the node sign different contents for an already signed transfer. The journal may be shared by
+// the replicas of the node, so it is accessed under an exclusive file lock and the entries appended by the other
+// replicas are read before every record.
+type Service struct {
+	mutex  sync.Mutex
+	file   *os.File
+	hashes map[string]string
+	// offset is the size of the journal, up to which its entries are read
+	offset             int64
+	doubleSignAttempts prometheus.Counter
+	logger             *log.Entry
+}
+
+func NewService(cfg config.SigningJournal, prometheusService service.Prometheus) *Service {
+	file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		log.Fatalf("Failed to open signing journal [%s]. Error: [%s]", cfg.Path, err)
+	}
+
+	s := &Service{
+		file:   file,
+		hashes: make(map[string]string),
+		logger: config.GetLoggerFor("Signing Journal Service"),
+	}
+	err = s.locked(s.catchUp)
+	if err != nil {
+		log.Fatalf("Failed to load signing journal [%s]. Error: [%s]", cfg.Path, err)
+	}
+	s.logger.Infof("Loaded [%d] signed authorisation messages from [%s].", len(s.hashes), cfg.Path)
+
+	if prometheusService != nil && prometheusService.GetIsMonitoringEnabled() {
+		s.doubleSignAttempts = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.DoubleSignAttemptsCounterName,
+			Help: constants.DoubleSignAttemptsCounterHelp,
+		})
+	}
+
+	return s
+}
+
+// Record persists the hash of the authorisation message about to be signed for the given transfer and target chain.
+// Recording the same hash again is allowed. Returns ErrDoubleSign if a different hash has already been recorded
+func (s *Service) Record(transferID string, targetChainId uint64, hash []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.locked(func() error {
+		return s.record(transferID, targetChainId, hash)
+	})
+}
+
+func (s *Service) record(transferID string, targetChainId uint64, hash []byte) error {
+	err := s.catchUp()
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to read the signing journal. Error: [%s]", transferID, err)
+		return err
+	}
+
+	k := key(transferID, targetChainId)
+	encoded := hex.EncodeToString(hash)
+	signed, exists := s.hashes[k]
+	if exists {
+		if signed == encoded {
+			return nil
+		}
+
+		s.logger.Errorf("[%s] - REFUSED TO DOUBLE SIGN for target chain [%d]. Already signed authorisation message [%s], requested [%s].",
+			transferID, targetChainId, signed, encoded)
+		if s.doubleSignAttempts != nil {
+			s.doubleSignAttempts.Inc()
+		}
+		return service.ErrDoubleSign
+	}
+
+	line, err := json.Marshal(entry{
+		TransferId:    transferID,
+		TargetChainId: targetChainId,
+		Hash:          encoded,
+		SignedAt:      time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.file.Write(append(line, '\n'))
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to write to the signing journal. Error: [%s]", transferID, err)
+		return err
+	}
+	err = s.file.Sync()
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to sync the signing journal. Error: [%s]", transferID, err)
+		return err
+	}
+
+	s.hashes[k] = encoded
+	s.offset += int64(len(line) + 1)
+	return nil
+}
+
+// locked runs the function under an exclusive lock of the journal file, held against the other replicas
+func (s *Service) locked(f func() error) error {
+	fd := int(s.file.Fd())
+	err := syscall.Flock(fd, syscall.LOCK_EX)
+	if err != nil {
+		return fmt.Errorf("failed to lock the signing journal: %w", err)
+	}
+	defer syscall.Flock(fd, syscall.LOCK_UN)
+
+	return f()
+}
+
+// catchUp reads the entries appended to the journal since it was last read, e.g. by another replica
+func (s *Service) catchUp() error {
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() <= s.offset {
+		return nil
+	}
+
+	err = read(io.NewSectionReader(s.file, s.offset, info.Size()-s.offset), s.hashes)
+	if err != nil {
+		return err
+	}
+	s.offset = info.Size()
+	return nil
+}
+
+func load(r io.Reader) (map[string]string, error) {
+	hashes := make(map[string]string)
+	err := read(r, hashes)
+	if err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// read adds the entries of the journal to the given hashes, failing on entries conflicting with them
+func read(r io.Reader, hashes map[string]string) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var e entry
+		err := json.Unmarshal(scanner.Bytes(), &e)
+		if err != nil {
+			return fmt.Errorf("invalid entry on line [%d]: %w", line, err)
+		}
+
+		k := key(e.TransferId, e.TargetChainId)
+		if signed, exists := hashes[k]; exists && signed != e.Hash {
+			return fmt.Errorf("conflicting entries for transfer [%s] and target chain [%d]", e.TransferId, e.TargetChainId)
+		}
+		hashes[k] = e.Hash
+	}
+
+	return scanner.Err()
+}
+
+func key(transferID string, targetChainId uint64) string {
+	return fmt.Sprintf("%s-%d", transferID, targetChainId)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package signing_journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	transferId    = "0.0.123-1658483845-123456789"
+	targetChainId = uint64(80001)
+	hash          = []byte{1, 2, 3}
+	otherHash     = []byte{4, 5, 6}
+)
+
+func setup(t *testing.T) (*Service, config.SigningJournal) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	cfg := config.SigningJournal{Path: filepath.Join(t.TempDir(), "signing-journal.jsonl")}
+	s := NewService(cfg, mocks.MPrometheusService)
+	t.Cleanup(func() { s.file.Close() })
+	return s, cfg
+}
+
+func Test_Record(t *testing.T) {
+	s, _ := setup(t)
+
+	err := s.Record(transferId, targetChainId, hash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "010203", s.hashes[key(transferId, targetChainId)])
+}
+
+func Test_Record_SameHash(t *testing.T) {
+	s, _ := setup(t)
+	assert.Nil(t, s.Record(transferId, targetChainId, hash))
+
+	err := s.Record(transferId, targetChainId, hash)
+
+	assert.Nil(t, err)
+}
+
+func Test_Record_DifferentHash(t *testing.T) {
+	s, _ := setup(t)
+	assert.Nil(t, s.Record(transferId, targetChainId, hash))
+
+	err := s.Record(transferId, targetChainId, otherHash)
+
+	assert.Equal(t, service.ErrDoubleSign, err)
+}
+
+func Test_Record_OtherTargetChain(t *testing.T) {
+	s, _ := setup(t)
+	assert.Nil(t, s.Record(transferId, targetChainId, hash))
+
+	err := s.Record(transferId, 5, otherHash)
+
+	assert.Nil(t, err)
+}
+
+func Test_Record_PersistsAcrossRestarts(t *testing.T) {
+	s, cfg := setup(t)
+	assert.Nil(t, s.Record(transferId, targetChainId, hash))
+	s.file.Close()
+
+	reloaded := NewService(cfg, mocks.MPrometheusService)
+	defer reloaded.file.Close()
+
+	assert.Nil(t, reloaded.Record(transferId, targetChainId, hash))
+	assert.Equal(t, service.ErrDoubleSign, reloaded.Record(transferId, targetChainId, otherHash))
+	assert.Len(t, reloaded.hashes, 1)
+}
+
+func Test_Record_EntriesAppendedByAnotherReplica(t *testing.T) {
+	s, cfg := setup(t)
+	other := NewService(cfg, mocks.MPrometheusService)
+	defer other.file.Close()
+	assert.Nil(t, other.Record(transferId, targetChainId, hash))
+
+	err := s.Record(transferId, targetChainId, otherHash)
+
+	assert.Equal(t, service.ErrDoubleSign, err)
+	assert.Nil(t, s.Record("0.0.123-1658483845-987654321", targetChainId, hash))
+	assert.Nil(t, other.Record("0.0.123-1658483845-987654321", targetChainId, hash))
+	assert.Equal(t, service.ErrDoubleSign, other.Record("0.0.123-1658483845-987654321", targetChainId, otherHash))
+}
+
+func Test_Load_InvalidEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signing-journal.jsonl")
+	assert.Nil(t, os.WriteFile(path, []byte("{\"transferId\":"), 0600))
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	hashes, err := load(file)
+
+	assert.Nil(t, hashes)
+	assert.Error(t, err)
+}
+
+func Test_Load_ConflictingEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signing-journal.jsonl")
+	content := `{"transferId":"a","targetChainId":1,"hash":"01"}
+{"transferId":"a","targetChainId":1,"hash":"02"}
+`
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	hashes, err := load(file)
+
+	assert.Nil(t, hashes)
+	assert.Error(t, err)
+}
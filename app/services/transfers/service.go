@@ -17,15 +17,25 @@
 package transfers
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -61,8 +71,16 @@ type Service struct {
 	messageService     service.Messages
 	prometheusService  service.Prometheus
 	assetsService      service.Assets
+	evmClients         map[uint64]client.EVM
+	routerAbi          abi.ABI
 	topicID            hedera.TopicID
 	bridgeAccountID    hedera.AccountID
+	signatureBatch     config.SignatureBatch
+	batchMutex         sync.Mutex
+	batch              []*pendingSignature
+	batchTimer         *time.Timer
+	batchGeneration    uint64
+	batchCtx           context.Context
 }
 
 func NewService(
@@ -80,6 +98,8 @@ func NewService(
 	messageService service.Messages,
 	prometheusService service.Prometheus,
 	assetsService service.Assets,
+	evmClients map[uint64]client.EVM,
+	signatureBatch config.SignatureBatch,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
@@ -89,6 +109,10 @@ func NewService(
 	if e != nil {
 		log.Fatalf("Invalid BridgeAccountID [%s] - Error: [%s]", bridgeAccount, e)
 	}
+	routerAbi, e := abi.JSON(strings.NewReader(router.RouterABI))
+	if e != nil {
+		log.Fatalf("Failed to parse router ABI - Error: [%s]", e)
+	}
 
 	instance := &Service{
 		logger:             config.GetLoggerFor(fmt.Sprintf("Transfers Service")),
@@ -106,6 +130,10 @@ func NewService(
 		messageService:     messageService,
 		prometheusService:  prometheusService,
 		assetsService:      assetsService,
+		evmClients:         evmClients,
+		routerAbi:          routerAbi,
+		signatureBatch:     signatureBatch,
+		batchCtx:           context.Background(),
 	}
 
 	return instance
@@ -170,7 +198,7 @@ func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRev
 	return onSuccess, onRevert
 }
 
-func (ts *Service) ProcessNativeTransfer(tm payload.Transfer) error {
+func (ts *Service) ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error {
 	intAmount, err := strconv.ParseInt(tm.Amount, 10, 64)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", tm.TransactionId, err)
@@ -183,20 +211,23 @@ func (ts *Service) ProcessNativeTransfer(tm payload.Transfer) error {
 		remainder += fee - validFee
 	}
 
-	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
-
 	wrappedAmount := strconv.FormatInt(remainder, 10)
 
 	tm.Amount = wrappedAmount
 	signatureMessage, err := ts.messageService.SignFungibleMessage(tm)
 	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
+
+	return ts.SubmitSignatureMessage(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
+func (ts *Service) ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error {
 	ts.logger.Infof("[%s] - Sending NFT to bridge account.", tm.TransactionId)
 	status, wg, err := ts.transferNftToBridgeAccount(tm)
 	if err != nil {
@@ -211,15 +242,18 @@ func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
 		return errors.New("failed-scheduled-nft-transfer")
 	}
 
-	feePerValidator := ts.distributor.ValidAmount(tm.Fee)
-	go ts.processFeeTransfer(feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
-
 	signatureMessage, err := ts.messageService.SignNftMessage(tm)
 	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	feePerValidator := ts.distributor.ValidAmount(tm.Fee)
+	go ts.processFeeTransfer(feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
+
+	return ts.SubmitSignatureMessage(ctx, tm.TransactionId, signatureMessage)
 }
 
 func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *string, wg *sync.WaitGroup, err error) {
@@ -250,7 +284,7 @@ func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *stri
 	return status, wg, err
 }
 
-func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
+func (ts *Service) ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error {
 	amount, err := big_numbers.ToBigInt(tm.Amount)
 	if err != nil {
 		return err
@@ -279,7 +313,15 @@ func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
 
 statusBlocker:
 	for {
-		switch <-status {
+		var s string
+		select {
+		case s = <-status:
+		case <-ctx.Done():
+			ts.logger.Errorf("[%s] - Stopped awaiting the execution of Scheduled Burn Transaction. Error: [%s]", tm.TransactionId, ctx.Err())
+			return ctx.Err()
+		}
+
+		switch s {
 		case syncHelper.DONE:
 			ts.logger.Debugf("[%s] - Proceeding to sign and submit unlock permission messages.", tm.TransactionId)
 			break statusBlocker
@@ -291,10 +333,13 @@ statusBlocker:
 
 	signatureMessage, err := ts.messageService.SignFungibleMessage(tm)
 	if err != nil {
+		if errors.Is(err, service.ErrTransferHeld) {
+			return nil
+		}
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.SubmitSignatureMessage(ctx, tm.TransactionId, signatureMessage)
 }
 
 // TransferData returns from the database the given transfer, its signatures and
@@ -346,20 +391,9 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 	transferData.Majority = reachedMajority
 
 	if !t.IsNft {
-		signedAmount := t.Amount
-		if t.NativeChainID == constants.HederaNetworkId {
-			amount, err := strconv.ParseInt(t.Amount, 10, 64)
-			if err != nil {
-				ts.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", t.TransactionID, err)
-				return nil, err
-			}
-
-			feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
-			if err != nil {
-				ts.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", t.TransactionID, err)
-				return nil, err
-			}
-			signedAmount = strconv.FormatInt(amount-feeAmount, 10)
+		signedAmount, err := ts.signedAmount(t)
+		if err != nil {
+			return nil, err
 		}
 		return service.FungibleTransferData{
 			TransferData: transferData,
@@ -374,6 +408,162 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 	}, nil
 }
 
+// ClaimData returns the transaction claiming the given transfer on the router of its target chain.
+// Only the signatures of current members are included, one per signer, ordered by signer address
+func (ts *Service) ClaimData(txId string) (*service.ClaimData, error) {
+	t, err := ts.transferRepository.GetWithPreloads(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query Transfer with messages. Error: [%s].", txId, err)
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, service.ErrNotFound
+	}
+
+	if t.TargetChainID == constants.HederaNetworkId {
+		return nil, service.ErrBadRequestTransferTargetNetworkNoSignaturesRequired
+	}
+
+	if t.NativeChainID == constants.HederaNetworkId && t.Fee == "" {
+		return nil, service.ErrNotFound
+	}
+
+	contractService, ok := ts.contractServices[t.TargetChainID]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+	evmClient, ok := ts.evmClients[t.TargetChainID]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+
+	signatures, err := ts.claimSignatures(t, contractService)
+	if err != nil {
+		return nil, err
+	}
+
+	reachedMajority, err := contractService.HasValidSignaturesLength(big.NewInt(int64(len(signatures))))
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to check has valid signatures length. Error [%s]", t.TransactionID, err)
+		return nil, err
+	}
+	if !reachedMajority {
+		return nil, service.ErrMajorityNotReached
+	}
+
+	method, args, err := ts.claimArguments(t, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	calldata, err := ts.routerAbi.Pack(method, args...)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to encode [%s] calldata. Error [%s]", t.TransactionID, method, err)
+		return nil, err
+	}
+
+	routerAddress := contractService.Address()
+	gas, err := evmClient.EstimateGas(context.Background(), ethereum.CallMsg{
+		From: common.HexToAddress(t.Receiver),
+		To:   &routerAddress,
+		Data: calldata,
+	})
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to estimate gas of [%s]. Error [%s]", t.TransactionID, method, err)
+		return nil, err
+	}
+
+	return &service.ClaimData{
+		TransferId:    t.TransactionID,
+		ChainId:       t.TargetChainID,
+		RouterAddress: routerAddress.String(),
+		Method:        method,
+		Selector:      hexutil.Encode(ts.routerAbi.Methods[method].ID),
+		Calldata:      hexutil.Encode(calldata),
+		GasEstimate:   gas,
+	}, nil
+}
+
+// claimSignatures returns the signatures of the current members of the target router,
+// skipping repeated signers and ordered by signer address
+func (ts *Service) claimSignatures(t *entity.Transfer, contractService service.Contracts) ([][]byte, error) {
+	messages := make(map[common.Address]entity.Message)
+	for _, m := range t.Messages {
+		signer := common.HexToAddress(m.Signer)
+		if _, exists := messages[signer]; exists || !contractService.IsMember(signer.String()) {
+			continue
+		}
+		messages[signer] = m
+	}
+
+	signers := make([]common.Address, 0, len(messages))
+	for signer := range messages {
+		signers = append(signers, signer)
+	}
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i].Bytes(), signers[j].Bytes()) < 0
+	})
+
+	signatures := make([][]byte, 0, len(signers))
+	for _, signer := range signers {
+		signature, err := hex.DecodeString(messages[signer].Signature)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to decode signature of [%s]. Error [%s]", t.TransactionID, signer, err)
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
+// claimArguments returns the router method claiming the transfer and its arguments
+func (ts *Service) claimArguments(t *entity.Transfer, signatures [][]byte) (string, []interface{}, error) {
+	sourceChainId := new(big.Int).SetUint64(t.SourceChainID)
+	targetAsset := common.HexToAddress(t.TargetAsset)
+	receiver := common.HexToAddress(t.Receiver)
+
+	if t.IsNft {
+		return "mintERC721", []interface{}{sourceChainId, []byte(t.TransactionID), targetAsset, big.NewInt(t.SerialNumber), t.Metadata, receiver, signatures}, nil
+	}
+
+	signedAmount, err := ts.signedAmount(t)
+	if err != nil {
+		return "", nil, err
+	}
+	amount, ok := new(big.Int).SetString(signedAmount, 10)
+	if !ok {
+		ts.logger.Errorf("[%s] - Failed to parse signed amount [%s].", t.TransactionID, signedAmount)
+		return "", nil, fmt.Errorf("invalid amount [%s]", signedAmount)
+	}
+
+	if t.NativeChainID == t.TargetChainID {
+		return "unlock", []interface{}{sourceChainId, []byte(t.TransactionID), targetAsset, amount, receiver, signatures}, nil
+	}
+	return "mint", []interface{}{sourceChainId, []byte(t.TransactionID), targetAsset, receiver, amount, signatures}, nil
+}
+
+// signedAmount returns the amount of the authorisation message, which excludes the validator fees of Hedera native assets
+func (ts *Service) signedAmount(t *entity.Transfer) (string, error) {
+	if t.NativeChainID != constants.HederaNetworkId {
+		return t.Amount, nil
+	}
+
+	amount, err := strconv.ParseInt(t.Amount, 10, 64)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", t.TransactionID, err)
+		return "", err
+	}
+
+	feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", t.TransactionID, err)
+		return "", err
+	}
+	return strconv.FormatInt(amount-feeAmount, 10), nil
+}
+
 func (ts *Service) Paged(req *model.PagedRequest) (*model.Paged, error) {
 	items, count, err := ts.transferRepository.Paged(req)
 	if err != nil {
@@ -392,7 +582,7 @@ func (ts *Service) Paged(req *model.PagedRequest) (*model.Paged, error) {
 	}, nil
 }
 
-func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, signatureMessageBytes []byte) error {
+func (ts *Service) submitTopicMessageAndWaitForTransaction(ctx context.Context, transferID string, signatureMessageBytes []byte) error {
 	messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(
 		ts.topicID,
 		signatureMessageBytes)
@@ -404,7 +594,7 @@ func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, si
 	// Attach update callbacks on Signature HCS Message
 	ts.logger.Infof("[%s] - Submitted signature on Topic [%s]", transferID, ts.topicID)
 	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(transferID)
-	ts.mirrorNode.WaitForTransaction(hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	ts.mirrorNode.WaitForTransaction(ctx, hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
@@ -0,0 +1,192 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+)
+
+// maxSignatureBatchBytes keeps batches within a single topic message chunk, as chunked messages are not
+// reassembled by the topic watchers
+const maxSignatureBatchBytes = 1024
+
+// pendingSignature is a signature message awaiting the submission of its batch
+type pendingSignature struct {
+	ctx        context.Context
+	transferID string
+	message    *message.Message
+	done       chan error
+}
+
+// SubmitSignatureMessage submits the signature message of the given transfer to the bridge topic.
+// With signature batches enabled, the message gets submitted as part of a batch, and the call blocks until then.
+func (ts *Service) SubmitSignatureMessage(ctx context.Context, transferID string, signatureMessage []byte) error {
+	if !ts.signatureBatch.Enabled {
+		return ts.submitTopicMessageAndWaitForTransaction(ctx, transferID, signatureMessage)
+	}
+
+	msg, err := message.FromBytes(signatureMessage)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to decode Signature Message for batching. Error: [%s]", transferID, err)
+		return err
+	}
+
+	pending := &pendingSignature{ctx: ctx, transferID: transferID, message: msg, done: make(chan error, 1)}
+	ts.enqueueSignature(pending)
+
+	select {
+	case err = <-pending.done:
+		return err
+	case <-ctx.Done():
+		ts.dequeueSignature(pending)
+		return ctx.Err()
+	}
+}
+
+// UseLeaderContext bounds the submission of signature batches to the given leader context. A batch is shared by
+// several transfers, so it is not bound to the context of any of them
+func (ts *Service) UseLeaderContext(ctx context.Context) {
+	ts.batchMutex.Lock()
+	defer ts.batchMutex.Unlock()
+
+	ts.batchCtx = ctx
+}
+
+// enqueueSignature adds the signature to the current batch. The batch gets submitted once it reaches its max size,
+// once the signature does not fit into it or once its window elapses
+func (ts *Service) enqueueSignature(pending *pendingSignature) {
+	ts.batchMutex.Lock()
+	defer ts.batchMutex.Unlock()
+
+	if len(ts.batch) > 0 && !ts.fitsInBatch(pending) {
+		go ts.submitSignatureBatch(ts.takeBatch())
+	}
+
+	ts.batch = append(ts.batch, pending)
+	if len(ts.batch) >= ts.signatureBatch.MaxSize {
+		go ts.submitSignatureBatch(ts.takeBatch())
+		return
+	}
+
+	if len(ts.batch) == 1 {
+		generation := ts.batchGeneration
+		ts.batchTimer = time.AfterFunc(ts.signatureBatch.Window, func() {
+			ts.batchMutex.Lock()
+			if generation != ts.batchGeneration {
+				ts.batchMutex.Unlock()
+				return
+			}
+			batch := ts.takeBatch()
+			ts.batchMutex.Unlock()
+
+			ts.submitSignatureBatch(batch)
+		})
+	}
+}
+
+// dequeueSignature removes the signature of a cancelled submitter from the current batch, unless already taken
+func (ts *Service) dequeueSignature(pending *pendingSignature) {
+	ts.batchMutex.Lock()
+	defer ts.batchMutex.Unlock()
+
+	for i, p := range ts.batch {
+		if p == pending {
+			ts.batch = append(ts.batch[:i], ts.batch[i+1:]...)
+			break
+		}
+	}
+	if len(ts.batch) == 0 {
+		ts.takeBatch()
+	}
+}
+
+// fitsInBatch checks whether the current batch, extended with the given signature, fits into a single chunk
+func (ts *Service) fitsInBatch(pending *pendingSignature) bool {
+	messages := make([]*message.Message, 0, len(ts.batch)+1)
+	for _, p := range ts.batch {
+		messages = append(messages, p.message)
+	}
+	messages = append(messages, pending.message)
+
+	bytes, err := message.BatchToBytes(messages, ts.batch[0].message.Validator, time.Now())
+	return err == nil && len(bytes) <= maxSignatureBatchBytes
+}
+
+// takeBatch returns the current batch and starts a new one. Must be called with the batch mutex held
+func (ts *Service) takeBatch() []*pendingSignature {
+	if ts.batchTimer != nil {
+		ts.batchTimer.Stop()
+		ts.batchTimer = nil
+	}
+	batch := ts.batch
+	ts.batch = nil
+	ts.batchGeneration++
+	return batch
+}
+
+// submitSignatureBatch submits the given signatures as a single batch message and notifies their submitters.
+// Signatures of submitters cancelled in the meantime are left out
+func (ts *Service) submitSignatureBatch(batch []*pendingSignature) {
+	ts.batchMutex.Lock()
+	ctx := ts.batchCtx
+	ts.batchMutex.Unlock()
+
+	pending := make([]*pendingSignature, 0, len(batch))
+	transferIDs := make([]string, 0, len(batch))
+	messages := make([]*message.Message, 0, len(batch))
+	for _, p := range batch {
+		if p.ctx.Err() != nil {
+			continue
+		}
+		pending = append(pending, p)
+		transferIDs = append(transferIDs, p.transferID)
+		messages = append(messages, p.message)
+	}
+	if len(pending) == 0 {
+		return
+	}
+	batchID := strings.Join(transferIDs, ", ")
+
+	err := ts.submitBatch(ctx, batchID, messages)
+	for _, p := range pending {
+		p.done <- err
+	}
+}
+
+func (ts *Service) submitBatch(ctx context.Context, batchID string, messages []*message.Message) error {
+	bytes, err := message.BatchToBytes(messages, messages[0].Validator, time.Now())
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to encode Signature Batch to bytes. Error: [%s]", batchID, err)
+		return err
+	}
+
+	messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(ts.topicID, bytes)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to submit Signature Batch to Topic. Error: [%s]", batchID, err)
+		return err
+	}
+
+	ts.logger.Infof("[%s] - Submitted batch of [%d] signatures on Topic [%s]", batchID, len(messages), ts.topicID)
+	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(batchID)
+	ts.mirrorNode.WaitForTransaction(ctx, hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	return nil
+}
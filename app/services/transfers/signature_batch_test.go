@@ -0,0 +1,227 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	batchTopicID = hedera.TopicID{Topic: 1}
+	batchTxID    = hedera.TransactionIDGenerate(hedera.AccountID{Account: 2})
+)
+
+func setupBatching(batch config.SignatureBatch) *Service {
+	mocks.Setup()
+	return &Service{
+		logger:         config.GetLoggerFor("Transfers Service"),
+		hederaNode:     mocks.MHederaNodeClient,
+		mirrorNode:     mocks.MHederaMirrorClient,
+		topicID:        batchTopicID,
+		signatureBatch: batch,
+		batchCtx:       context.Background(),
+	}
+}
+
+func signatureMessageBytes(t *testing.T, transferID, signature string) []byte {
+	bytes, err := message.NewFungibleSignature(&proto.TopicEthSignatureMessage{
+		SourceChainId: 1,
+		TargetChainId: 2,
+		TransferID:    transferID,
+		Signature:     signature,
+	}).WithEnvelope("0xvalidator", time.Now()).ToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes
+}
+
+func submitConcurrently(ts *Service, transferIDs []string, contents [][]byte) []error {
+	errs := make([]error, len(transferIDs))
+	wg := sync.WaitGroup{}
+	for i := range transferIDs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = ts.SubmitSignatureMessage(context.Background(), transferIDs[i], contents[i])
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func submittedTransferIDs(t *testing.T, call mock.Call) []string {
+	messages, err := message.UnpackBytes(call.Arguments.Get(1).([]byte), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transferIDs := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		transferIDs = append(transferIDs, msg.GetFungibleSignatureMessage().TransferID)
+	}
+	return transferIDs
+}
+
+func Test_SubmitSignatureMessage_BatchingDisabled(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{})
+	content := signatureMessageBytes(t, "transfer-1", "signature-1")
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, content).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	err := ts.SubmitSignatureMessage(context.Background(), "transfer-1", content)
+
+	assert.Nil(t, err)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+}
+
+func Test_SubmitSignatureMessage_SubmitsFullBatch(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	errs := submitConcurrently(ts,
+		[]string{"transfer-1", "transfer-2"},
+		[][]byte{signatureMessageBytes(t, "transfer-1", "signature-1"), signatureMessageBytes(t, "transfer-2", "signature-2")})
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+	assert.ElementsMatch(t, []string{"transfer-1", "transfer-2"}, submittedTransferIDs(t, mocks.MHederaNodeClient.Calls[0]))
+}
+
+func Test_SubmitSignatureMessage_SubmitsBatchAfterWindow(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: 10 * time.Millisecond, MaxSize: 10})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	err := ts.SubmitSignatureMessage(context.Background(), "transfer-1", signatureMessageBytes(t, "transfer-1", "signature-1"))
+
+	assert.Nil(t, err)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+	assert.Equal(t, []string{"transfer-1"}, submittedTransferIDs(t, mocks.MHederaNodeClient.Calls[0]))
+}
+
+func Test_SubmitSignatureMessage_SplitsBatchExceedingChunk(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: 50 * time.Millisecond, MaxSize: 10})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	signature := strings.Repeat("a", 400)
+
+	errs := submitConcurrently(ts,
+		[]string{"transfer-1", "transfer-2", "transfer-3"},
+		[][]byte{
+			signatureMessageBytes(t, "transfer-1", signature),
+			signatureMessageBytes(t, "transfer-2", signature),
+			signatureMessageBytes(t, "transfer-3", signature),
+		})
+
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 2)
+	for _, call := range mocks.MHederaNodeClient.Calls {
+		assert.LessOrEqual(t, len(call.Arguments.Get(1).([]byte)), maxSignatureBatchBytes)
+	}
+}
+
+func Test_SubmitSignatureMessage_SubmissionFails(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, errors.New("some-error"))
+
+	errs := submitConcurrently(ts,
+		[]string{"transfer-1", "transfer-2"},
+		[][]byte{signatureMessageBytes(t, "transfer-1", "signature-1"), signatureMessageBytes(t, "transfer-2", "signature-2")})
+
+	assert.Error(t, errs[0])
+	assert.Error(t, errs[1])
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_SubmitSignatureMessage_InvalidMessage(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+
+	err := ts.SubmitSignatureMessage(context.Background(), "transfer-1", []byte{1, 2})
+
+	assert.Error(t, err)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+}
+
+func Test_SubmitSignatureMessage_CancelledLeavesBatch(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ts.SubmitSignatureMessage(ctx, "transfer-1", signatureMessageBytes(t, "transfer-1", "signature-1"))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, ts.batch)
+	assert.Nil(t, ts.batchTimer)
+
+	errs := submitConcurrently(ts,
+		[]string{"transfer-2", "transfer-3"},
+		[][]byte{signatureMessageBytes(t, "transfer-2", "signature-2"), signatureMessageBytes(t, "transfer-3", "signature-3")})
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+	assert.ElementsMatch(t, []string{"transfer-2", "transfer-3"}, submittedTransferIDs(t, mocks.MHederaNodeClient.Calls[0]))
+}
+
+func Test_SubmitSignatureBatch_SkipsCancelled(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	msg, _ := message.FromBytes(signatureMessageBytes(t, "transfer-1", "signature-1"))
+	cancelled := &pendingSignature{ctx: cancelledCtx, transferID: "transfer-1", message: msg, done: make(chan error, 1)}
+	msg, _ = message.FromBytes(signatureMessageBytes(t, "transfer-2", "signature-2"))
+	pending := &pendingSignature{ctx: context.Background(), transferID: "transfer-2", message: msg, done: make(chan error, 1)}
+
+	ts.submitSignatureBatch([]*pendingSignature{cancelled, pending})
+
+	assert.Nil(t, <-pending.done)
+	assert.Empty(t, cancelled.done)
+	assert.Equal(t, []string{"transfer-2"}, submittedTransferIDs(t, mocks.MHederaNodeClient.Calls[0]))
+}
+
+func Test_SubmitSignatureBatch_UsesLeaderContext(t *testing.T) {
+	ts := setupBatching(config.SignatureBatch{Enabled: true, Window: time.Hour, MaxSize: 2})
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts.UseLeaderContext(leaderCtx)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", batchTopicID, mock.Anything).Return(&batchTxID, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", leaderCtx, mock.Anything, mock.Anything, mock.Anything)
+
+	errs := submitConcurrently(ts,
+		[]string{"transfer-1", "transfer-2"},
+		[][]byte{signatureMessageBytes(t, "transfer-1", "signature-1"), signatureMessageBytes(t, "transfer-2", "signature-2")})
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForTransaction", leaderCtx, mock.Anything, mock.Anything, mock.Anything)
+}
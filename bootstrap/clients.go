@@ -29,7 +29,9 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/wtoken"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera"
 	mirrornode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hsm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	eventHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
@@ -47,22 +49,30 @@ type Clients struct {
 	RouterClients           map[uint64]client.DiamondRouter
 	EvmFungibleTokenClients map[uint64]map[string]client.EvmFungibleToken
 	EvmNFTClients           map[uint64]map[string]client.EvmNft
+	Hsm                     client.Hsm
 	ClientsConfig           config.Clients
+	health                  service.Health
 }
 
 // PrepareClients instantiates all the necessary clients for a validator node
-func PrepareClients(clientsCfg config.Clients, bridgeEvmsCfgs map[uint64]config.BridgeEvm, networks map[uint64]*parser.Network) *Clients {
-	EvmClients := InitEVMClients(clientsCfg, networks)
+func PrepareClients(clientsCfg config.Clients, bridgeEvmsCfgs map[uint64]config.BridgeEvm, networks map[uint64]*parser.Network, health service.Health) *Clients {
+	EvmClients := InitEVMClients(clientsCfg, networks, health)
+	var hsmClient client.Hsm
+	if clientsCfg.Hsm.Enabled() {
+		hsmClient = hsm.NewClient(clientsCfg.Hsm)
+	}
 	instance := &Clients{
-		HederaNode:              hedera.NewNodeClient(clientsCfg.Hedera),
-		MirrorNode:              mirrornode.NewClient(clientsCfg.MirrorNode),
+		HederaNode:              hedera.NewNodeClient(clientsCfg.Hedera, clientsCfg.MirrorNode.ClientAddress, hsmClient, health),
+		MirrorNode:              mirrornode.NewClient(clientsCfg.MirrorNode, health),
 		EvmClients:              EvmClients,
 		CoinGecko:               coin_gecko.NewClient(clientsCfg.CoinGecko),
 		CoinMarketCap:           coin_market_cap.NewClient(clientsCfg.CoinMarketCap),
 		RouterClients:           InitRouterClients(bridgeEvmsCfgs, EvmClients),
 		EvmFungibleTokenClients: InitEvmFungibleTokenClients(networks, EvmClients),
 		EvmNFTClients:           InitEvmNftClients(networks, EvmClients),
+		Hsm:                     hsmClient,
 		ClientsConfig:           clientsCfg,
+		health:                  health,
 	}
 
 	event.On(constants.EventBridgeConfigUpdate, event.ListenerFunc(func(e event.Event) error {
@@ -77,7 +87,7 @@ func bridgeCfgEventHandler(e event.Event, instance *Clients) error {
 	if err != nil {
 		return err
 	}
-	instance.EvmClients = InitEVMClients(instance.ClientsConfig, params.ParsedBridge.Networks)
+	instance.EvmClients = InitEVMClients(instance.ClientsConfig, params.ParsedBridge.Networks, instance.health)
 	evmFungibleTokenClients := InitEvmFungibleTokenClients(params.ParsedBridge.Networks, instance.EvmClients)
 	evmNFTClients := InitEvmNftClients(params.ParsedBridge.Networks, instance.EvmClients)
 	routerClients := InitRouterClients(params.Bridge.EVMs, instance.EvmClients)
@@ -112,14 +122,14 @@ func bridgeCfgEventHandler(e event.Event, instance *Clients) error {
 	return nil
 }
 
-func InitEVMClients(clientsCfg config.Clients, networks map[uint64]*parser.Network) map[uint64]client.EVM {
+func InitEVMClients(clientsCfg config.Clients, networks map[uint64]*parser.Network, health service.Health) map[uint64]client.EVM {
 	EVMClients := make(map[uint64]client.EVM)
 	for configChainId, ec := range clientsCfg.EvmPool {
 		network, ok := networks[configChainId]
 		if !ok || network.RouterContractAddress == "" {
 			continue
 		}
-		evmClient, e := evm.NewClientPool(ec, configChainId)
+		evmClient, e := evm.NewClientPool(ec, configChainId, health)
 		if e != nil {
 			log.Fatalf("[%d] - Failed to initialize EVM Client. Error: [%s]", configChainId, e)
 		}
@@ -19,11 +19,19 @@ package bootstrap
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	dead_letter "github.com/limechain/hedera-eth-bridge-validator/app/persistence/dead-letter"
+	evm_block "github.com/limechain/hedera-eth-bridge-validator/app/persistence/evm-block"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/fee"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/lock"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/peer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/policy"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 )
 
 // Repositories struct holding the referenced repositories
@@ -34,10 +42,16 @@ type Repositories struct {
 	Message        repository.Message
 	Fee            repository.Fee
 	Schedule       repository.Schedule
+	QueueMessage   repository.QueueMessage
+	DeadLetter     repository.DeadLetter
+	Lock           repository.Lock
+	EvmBlock       repository.EvmBlock
+	PolicyDecision repository.PolicyDecision
+	Peer           repository.Peer
 }
 
 // PrepareRepositories initialises connection to the Database and instantiates the repositories
-func PrepareRepositories(db database.Database) *Repositories {
+func PrepareRepositories(db database.Database, leaderElection config.LeaderElection) *Repositories {
 	connection := db.Connection()
 	return &Repositories{
 		TransferStatus: status.NewRepositoryForStatus(connection, status.Transfer),
@@ -46,5 +60,11 @@ func PrepareRepositories(db database.Database) *Repositories {
 		Message:        message.NewRepository(connection),
 		Fee:            fee.NewRepository(connection),
 		Schedule:       schedule.NewRepository(connection),
+		QueueMessage:   queue.NewRepository(connection),
+		DeadLetter:     dead_letter.NewRepository(connection),
+		Lock:           lock.NewAdvisoryLock(connection, constants.LeaderElectionLockKey, leaderElection.LeaseTime/3),
+		EvmBlock:       evm_block.NewRepository(connection),
+		PolicyDecision: policy.NewRepository(connection),
+		Peer:           peer.NewRepository(connection),
 	}
 }
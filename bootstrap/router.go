@@ -21,9 +21,14 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/assets"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/router/burn-event"
 	config_bridge "github.com/limechain/hedera-eth-bridge-validator/app/router/config-bridge"
+	dead_letters "github.com/limechain/hedera-eth-bridge-validator/app/router/dead-letters"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/fees"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/healthcheck"
+	held_transfers "github.com/limechain/hedera-eth-bridge-validator/app/router/held-transfers"
 	min_amounts "github.com/limechain/hedera-eth-bridge-validator/app/router/min-amounts"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/peers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/reprocess"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/signers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer-reset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/utils"
@@ -36,7 +41,7 @@ import (
 
 func InitializeAPIRouter(services *Services, bridgeConfig *parser.Bridge, nodeConfig config.Node) *apirouter.APIRouter {
 	apiRouter := apirouter.NewAPIRouter()
-	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter())
+	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter(services.Health))
 	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers))
 	apiRouter.AddV1Router(burn_event.Route, burn_event.NewRouter(services.BurnEvents))
 	apiRouter.AddV1Router(constants.PrometheusMetricsEndpoint, promhttp.Handler())
@@ -47,5 +52,10 @@ func InitializeAPIRouter(services *Services, bridgeConfig *parser.Bridge, nodeCo
 	apiRouter.AddV1Router(fees.Route, fees.NewRouter(services.Pricing))
 	apiRouter.AddV1Router(transfer_reset.Route, transfer_reset.NewRouter(services.transfers, services.Prometheus, nodeConfig))
 	apiRouter.AddV1Router(validator_version.Route, validator_version.NewRouter())
+	apiRouter.AddV1Router(dead_letters.Route, dead_letters.NewRouter(services.DeadLetters, nodeConfig))
+	apiRouter.AddV1Router(reprocess.Route, reprocess.NewRouter(services.Reprocess, nodeConfig))
+	apiRouter.AddV1Router(signers.Route, signers.NewRouter(services.Signers))
+	apiRouter.AddV1Router(held_transfers.Route, held_transfers.NewRouter(services.Policy, nodeConfig))
+	apiRouter.AddV1Router(peers.Route, peers.NewRouter(services.Peers))
 	return apiRouter
 }
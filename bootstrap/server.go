@@ -18,6 +18,7 @@ package bootstrap
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -39,6 +40,7 @@ import (
 	rthh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/transfer"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/bridge-config"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/peers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/price"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
@@ -75,7 +77,7 @@ func InitializeServerPairs(server *server.Server, services *Services, repositori
 	registerPrometheusWatcher(server, services, configuration, clients)
 
 	// Pricing Watcher
-	server.AddWatcher(price.NewWatcher(services.Pricing))
+	server.AddWatcher(price.NewWatcher(services.Pricing, services.Health))
 
 	// Bridge Config Watcher
 	registerBridgeConfigWatcher(server, services, parsedBridge.UseLocalConfig, bridgeCfgTopicId, parsedBridge.PollingInterval)
@@ -85,12 +87,12 @@ func registerBridgeConfigWatcher(s *server.Server, services *Services, useLocalC
 	if useLocalConfig {
 		log.Infoln("Using local bridge config. Skipping initialization of BridgeConfigWatcher ...")
 	} else {
-		s.AddWatcher(bridge_config.NewWatcher(services.BridgeConfig, bridgeCfgTopicId, pollingInterval))
+		s.AddWatcher(bridge_config.NewWatcher(services.BridgeConfig, bridgeCfgTopicId, pollingInterval, services.Health))
 	}
 }
 
 func registerTransferWatcher(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
-	server.AddWatcher(createTransferWatcher(
+	watcher := createTransferWatcher(
 		configuration,
 		services.transfers,
 		services.Assets,
@@ -98,16 +100,22 @@ func registerTransferWatcher(server *server.Server, services *Services, reposito
 		&repositories.TransferStatus,
 		services.ContractServices,
 		services.Prometheus,
-		services.Pricing))
+		services.Pricing,
+		services.Health)
+	server.AddWatcher(watcher)
+	services.Reprocess.Register(configuration.Bridge.Hedera.BridgeAccount, watcher)
 }
 
 func registerValidationServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
 	// Watcher - ConsensusTopic
-	server.AddWatcher(
-		createConsensusTopicWatcher(
-			configuration,
-			clients.MirrorNode,
-			repositories.MessageStatus))
+	watcher := createConsensusTopicWatcher(
+		configuration,
+		clients.MirrorNode,
+		clients.HederaNode,
+		repositories.MessageStatus,
+		services.Health)
+	server.AddWatcher(watcher)
+	services.Reprocess.Register(configuration.Bridge.TopicId, watcher)
 
 	// Handler - TopicMessageValidation
 	server.AddHandler(constants.TopicMessageValidation, mh.NewHandler(
@@ -117,19 +125,19 @@ func registerValidationServerPairs(server *server.Server, services *Services, re
 		services.ContractServices,
 		services.Messages,
 		services.Prometheus,
-		services.Assets))
+		services.Assets,
+		services.Relayer))
 }
 
 func registerTransferMessageHandlers(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
+	server.AddLeaderTask(services.transfers.UseLeaderContext)
+
 	// TopicMessageSubmission
 	server.AddHandler(constants.TopicMessageSubmission,
 		message_submission.NewHandler(
-			clients.HederaNode,
-			clients.MirrorNode,
 			services.transfers,
 			repositories.Transfer,
-			services.Messages,
-			configuration.Bridge.TopicId))
+			services.Messages))
 
 	// HederaMintHtsTransfer
 	server.AddHandler(constants.HederaMintHtsTransfer, mint_hts.NewHandler(services.LockEvents))
@@ -154,21 +162,27 @@ func registerEvmClients(server *server.Server, services *Services, repositories
 		dbIdentifier := fmt.Sprintf("%d-%s", chain, contractService.Address().String())
 		blacklisted := configuration.Bridge.BlacklistedAccounts
 
-		server.AddWatcher(
-			evm.NewWatcher(
-				repositories.TransferStatus,
-				contractService,
-				services.Prometheus,
-				services.Pricing,
-				evmClient,
-				services.Assets,
-				dbIdentifier,
-				configuration.Node.Clients.EvmPool[chain].StartBlock,
-				configuration.Node.Validator,
-				configuration.Node.Clients.EvmPool[chain].PollingInterval,
-				configuration.Node.Clients.EvmPool[chain].MaxLogsBlocks,
-				blacklisted,
-			))
+		watcher := evm.NewWatcher(
+			repositories.TransferStatus,
+			repositories.EvmBlock,
+			repositories.Transfer,
+			contractService,
+			services.Prometheus,
+			services.Pricing,
+			evmClient,
+			services.Assets,
+			dbIdentifier,
+			configuration.Node.Clients.EvmPool[chain].StartBlock,
+			configuration.Node.Validator,
+			configuration.Node.Clients.EvmPool[chain].PollingInterval,
+			configuration.Node.Clients.EvmPool[chain].MaxLogsBlocks,
+			configuration.Node.Clients.EvmPool[chain].MaxReorgDepth,
+			configuration.Node.Clients.EvmPool[chain].SubscribeLogs,
+			blacklisted,
+			services.Health,
+		)
+		server.AddWatcher(watcher)
+		services.Reprocess.Register(strconv.FormatUint(chain, 10), watcher)
 	}
 }
 
@@ -178,7 +192,8 @@ func registerAssetsWatcher(server *server.Server, services *Services, configurat
 		configuration,
 		clients.EvmFungibleTokenClients,
 		clients.EvmNFTClients,
-		services.Assets))
+		services.Assets,
+		services.Health))
 }
 
 func registerPrometheusWatcher(server *server.Server, services *Services, configuration *config.Config, clients *Clients) {
@@ -193,6 +208,7 @@ func registerPrometheusWatcher(server *server.Server, services *Services, config
 			clients.EvmFungibleTokenClients,
 			clients.EvmNFTClients,
 			services.Assets))
+		server.AddWatcher(peers.NewWatcher(services.Peers, dashboardPolling))
 	} else {
 		log.Infoln("Monitoring is disabled. No metrics will be added.")
 	}
@@ -205,7 +221,8 @@ func registerHederaNativeUnlockNftHandlers(server *server.Server, services *Serv
 		repositories.Transfer,
 		repositories.Schedule,
 		services.transfers,
-		services.Scheduled))
+		services.Scheduled,
+		services.Policy))
 
 	// ReadOnlyHederaUnlockNftTransfer
 	server.AddHandler(constants.ReadOnlyHederaUnlockNftTransfer, rnth.NewHandler(
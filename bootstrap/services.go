@@ -19,25 +19,37 @@ package bootstrap
 import (
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/assets"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/services/bridge-config"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/services/burn-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/contracts"
+	dead_letter "github.com/limechain/hedera-eth-bridge-validator/app/services/dead-letter"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/calculator"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/leader"
 	lock_event "github.com/limechain/hedera-eth-bridge-validator/app/services/lock-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/messages"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/peers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/policy"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/pricing"
 	prometheusServices "github.com/limechain/hedera-eth-bridge-validator/app/services/prometheus"
 	read_only "github.com/limechain/hedera-eth-bridge-validator/app/services/read-only"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/relayer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/reprocess"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/scheduled"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	hsmSigner "github.com/limechain/hedera-eth-bridge-validator/app/services/signer/hsm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/web3signer"
+	signing_journal "github.com/limechain/hedera-eth-bridge-validator/app/services/signing-journal"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/transfers"
 	utilsSvc "github.com/limechain/hedera-eth-bridge-validator/app/services/utils"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
 )
 
 type Services struct {
@@ -56,10 +68,18 @@ type Services struct {
 	Assets           service.Assets
 	Utils            service.Utils
 	BridgeConfig     service.BridgeConfig
+	DeadLetters      service.DeadLetters
+	Reprocess        service.Reprocess
+	Leader           service.Leader
+	Health           service.Health
+	Policy           service.Policy
+	Peers            service.Peers
+	// Relayer is nil unless the node is a validator running in relayer mode
+	Relayer service.Relayer
 }
 
 // PrepareServices instantiates all the necessary services with their required context and parameters
-func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Clients, repositories Repositories, parsedBridgeConfigTopicId hedera.TopicID) *Services {
+func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Clients, repositories Repositories, parsedBridgeConfigTopicId hedera.TopicID, queue qi.Queue, health service.Health) *Services {
 
 	bridgeCfgService := bridge_config.NewService(c, parsedBridge, clients.MirrorNode)
 	if !parsedBridge.UseLocalConfig {
@@ -86,11 +106,24 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 
 	for _, client := range clients.EvmClients {
 		chainId := client.GetChainID()
-		evmSigners[chainId] = evm.NewEVMSigner(client.GetPrivateKey())
 		evmConfig, ok := c.Bridge.EVMs[chainId]
 		if ok && evmConfig.RouterContractAddress != "" {
 			contractServices[chainId] = contracts.NewService(client, evmConfig.RouterContractAddress, clients.RouterClients[chainId])
 		}
+
+		signer := newEvmSigner(c.Node.Clients.EvmPool[chainId].Signer, client.GetPrivateKey(), clients.Hsm)
+		nextPrivateKey := c.Node.Clients.EvmPool[chainId].NextPrivateKey
+		if nextPrivateKey == "" {
+			evmSigners[chainId] = signer
+			continue
+		}
+		contractService, ok := contractServices[chainId]
+		if !ok {
+			log.Warnf("Next private key for chain [%d] is ignored, as there is no router contract configured.", chainId)
+			evmSigners[chainId] = signer
+			continue
+		}
+		evmSigners[chainId] = evm.NewDualKeySigner(signer, evm.NewEVMSigner(nextPrivateKey), contractService)
 	}
 
 	fees := calculator.New(c.Bridge.Hedera.FeePercentages)
@@ -98,6 +131,21 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 	scheduled := scheduled.New(c.Bridge.Hedera.PayerAccount, clients.HederaNode, clients.MirrorNode)
 
 	prometheus := prometheusServices.NewService(assetsService, c.Node.Monitoring.Enable)
+
+	pricingService := pricing.NewService(
+		c.Bridge,
+		assetsService,
+		clients.RouterClients,
+		clients.MirrorNode,
+		clients.CoinGecko,
+		clients.CoinMarketCap)
+
+	policyService := policy.NewService(c.Node.SigningPolicy, repositories.PolicyDecision, repositories.Transfer, assetsService, pricingService)
+
+	signingJournal := signing_journal.NewService(c.Node.SigningJournal, prometheus)
+
+	peersService := peers.NewService(c.Node.Peers, repositories.Peer, contractServices, prometheus)
+
 	messages := messages.NewService(
 		evmSigners,
 		contractServices,
@@ -106,7 +154,11 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		clients.MirrorNode,
 		clients.EvmClients,
 		c.Bridge.TopicId,
-		assetsService)
+		assetsService,
+		policyService,
+		signingJournal,
+		c.Node.TopicEnvelope,
+		peersService)
 
 	transfers := transfers.NewService(
 		clients.HederaNode,
@@ -122,7 +174,9 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		scheduled,
 		messages,
 		prometheus,
-		assetsService)
+		assetsService,
+		clients.EvmClients,
+		c.Node.SignatureBatch)
 
 	burnEvent := burn_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
@@ -133,7 +187,8 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		scheduled,
 		fees,
 		transfers,
-		prometheus)
+		prometheus,
+		policyService)
 
 	lockEvent := lock_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
@@ -141,20 +196,24 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		repositories.Schedule,
 		scheduled,
 		transfers,
-		prometheus)
+		prometheus,
+		policyService)
 
 	readOnly := read_only.New(clients.MirrorNode, repositories.Transfer, c.Node.Clients.MirrorNode.PollingInterval)
 
-	pricingService := pricing.NewService(
-		c.Bridge,
-		assetsService,
-		clients.RouterClients,
-		clients.MirrorNode,
-		clients.CoinGecko,
-		clients.CoinMarketCap)
-
 	utilsService := utilsSvc.New(clients.EvmClients, burnEvent)
 
+	deadLetters := dead_letter.NewService(repositories.DeadLetter, queue)
+
+	reprocessService := reprocess.NewService(repositories.Transfer, repositories.Message, queue)
+
+	leaderService := leader.NewService(repositories.Lock, c.Node.LeaderElection, prometheus)
+
+	var relayerService service.Relayer
+	if c.Node.Validator && c.Node.Relayer.Enabled {
+		relayerService = relayer.NewService(c.Node.Relayer, evmSigners, contractServices, clients.EvmClients, transfers, repositories.Transfer)
+	}
+
 	return &Services{
 		Signers:          evmSigners,
 		ContractServices: contractServices,
@@ -171,5 +230,26 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		Assets:           assetsService,
 		Utils:            utilsService,
 		BridgeConfig:     bridgeCfgService,
+		DeadLetters:      deadLetters,
+		Reprocess:        reprocessService,
+		Leader:           leaderService,
+		Health:           health,
+		Policy:           policyService,
+		Peers:            peersService,
+		Relayer:          relayerService,
+	}
+}
+
+func newEvmSigner(cfg config.EvmSigner, privateKey string, hsm client.Hsm) service.Signer {
+	switch cfg.Type {
+	case config.EvmSignerWeb3Signer:
+		return web3signer.NewSigner(cfg)
+	case config.EvmSignerHsm:
+		if hsm == nil {
+			log.Fatalf("EVM Signer [%s] requires HSM configuration.", config.EvmSignerHsm)
+		}
+		return hsmSigner.NewSigner(hsm, cfg.KeyLabel)
+	default:
+		return evm.NewEVMSigner(privateKey)
 	}
 }
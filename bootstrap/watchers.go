@@ -39,6 +39,7 @@ func createTransferWatcher(
 	contractServices map[uint64]service.Contracts,
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
+	healthService service.Health,
 ) *tw.Watcher {
 	account := configuration.Bridge.Hedera.BridgeAccount
 	blacklisted_accounts := configuration.Bridge.BlacklistedAccounts
@@ -57,20 +58,26 @@ func createTransferWatcher(
 		prometheusService,
 		pricingService,
 		blacklisted_accounts,
+		healthService,
 	)
 }
 
 func createConsensusTopicWatcher(configuration *config.Config,
 	client client.MirrorNode,
+	node client.HederaNode,
 	repository repository.Status,
+	healthService service.Health,
 ) *cmw.Watcher {
 	topic := configuration.Bridge.TopicId
 	log.Debugf("Added Topic Watcher for topic [%s]\n", topic)
 	return cmw.NewWatcher(client,
+		node,
 		topic,
 		repository,
 		configuration.Node.Clients.MirrorNode.PollingInterval,
-		configuration.Node.Clients.Hedera.StartTimestamp)
+		configuration.Node.Clients.Hedera.StartTimestamp,
+		configuration.Node.Clients.MirrorNode.StreamMessages,
+		healthService)
 }
 
 func createAssetsWatcher(
@@ -79,7 +86,7 @@ func createAssetsWatcher(
 	evmFungibleTokenClients map[uint64]map[string]client.EvmFungibleToken,
 	evmNonFungibleTokenClients map[uint64]map[string]client.EvmNft,
 	assetsService service.Assets,
-
+	healthService service.Health,
 ) *aw.Watcher {
 	log.Debugf("Added Assets Watcher")
 	return aw.NewWatcher(
@@ -87,7 +94,8 @@ func createAssetsWatcher(
 		configuration.Bridge,
 		evmFungibleTokenClients,
 		evmNonFungibleTokenClients,
-		assetsService)
+		assetsService,
+		healthService)
 }
 
 func createPrometheusWatcher(
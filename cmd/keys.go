@@ -0,0 +1,250 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+)
+
+const (
+	keysCommand = "keys"
+	keysUsage   = `Usage: node keys <command> [flags]
+
+Commands:
+  import   Encrypts a private key into a keystore file
+  export   Decrypts a keystore file into a private key file
+  inspect  Prints the address or the public key of a keystore file
+
+Run 'node keys <command> -h' for the flags of the command.`
+)
+
+// keyType holds the keystore functions of the supported key types
+type keyType struct {
+	encrypt func(privateKey, passphrase string) ([]byte, error)
+	decrypt func(content []byte, passphrase string) (string, error)
+	inspect func(content []byte, passphrase string) (string, error)
+}
+
+var keyTypes = map[string]keyType{
+	"evm":    {encrypt: keystore.EncryptEvm, decrypt: keystore.DecryptEvm, inspect: keystore.InspectEvm},
+	"hedera": {encrypt: keystore.EncryptHedera, decrypt: keystore.DecryptHedera, inspect: keystore.InspectHedera},
+}
+
+// keyFlags are the flags shared by all keys commands
+type keyFlags struct {
+	keyType        string
+	passphraseFile string
+	passphraseEnv  string
+}
+
+func (f *keyFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.keyType, "type", "", "Type of the key: evm or hedera")
+	flags.StringVar(&f.passphraseFile, "passphrase-file", "", "Path to the file holding the keystore passphrase")
+	flags.StringVar(&f.passphraseEnv, "passphrase-env", "", "Environment variable holding the keystore passphrase")
+}
+
+func (f *keyFlags) resolve() (keyType, string, error) {
+	kt, ok := keyTypes[f.keyType]
+	if !ok {
+		return keyType{}, "", fmt.Errorf("unsupported key type [%s]", f.keyType)
+	}
+	passphrase, err := keystore.ReadPassphrase(f.passphraseFile, f.passphraseEnv)
+	if err != nil {
+		return keyType{}, "", err
+	}
+
+	return kt, passphrase, nil
+}
+
+// runKeys executes the keys command with the given arguments, returning the exit code.
+// Private keys and passphrases are read from files or environment variables and are never printed
+func runKeys(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, keysUsage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "import":
+		err = importKey(args[1:], stdout, stderr)
+	case "export":
+		err = exportKey(args[1:], stdout, stderr)
+	case "inspect":
+		err = inspectKey(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintln(stderr, keysUsage)
+		return 2
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+func importKey(args []string, stdout, stderr io.Writer) error {
+	var common keyFlags
+	var privateKeyFile, privateKeyEnv, out string
+	flags := newFlagSet(keysCommand, "import", stderr)
+	common.register(flags)
+	flags.StringVar(&privateKeyFile, "private-key-file", "", "Path to the file holding the plaintext private key")
+	flags.StringVar(&privateKeyEnv, "private-key-env", "", "Environment variable holding the plaintext private key")
+	flags.StringVar(&out, "out", "", "Path of the keystore file to create")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if out == "" {
+		return errors.New("-out is required")
+	}
+
+	kt, passphrase, err := common.resolve()
+	if err != nil {
+		return err
+	}
+	privateKey, err := readPrivateKey(privateKeyFile, privateKeyEnv)
+	if err != nil {
+		return err
+	}
+	content, err := kt.encrypt(privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+	identity, err := kt.inspect(content, passphrase)
+	if err != nil {
+		return err
+	}
+	if err = writeNewFile(out, content); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Imported key [%s] into [%s].\n", identity, out)
+	return nil
+}
+
+func exportKey(args []string, stdout, stderr io.Writer) error {
+	var common keyFlags
+	var path, out string
+	flags := newFlagSet(keysCommand, "export", stderr)
+	common.register(flags)
+	flags.StringVar(&path, "keystore", "", "Path to the keystore file")
+	flags.StringVar(&out, "out", "", "Path of the plaintext private key file to create")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if out == "" {
+		return errors.New("-out is required")
+	}
+
+	kt, passphrase, err := common.resolve()
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	privateKey, err := kt.decrypt(content, passphrase)
+	if err != nil {
+		return err
+	}
+	identity, err := kt.inspect(content, passphrase)
+	if err != nil {
+		return err
+	}
+	if err = writeNewFile(out, []byte(privateKey+"\n")); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Exported key [%s] to [%s].\n", identity, out)
+	return nil
+}
+
+func inspectKey(args []string, stdout, stderr io.Writer) error {
+	var common keyFlags
+	var path string
+	flags := newFlagSet(keysCommand, "inspect", stderr)
+	common.register(flags)
+	flags.StringVar(&path, "keystore", "", "Path to the keystore file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	kt, passphrase, err := common.resolve()
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	identity, err := kt.inspect(content, passphrase)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Type: %s\nKey: %s\n", common.keyType, identity)
+	return nil
+}
+
+func newFlagSet(command, name string, output io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(fmt.Sprintf("%s %s", command, name), flag.ContinueOnError)
+	flags.SetOutput(output)
+	return flags
+}
+
+func readPrivateKey(file, env string) (string, error) {
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+	if env != "" {
+		if privateKey, ok := os.LookupEnv(env); ok {
+			return strings.TrimSpace(privateKey), nil
+		}
+		return "", fmt.Errorf("environment variable [%s] is not set", env)
+	}
+
+	return "", errors.New("-private-key-file or -private-key-env is required")
+}
+
+// writeNewFile writes the content to a file readable only by its owner, failing if the file exists
+func writeNewFile(path string, content []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
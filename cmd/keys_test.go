@@ -0,0 +1,96 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Keys_RoundTrip(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	dir := t.TempDir()
+	keystorePath := filepath.Join(dir, "operator.json")
+	exportPath := filepath.Join(dir, "operator.key")
+	t.Setenv("OPERATOR_KEY", privateKey.String())
+	t.Setenv("OPERATOR_PASSPHRASE", "some-passphrase")
+	common := []string{"-type", "hedera", "-passphrase-env", "OPERATOR_PASSPHRASE"}
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := runKeys(append([]string{"import", "-private-key-env", "OPERATOR_KEY", "-out", keystorePath}, common...), stdout, stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.Contains(t, stdout.String(), privateKey.PublicKey().String())
+
+	stdout.Reset()
+	code = runKeys(append([]string{"inspect", "-keystore", keystorePath}, common...), stdout, stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "Type: hedera\nKey: "+privateKey.PublicKey().String()+"\n", stdout.String())
+
+	stdout.Reset()
+	code = runKeys(append([]string{"export", "-keystore", keystorePath, "-out", exportPath}, common...), stdout, stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.NotContains(t, stdout.String(), privateKey.String())
+	exported, _ := os.ReadFile(exportPath)
+	assert.Equal(t, privateKey.String(), strings.TrimSpace(string(exported)))
+	info, _ := os.Stat(exportPath)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func Test_Keys_ExistingOutput(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	out := filepath.Join(t.TempDir(), "operator.json")
+	assert.Nil(t, os.WriteFile(out, []byte("existing"), 0600))
+	t.Setenv("OPERATOR_KEY", privateKey.String())
+	t.Setenv("OPERATOR_PASSPHRASE", "some-passphrase")
+
+	stderr := &bytes.Buffer{}
+	code := runKeys([]string{"import", "-type", "hedera", "-passphrase-env", "OPERATOR_PASSPHRASE", "-private-key-env", "OPERATOR_KEY", "-out", out}, &bytes.Buffer{}, stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "file exists")
+	content, _ := os.ReadFile(out)
+	assert.Equal(t, "existing", string(content))
+}
+
+func Test_Keys_WrongPassphrase(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	keystorePath := filepath.Join(t.TempDir(), "operator.json")
+	t.Setenv("OPERATOR_KEY", privateKey.String())
+	t.Setenv("OPERATOR_PASSPHRASE", "some-passphrase")
+	t.Setenv("WRONG_PASSPHRASE", "wrong-passphrase")
+	runKeys([]string{"import", "-type", "hedera", "-passphrase-env", "OPERATOR_PASSPHRASE", "-private-key-env", "OPERATOR_KEY", "-out", keystorePath}, &bytes.Buffer{}, &bytes.Buffer{})
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := runKeys([]string{"inspect", "-type", "hedera", "-passphrase-env", "WRONG_PASSPHRASE", "-keystore", keystorePath}, stdout, stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout.String())
+}
+
+func Test_Keys_Usage(t *testing.T) {
+	stderr := &bytes.Buffer{}
+
+	assert.Equal(t, 2, runKeys(nil, &bytes.Buffer{}, stderr))
+	assert.Equal(t, 2, runKeys([]string{"unknown"}, &bytes.Buffer{}, stderr))
+	assert.Contains(t, stderr.String(), keysUsage)
+}
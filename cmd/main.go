@@ -17,20 +17,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/recovery"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/health"
 	"github.com/limechain/hedera-eth-bridge-validator/bootstrap"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	_ "net/http/pprof"
+	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == keysCommand {
+		os.Exit(runKeys(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == migrateCommand {
+		os.Exit(runMigrate(os.Args[2:], connectMigrator, os.Stdout, os.Stderr))
+	}
+
 	// Config
 	configuration, parsedBridge, err := config.LoadConfig()
 	if err != nil {
@@ -40,18 +51,16 @@ func main() {
 	config.InitLogger(configuration.Node.LogLevel, configuration.Node.LogFormat)
 
 	// Prepare Clients
-	clients := bootstrap.PrepareClients(configuration.Node.Clients, configuration.Bridge.EVMs, parsedBridge.Networks)
-
-	// Prepare Node
-	server := server.NewServer()
+	healthService := health.NewService(configuration.Node.Health)
+	clients := bootstrap.PrepareClients(configuration.Node.Clients, configuration.Bridge.EVMs, parsedBridge.Networks, healthService)
 
 	var services *bootstrap.Services = nil
 	conn := persistence.NewPgConnector(configuration.Node.Database)
 	db := persistence.NewDatabase(conn)
-	db.Migrate()
+	db.VerifySchema()
 
 	// Prepare repositories
-	repositories := bootstrap.PrepareRepositories(db)
+	repositories := bootstrap.PrepareRepositories(db, configuration.Node.LeaderElection)
 
 	// Prepare Services
 	var parsedBridgeConfigTopicId hedera.TopicID
@@ -62,12 +71,21 @@ func main() {
 			panic(fmt.Sprintf("failed to parse bridge config topic id [%s]. Err: [%s]", parsedBridgeConfigTopicId, err))
 		}
 	}
-	services = bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, parsedBridgeConfigTopicId)
+	queue := persistent.NewQueue(repositories.QueueMessage)
+	services = bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, parsedBridgeConfigTopicId, queue, healthService)
+
+	// Prepare Node
+	server := server.NewServer(queue, configuration.Node.Handlers, services.Prometheus, services.DeadLetters, services.Leader)
 	bootstrap.InitializeServerPairs(server, services, repositories, clients, configuration, parsedBridge, parsedBridgeConfigTopicId)
 
 	apiRouter := bootstrap.InitializeAPIRouter(services, parsedBridge, configuration.Node)
 
-	executeRecovery(repositories.Fee, repositories.Schedule, clients.MirrorNode)
+	server.AddLeaderTask(func(ctx context.Context) {
+		executeRecovery(repositories.Fee, repositories.Schedule, clients.MirrorNode)
+	})
+	if services.Relayer != nil {
+		server.AddLeaderTask(services.Relayer.Resume)
+	}
 
 	// Start
 	server.Run(apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
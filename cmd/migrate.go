@@ -0,0 +1,173 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+const (
+	migrateCommand = "migrate"
+	migrateUsage   = `Usage: node migrate <command> [flags]
+
+Commands:
+  up      Applies all pending migrations
+  down    Rolls back the last applied migrations
+  status  Prints the applied and the pending migrations
+
+Run 'node migrate <command> -h' for the flags of the command.`
+)
+
+// runMigrate executes the migrate command with the given arguments, returning the exit code.
+// The migrator is created only after the arguments are parsed, connecting to the configured database
+func runMigrate(args []string, newMigrator func() (*migrations.Migrator, error), stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, migrateUsage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "up":
+		err = migrateUp(args[1:], newMigrator, stdout, stderr)
+	case "down":
+		err = migrateDown(args[1:], newMigrator, stdout, stderr)
+	case "status":
+		err = migrateStatus(args[1:], newMigrator, stdout, stderr)
+	default:
+		fmt.Fprintln(stderr, migrateUsage)
+		return 2
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+func migrateUp(args []string, newMigrator func() (*migrations.Migrator, error), stdout, stderr io.Writer) error {
+	flags := newFlagSet(migrateCommand, "up", stderr)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	migrator, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up()
+	for _, migration := range applied {
+		fmt.Fprintf(stdout, "Applied migration [%d] [%s].\n", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Schema is at version [%d].\n", migrator.Latest())
+	return nil
+}
+
+func migrateDown(args []string, newMigrator func() (*migrations.Migrator, error), stdout, stderr io.Writer) error {
+	var steps int
+	flags := newFlagSet(migrateCommand, "down", stderr)
+	flags.IntVar(&steps, "steps", 1, "Number of the last applied migrations to roll back")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if steps < 1 {
+		return errors.New("-steps must be at least 1")
+	}
+
+	migrator, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	rolledBack, err := migrator.Down(steps)
+	for _, migration := range rolledBack {
+		fmt.Fprintf(stdout, "Rolled back migration [%d] [%s].\n", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	version, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Schema is at version [%d].\n", version)
+	return nil
+}
+
+func migrateStatus(args []string, newMigrator func() (*migrations.Migrator, error), stdout, stderr io.Writer) error {
+	flags := newFlagSet(migrateCommand, "status", stderr)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	migrator, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	statuses, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	version, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Schema is at version [%d], the node expects version [%d].\n", version, migrator.Latest())
+	return nil
+}
+
+// connectMigrator creates a migrator for the database configured in node.yml
+func connectMigrator() (*migrations.Migrator, error) {
+	configuration, _, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	config.InitLogger(configuration.Node.LogLevel, configuration.Node.LogFormat)
+
+	db := persistence.NewDatabase(persistence.NewPgConnector(configuration.Node.Database))
+	return migrations.NewMigrator(db.Connection())
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Migrate_Usage(t *testing.T) {
+	for _, args := range [][]string{{}, {"unknown"}} {
+		stderr := &bytes.Buffer{}
+
+		code := runMigrate(args, failingMigrator, &bytes.Buffer{}, stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "Usage: node migrate")
+	}
+}
+
+func Test_Migrate_InvalidSteps(t *testing.T) {
+	stderr := &bytes.Buffer{}
+
+	code := runMigrate([]string{"down", "-steps", "0"}, failingMigrator, &bytes.Buffer{}, stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "-steps must be at least 1")
+}
+
+func Test_Migrate_ConnectionFails(t *testing.T) {
+	stderr := &bytes.Buffer{}
+
+	code := runMigrate([]string{"up"}, failingMigrator, &bytes.Buffer{}, stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "Error: some-error\n", stderr.String())
+}
+
+func Test_Migrate_Status(t *testing.T) {
+	mocks.Setup()
+	db, sqlMock, _ := helper.SetupSqlMock()
+	appliedAt := time.Unix(1700000000, 0).UTC()
+	for i := 0; i < 2; i++ {
+		helper.SqlMockPrepareExec(sqlMock, regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations"))
+		sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT version, name, applied_at FROM schema_migrations ORDER BY version")).
+			WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}).AddRow(uint64(1), "initial_schema", appliedAt))
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	code := runMigrate([]string{"status"}, func() (*migrations.Migrator, error) {
+		return migrations.NewMigrator(db)
+	}, stdout, stderr)
+
+	assert.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "VERSION  NAME                          APPLIED AT\n"+
+		"1        initial_schema                2023-11-14T22:13:20Z\n"+
+		"2        queue_message_dead_letter_id  pending\n"+
+		"Schema is at version [1], the node expects version [2].\n", stdout.String())
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func failingMigrator() (*migrations.Migrator, error) {
+	return nil, errors.New("some-error")
+}
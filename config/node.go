@@ -17,10 +17,13 @@
 package config
 
 import (
+	"os"
 	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -32,7 +35,17 @@ type Node struct {
 	Port               string
 	Validator          bool
 	Monitoring         Monitoring
+	Handlers           Handlers
+	LeaderElection     LeaderElection
+	Health             Health
+	SigningPolicy      SigningPolicy
+	SigningJournal     SigningJournal
+	Relayer            Relayer
+	TopicEnvelope      bool
+	SignatureBatch     SignatureBatch
+	Peers              Peers
 	GaugeResetPassword string
+	AdminApiKey        string
 }
 
 type Database struct {
@@ -49,6 +62,7 @@ type Clients struct {
 	MirrorNode    MirrorNode
 	CoinGecko     CoinGecko
 	CoinMarketCap CoinMarketCap
+	Hsm           Hsm
 }
 
 type Evm struct {
@@ -64,9 +78,94 @@ type EvmPool struct {
 	BlockConfirmations uint64
 	NodeUrls           []string
 	PrivateKey         string
+	NextPrivateKey     string
 	StartBlock         int64
 	PollingInterval    time.Duration
 	MaxLogsBlocks      int64
+	MaxReorgDepth      int64
+	SubscribeLogs      bool
+	Signer             EvmSigner
+}
+
+func (e *EvmPool) DefaultOrConfig(cfg *parser.EvmPool) *EvmPool {
+	e.BlockConfirmations = cfg.BlockConfirmations
+	e.NodeUrls = cfg.NodeUrls
+	e.PrivateKey = cfg.PrivateKey
+	if cfg.Keystore.Path != "" {
+		e.PrivateKey = decryptKeystore(cfg.Keystore, keystore.DecryptEvm)
+	}
+	e.NextPrivateKey = cfg.NextPrivateKey
+	if cfg.NextKeystore.Path != "" {
+		e.NextPrivateKey = decryptKeystore(cfg.NextKeystore, keystore.DecryptEvm)
+	}
+	e.StartBlock = cfg.StartBlock
+	e.PollingInterval = cfg.PollingInterval
+	e.MaxLogsBlocks = cfg.MaxLogsBlocks
+	e.MaxReorgDepth = cfg.MaxReorgDepth
+	e.SubscribeLogs = cfg.SubscribeLogs
+	e.Signer = *e.Signer.DefaultOrConfig(&cfg.Signer)
+
+	return e
+}
+
+// EvmSigner configures the backend holding the EVM key of the validator. The key is either
+// the local `PrivateKey` of the EvmPool or it is kept by a Web3Signer compatible signing service
+type EvmSigner struct {
+	Type           string
+	Url            string
+	PublicKey      string
+	RequestTimeout time.Duration
+	TlsCert        string
+	TlsKey         string
+	TlsCa          string
+	KeyLabel       string
+}
+
+const (
+	EvmSignerLocal      = "local"
+	EvmSignerWeb3Signer = "web3signer"
+	EvmSignerHsm        = "hsm"
+	// in seconds
+	defaultEvmSignerRequestTimeout = 10
+)
+
+func (s *EvmSigner) DefaultOrConfig(cfg *parser.EvmSigner) *EvmSigner {
+	s.Type = EvmSignerLocal
+	if cfg.Type != "" {
+		s.Type = cfg.Type
+	}
+
+	switch s.Type {
+	case EvmSignerLocal:
+	case EvmSignerWeb3Signer:
+		if cfg.Url == "" {
+			log.Fatalf("node configuration: EVM Signer Url is required for [%s]", EvmSignerWeb3Signer)
+		}
+		if cfg.PublicKey == "" {
+			log.Fatalf("node configuration: EVM Signer Public Key is required for [%s]", EvmSignerWeb3Signer)
+		}
+	case EvmSignerHsm:
+		if cfg.KeyLabel == "" {
+			log.Fatalf("node configuration: EVM Signer Key Label is required for [%s]", EvmSignerHsm)
+		}
+	default:
+		log.Fatalf("node configuration: unsupported EVM Signer Type [%s]", cfg.Type)
+	}
+
+	s.Url = cfg.Url
+	s.PublicKey = cfg.PublicKey
+	s.TlsCert = cfg.TlsCert
+	s.TlsKey = cfg.TlsKey
+	s.TlsCa = cfg.TlsCa
+	s.KeyLabel = cfg.KeyLabel
+
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultEvmSignerRequestTimeout
+	}
+	s.RequestTimeout = time.Duration(requestTimeout) * time.Second
+
+	return s
 }
 
 type Hedera struct {
@@ -77,9 +176,11 @@ type Hedera struct {
 	MaxRetry       int
 }
 
+// Operator signs with PrivateKey or, if KeyLabel is set, with the key of the HSM
 type Operator struct {
 	AccountId  string
 	PrivateKey string
+	KeyLabel   string
 }
 
 const (
@@ -91,8 +192,13 @@ func (h *Hedera) DefaultOrConfig(cfg *parser.Hedera) *Hedera {
 	if h.Operator.AccountId = cfg.Operator.AccountId; h.Operator.AccountId == "" {
 		log.Fatalf("node configuration: Hedera Operator Account ID is required")
 	}
-	if h.Operator.PrivateKey = cfg.Operator.PrivateKey; h.Operator.PrivateKey == "" {
-		log.Fatalf("node configuration: Hedera Operator Private Key is required")
+	h.Operator.KeyLabel = cfg.Operator.KeyLabel
+	h.Operator.PrivateKey = cfg.Operator.PrivateKey
+	if cfg.Operator.Keystore.Path != "" {
+		h.Operator.PrivateKey = decryptKeystore(cfg.Operator.Keystore, keystore.DecryptHedera)
+	}
+	if h.Operator.PrivateKey == "" && h.Operator.KeyLabel == "" {
+		log.Fatalf("node configuration: Hedera Operator Private Key or Key Label is required")
 	}
 
 	h.Rpc = parseRpc(cfg.Rpc)
@@ -110,6 +216,40 @@ func (h *Hedera) DefaultOrConfig(cfg *parser.Hedera) *Hedera {
 	return h
 }
 
+// Keystore //
+
+// decryptKeystore reads the key file and its passphrase and decrypts the private key with the given function
+func decryptKeystore(cfg parser.Keystore, decrypt func(content []byte, passphrase string) (string, error)) string {
+	passphrase, err := keystore.ReadPassphrase(cfg.PassphraseFile, cfg.PassphraseEnv)
+	if err != nil {
+		log.Fatalf("node configuration: failed to read passphrase of keystore [%s]. Error: [%s]", cfg.Path, err)
+	}
+	content, err := os.ReadFile(cfg.Path)
+	if err != nil {
+		log.Fatalf("node configuration: failed to read keystore [%s]. Error: [%s]", cfg.Path, err)
+	}
+	privateKey, err := decrypt(content, passphrase)
+	if err != nil {
+		log.Fatalf("node configuration: failed to decrypt keystore [%s]. Error: [%s]", cfg.Path, err)
+	}
+
+	return privateKey
+}
+
+// Hsm //
+
+// Hsm configures the PKCS#11 module and the token, which keep the keys referenced by their labels
+type Hsm struct {
+	ModulePath string
+	TokenLabel string
+	Pin        string
+}
+
+// Enabled returns whether a PKCS#11 module is configured
+func (h Hsm) Enabled() bool {
+	return h.ModulePath != ""
+}
+
 // CoinGecko //
 
 type CoinGecko struct {
@@ -133,6 +273,7 @@ type MirrorNode struct {
 	QueryDefaultLimit int64
 	RetryPolicy       RetryPolicy
 	RequestTimeout    int
+	StreamMessages    bool
 }
 
 const (
@@ -172,6 +313,7 @@ func (m *MirrorNode) DefaultOrConfig(cfg *parser.MirrorNode) *MirrorNode {
 		m.RequestTimeout = cfg.RequestTimeout
 	}
 
+	m.StreamMessages = cfg.StreamMessages
 	m.RetryPolicy = *m.RetryPolicy.DefaultOrConfig(&cfg.RetryPolicy)
 
 	return m
@@ -218,6 +360,324 @@ type Monitoring struct {
 	DashboardPolling time.Duration
 }
 
+// Handlers //
+
+type Handlers struct {
+	Workers         int
+	TopicWorkers    map[string]int
+	Buffer          int
+	ShutdownTimeout time.Duration
+}
+
+const (
+	defaultHandlerWorkers         = 10
+	defaultHandlerBuffer          = 1000
+	defaultHandlerShutdownTimeout = 30
+)
+
+func (h *Handlers) DefaultOrConfig(cfg *parser.Handlers) *Handlers {
+	if h.Workers = cfg.Workers; h.Workers <= 0 {
+		h.Workers = defaultHandlerWorkers
+	}
+
+	h.TopicWorkers = make(map[string]int)
+	for topic, workers := range cfg.TopicWorkers {
+		if workers <= 0 {
+			log.Fatalf("node configuration: Handler workers for topic [%s] must be positive", topic)
+		}
+		h.TopicWorkers[topic] = workers
+	}
+
+	if h.Buffer = cfg.Buffer; h.Buffer <= 0 {
+		h.Buffer = defaultHandlerBuffer
+	}
+
+	shutdownTimeout := cfg.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = defaultHandlerShutdownTimeout
+	}
+	h.ShutdownTimeout = time.Duration(shutdownTimeout) * time.Second
+
+	return h
+}
+
+// WorkersFor returns the size of the worker pool for the given handler topic
+func (h Handlers) WorkersFor(topic string) int {
+	if workers, ok := h.TopicWorkers[topic]; ok {
+		return workers
+	}
+	return h.Workers
+}
+
+// Leader Election //
+
+type LeaderElection struct {
+	Enable    bool
+	LeaseTime time.Duration
+}
+
+const defaultLeaseTime = 15
+
+func (l *LeaderElection) DefaultOrConfig(cfg *parser.LeaderElection) *LeaderElection {
+	l.Enable = cfg.Enable
+
+	leaseTime := cfg.LeaseTime
+	if leaseTime <= 0 {
+		leaseTime = defaultLeaseTime
+	}
+	l.LeaseTime = time.Duration(leaseTime) * time.Second
+
+	return l
+}
+
+// Health //
+
+type Health struct {
+	HeartbeatTimeout time.Duration
+	MaxErrorRate     float64
+}
+
+const (
+	defaultHeartbeatTimeout = 300
+	defaultMaxErrorRate     = 0.5
+)
+
+func (h *Health) DefaultOrConfig(cfg *parser.Health) *Health {
+	heartbeatTimeout := cfg.HeartbeatTimeout
+	if heartbeatTimeout <= 0 {
+		heartbeatTimeout = defaultHeartbeatTimeout
+	}
+	h.HeartbeatTimeout = time.Duration(heartbeatTimeout) * time.Second
+
+	if h.MaxErrorRate = cfg.MaxErrorRate; h.MaxErrorRate <= 0 || h.MaxErrorRate > 1 {
+		h.MaxErrorRate = defaultMaxErrorRate
+	}
+
+	return h
+}
+
+// Relayer //
+
+// Relayer configures the submission of the claim transactions of fungible transfers to EVM chains by the validators
+type Relayer struct {
+	Enabled bool
+	// Deadline is the time each validator has to get the transfer completed, before the next one takes over
+	Deadline time.Duration
+	// StuckTimeout is the time after which a pending transaction gets replaced with a higher gas price
+	StuckTimeout time.Duration
+	// GasPriceBump is the percentage by which the gas price of a stuck transaction is increased
+	GasPriceBump     uint64
+	MaxGasPriceBumps int
+}
+
+const (
+	defaultRelayerDeadline         = 300
+	defaultRelayerStuckTimeout     = 120
+	defaultRelayerGasPriceBump     = 20
+	minRelayerGasPriceBump         = 10
+	defaultRelayerMaxGasPriceBumps = 5
+)
+
+func (r *Relayer) DefaultOrConfig(cfg *parser.Relayer) *Relayer {
+	r.Enabled = cfg.Enabled
+
+	deadline := cfg.Deadline
+	if deadline <= 0 {
+		deadline = defaultRelayerDeadline
+	}
+	r.Deadline = time.Duration(deadline) * time.Second
+
+	stuckTimeout := cfg.StuckTimeout
+	if stuckTimeout <= 0 {
+		stuckTimeout = defaultRelayerStuckTimeout
+	}
+	r.StuckTimeout = time.Duration(stuckTimeout) * time.Second
+
+	gasPriceBump := cfg.GasPriceBump
+	if gasPriceBump <= 0 {
+		gasPriceBump = defaultRelayerGasPriceBump
+	}
+	if gasPriceBump < minRelayerGasPriceBump {
+		log.Fatalf("node configuration: relayer gas price bump must be at least [%d] percent in order to replace pending transactions", minRelayerGasPriceBump)
+	}
+	r.GasPriceBump = uint64(gasPriceBump)
+
+	if r.MaxGasPriceBumps = cfg.MaxGasPriceBumps; r.MaxGasPriceBumps <= 0 {
+		r.MaxGasPriceBumps = defaultRelayerMaxGasPriceBumps
+	}
+
+	return r
+}
+
+// Signature Batch //
+
+// SignatureBatch configures the accumulation of signature messages into batches, submitted as a single topic message
+type SignatureBatch struct {
+	Enabled bool
+	// Window is the max time a signature message waits for the batch to get filled
+	Window time.Duration
+	// MaxSize is the max number of signature messages in a batch
+	MaxSize int
+}
+
+const (
+	defaultSignatureBatchWindow  = 2000
+	defaultSignatureBatchMaxSize = 10
+)
+
+func (b *SignatureBatch) DefaultOrConfig(cfg *parser.SignatureBatch) *SignatureBatch {
+	b.Enabled = cfg.Enabled
+
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultSignatureBatchWindow
+	}
+	b.Window = time.Duration(window) * time.Millisecond
+
+	if b.MaxSize = cfg.MaxSize; b.MaxSize <= 0 {
+		b.MaxSize = defaultSignatureBatchMaxSize
+	}
+
+	return b
+}
+
+// Peers //
+
+// Peers configures the accountability report of the peer validators
+type Peers struct {
+	// Window is the period of transfers, covered by the report
+	Window time.Duration
+	// GracePeriod is the time peers have to sign a transfer, before it is counted as missed
+	GracePeriod time.Duration
+}
+
+const (
+	defaultPeersWindow      = 86400
+	defaultPeersGracePeriod = 600
+)
+
+func (p *Peers) DefaultOrConfig(cfg *parser.Peers) *Peers {
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultPeersWindow
+	}
+	p.Window = time.Duration(window) * time.Second
+
+	gracePeriod := cfg.GracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = defaultPeersGracePeriod
+	}
+	p.GracePeriod = time.Duration(gracePeriod) * time.Second
+
+	if p.GracePeriod >= p.Window {
+		log.Fatalf("node configuration: peers grace period must be shorter than the window")
+	}
+
+	return p
+}
+
+// Signing Journal //
+
+// SigningJournal is the local file recording every authorisation message signed by the node.
+// It must be kept on persistent storage, separate from the database.
+type SigningJournal struct {
+	Path string
+}
+
+const defaultSigningJournalPath = "signing-journal.jsonl"
+
+func (j *SigningJournal) DefaultOrConfig(cfg *parser.SigningJournal) *SigningJournal {
+	if j.Path = cfg.Path; j.Path == "" {
+		j.Path = defaultSigningJournalPath
+	}
+
+	return j
+}
+
+// Signing Policy //
+
+type SigningPolicy struct {
+	Enabled bool
+	// Assets are keyed by the ID of the target chain and the target asset
+	Assets     map[uint64]map[string]AssetPolicy
+	Routes     []RoutePolicy
+	Originator OriginatorPolicy
+}
+
+// AssetPolicy holds the limits of an asset on its target chain. A zero value means no limit.
+type AssetPolicy struct {
+	MaxAmount decimal.Decimal
+	MaxUsd    decimal.Decimal
+	HourlyCap decimal.Decimal
+	DailyCap  decimal.Decimal
+}
+
+// RoutePolicy holds the USD outflow caps from a source to a target chain. A zero value means no limit.
+type RoutePolicy struct {
+	SourceChainId uint64
+	TargetChainId uint64
+	HourlyUsdCap  decimal.Decimal
+	DailyUsdCap   decimal.Decimal
+}
+
+// OriginatorPolicy limits the number of transfers of a single originator within the window. Zero MaxTransfers means no limit.
+type OriginatorPolicy struct {
+	MaxTransfers uint64
+	Window       time.Duration
+}
+
+const defaultOriginatorWindow = 3600
+
+func (p *SigningPolicy) DefaultOrConfig(cfg *parser.SigningPolicy) *SigningPolicy {
+	p.Enabled = cfg.Enabled
+
+	if len(cfg.Assets) > 0 {
+		p.Assets = make(map[uint64]map[string]AssetPolicy)
+	}
+	for chainId, assets := range cfg.Assets {
+		p.Assets[chainId] = make(map[string]AssetPolicy)
+		for asset, limits := range assets {
+			p.Assets[chainId][asset] = AssetPolicy{
+				MaxAmount: parsePolicyLimit(limits.MaxAmount),
+				MaxUsd:    parsePolicyLimit(limits.MaxUsd),
+				HourlyCap: parsePolicyLimit(limits.HourlyCap),
+				DailyCap:  parsePolicyLimit(limits.DailyCap),
+			}
+		}
+	}
+
+	for _, route := range cfg.Routes {
+		p.Routes = append(p.Routes, RoutePolicy{
+			SourceChainId: route.SourceChainId,
+			TargetChainId: route.TargetChainId,
+			HourlyUsdCap:  parsePolicyLimit(route.HourlyUsdCap),
+			DailyUsdCap:   parsePolicyLimit(route.DailyUsdCap),
+		})
+	}
+
+	window := cfg.Originator.Window
+	if window <= 0 {
+		window = defaultOriginatorWindow
+	}
+	p.Originator = OriginatorPolicy{
+		MaxTransfers: cfg.Originator.MaxTransfers,
+		Window:       time.Duration(window) * time.Second,
+	}
+
+	return p
+}
+
+func parsePolicyLimit(value string) decimal.Decimal {
+	if value == "" {
+		return decimal.Zero
+	}
+	limit, err := decimal.NewFromString(value)
+	if err != nil || limit.IsNegative() {
+		log.Fatalf("Invalid signing policy limit [%s].", value)
+	}
+	return limit
+}
+
 type Recovery struct {
 	StartTimestamp int64
 	StartBlock     int64
@@ -237,6 +697,7 @@ func New(node parser.Node) Node {
 				ApiKey:     node.Clients.CoinMarketCap.ApiKey,
 				ApiAddress: node.Clients.CoinMarketCap.ApiAddress,
 			},
+			Hsm: Hsm(node.Clients.Hsm),
 		},
 		LogLevel:  node.LogLevel,
 		LogFormat: node.LogFormat,
@@ -246,11 +707,31 @@ func New(node parser.Node) Node {
 			Enable:           node.Monitoring.Enable,
 			DashboardPolling: node.Monitoring.DashboardPolling,
 		},
+		Handlers:           *new(Handlers).DefaultOrConfig(&node.Handlers),
+		LeaderElection:     *new(LeaderElection).DefaultOrConfig(&node.LeaderElection),
+		Health:             *new(Health).DefaultOrConfig(&node.Health),
+		SigningPolicy:      *new(SigningPolicy).DefaultOrConfig(&node.SigningPolicy),
+		SigningJournal:     *new(SigningJournal).DefaultOrConfig(&node.SigningJournal),
+		Relayer:            *new(Relayer).DefaultOrConfig(&node.Relayer),
+		TopicEnvelope:      node.TopicEnvelope,
+		SignatureBatch:     *new(SignatureBatch).DefaultOrConfig(&node.SignatureBatch),
+		Peers:              *new(Peers).DefaultOrConfig(&node.Peers),
 		GaugeResetPassword: node.GaugeResetPassword,
+		AdminApiKey:        node.AdminApiKey,
 	}
 
 	for key, value := range node.Clients.EvmPool {
-		config.Clients.EvmPool[key] = EvmPool(value)
+		config.Clients.EvmPool[key] = *new(EvmPool).DefaultOrConfig(&value)
+	}
+
+	if config.SignatureBatch.Enabled && !config.TopicEnvelope {
+		log.Fatalf("node configuration: signature batches require the topic envelope to be enabled")
+	}
+
+	// Replicas take over each other's signing, so the journal must be the same file for all of them,
+	// instead of the default path local to each process
+	if config.LeaderElection.Enable && node.SigningJournal.Path == "" {
+		log.Fatalf("node configuration: leader election requires an explicit signing journal path, shared by all replicas")
 	}
 
 	return config
@@ -17,9 +17,13 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/stretchr/testify/assert"
 )
@@ -85,6 +89,10 @@ func Test_New(t *testing.T) {
 					StartBlock:         0,
 					PollingInterval:    0,
 					MaxLogsBlocks:      0,
+					Signer: EvmSigner{
+						Type:           EvmSignerLocal,
+						RequestTimeout: defaultEvmSignerRequestTimeout * time.Second,
+					},
 				},
 			},
 			Hedera: Hedera{
@@ -119,6 +127,41 @@ func Test_New(t *testing.T) {
 			Enable:           false,
 			DashboardPolling: 0,
 		},
+		Handlers: Handlers{
+			Workers:         defaultHandlerWorkers,
+			TopicWorkers:    map[string]int{},
+			Buffer:          defaultHandlerBuffer,
+			ShutdownTimeout: defaultHandlerShutdownTimeout * time.Second,
+		},
+		LeaderElection: LeaderElection{
+			LeaseTime: defaultLeaseTime * time.Second,
+		},
+		Health: Health{
+			HeartbeatTimeout: defaultHeartbeatTimeout * time.Second,
+			MaxErrorRate:     defaultMaxErrorRate,
+		},
+		SigningPolicy: SigningPolicy{
+			Originator: OriginatorPolicy{
+				Window: defaultOriginatorWindow * time.Second,
+			},
+		},
+		SigningJournal: SigningJournal{
+			Path: defaultSigningJournalPath,
+		},
+		Relayer: Relayer{
+			Deadline:         defaultRelayerDeadline * time.Second,
+			StuckTimeout:     defaultRelayerStuckTimeout * time.Second,
+			GasPriceBump:     defaultRelayerGasPriceBump,
+			MaxGasPriceBumps: defaultRelayerMaxGasPriceBumps,
+		},
+		SignatureBatch: SignatureBatch{
+			Window:  defaultSignatureBatchWindow * time.Millisecond,
+			MaxSize: defaultSignatureBatchMaxSize,
+		},
+		Peers: Peers{
+			Window:      defaultPeersWindow * time.Second,
+			GracePeriod: defaultPeersGracePeriod * time.Second,
+		},
 	}
 
 	actual := New(in)
@@ -146,6 +189,129 @@ func Test_parseRpc(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func Test_Handlers_DefaultOrConfig(t *testing.T) {
+	actual := Handlers{}
+	actual.DefaultOrConfig(&parser.Handlers{
+		TopicWorkers: map[string]int{"topic": 3},
+	})
+
+	assert.Equal(t, defaultHandlerWorkers, actual.Workers)
+	assert.Equal(t, 3, actual.WorkersFor("topic"))
+	assert.Equal(t, defaultHandlerWorkers, actual.WorkersFor("other-topic"))
+	assert.Equal(t, defaultHandlerBuffer, actual.Buffer)
+	assert.Equal(t, defaultHandlerShutdownTimeout*time.Second, actual.ShutdownTimeout)
+}
+
+func Test_LeaderElection_DefaultOrConfig(t *testing.T) {
+	actual := LeaderElection{}
+	actual.DefaultOrConfig(&parser.LeaderElection{Enable: true})
+
+	assert.True(t, actual.Enable)
+	assert.Equal(t, defaultLeaseTime*time.Second, actual.LeaseTime)
+
+	actual.DefaultOrConfig(&parser.LeaderElection{LeaseTime: 30})
+
+	assert.False(t, actual.Enable)
+	assert.Equal(t, 30*time.Second, actual.LeaseTime)
+}
+
+func Test_Health_DefaultOrConfig(t *testing.T) {
+	actual := Health{}
+	actual.DefaultOrConfig(&parser.Health{MaxErrorRate: 2})
+
+	assert.Equal(t, defaultHeartbeatTimeout*time.Second, actual.HeartbeatTimeout)
+	assert.Equal(t, defaultMaxErrorRate, actual.MaxErrorRate)
+
+	actual.DefaultOrConfig(&parser.Health{HeartbeatTimeout: 60, MaxErrorRate: 0.2})
+
+	assert.Equal(t, time.Minute, actual.HeartbeatTimeout)
+	assert.Equal(t, 0.2, actual.MaxErrorRate)
+}
+
+func Test_Relayer_DefaultOrConfig(t *testing.T) {
+	actual := Relayer{}
+	actual.DefaultOrConfig(&parser.Relayer{Enabled: true})
+
+	assert.True(t, actual.Enabled)
+	assert.Equal(t, defaultRelayerDeadline*time.Second, actual.Deadline)
+	assert.Equal(t, defaultRelayerStuckTimeout*time.Second, actual.StuckTimeout)
+	assert.Equal(t, uint64(defaultRelayerGasPriceBump), actual.GasPriceBump)
+	assert.Equal(t, defaultRelayerMaxGasPriceBumps, actual.MaxGasPriceBumps)
+
+	actual.DefaultOrConfig(&parser.Relayer{Deadline: 60, StuckTimeout: 30, GasPriceBump: 15, MaxGasPriceBumps: 2})
+
+	assert.False(t, actual.Enabled)
+	assert.Equal(t, time.Minute, actual.Deadline)
+	assert.Equal(t, 30*time.Second, actual.StuckTimeout)
+	assert.Equal(t, uint64(15), actual.GasPriceBump)
+	assert.Equal(t, 2, actual.MaxGasPriceBumps)
+}
+
+func Test_SignatureBatch_DefaultOrConfig(t *testing.T) {
+	actual := SignatureBatch{}
+	actual.DefaultOrConfig(&parser.SignatureBatch{Enabled: true})
+
+	assert.True(t, actual.Enabled)
+	assert.Equal(t, defaultSignatureBatchWindow*time.Millisecond, actual.Window)
+	assert.Equal(t, defaultSignatureBatchMaxSize, actual.MaxSize)
+
+	actual.DefaultOrConfig(&parser.SignatureBatch{Window: 500, MaxSize: 3})
+
+	assert.False(t, actual.Enabled)
+	assert.Equal(t, 500*time.Millisecond, actual.Window)
+	assert.Equal(t, 3, actual.MaxSize)
+}
+
+func Test_Peers_DefaultOrConfig(t *testing.T) {
+	actual := Peers{}
+	actual.DefaultOrConfig(&parser.Peers{})
+
+	assert.Equal(t, defaultPeersWindow*time.Second, actual.Window)
+	assert.Equal(t, defaultPeersGracePeriod*time.Second, actual.GracePeriod)
+
+	actual.DefaultOrConfig(&parser.Peers{Window: 3600, GracePeriod: 60})
+
+	assert.Equal(t, time.Hour, actual.Window)
+	assert.Equal(t, time.Minute, actual.GracePeriod)
+}
+
+func Test_SigningJournal_DefaultOrConfig(t *testing.T) {
+	actual := SigningJournal{}
+	actual.DefaultOrConfig(&parser.SigningJournal{})
+
+	assert.Equal(t, defaultSigningJournalPath, actual.Path)
+
+	actual.DefaultOrConfig(&parser.SigningJournal{Path: "/var/lib/validator/journal.jsonl"})
+
+	assert.Equal(t, "/var/lib/validator/journal.jsonl", actual.Path)
+}
+
+func Test_SigningPolicy_DefaultOrConfig(t *testing.T) {
+	actual := SigningPolicy{}
+	actual.DefaultOrConfig(&parser.SigningPolicy{
+		Enabled: true,
+		Assets: map[uint64]map[string]parser.AssetPolicy{
+			80001: {
+				"0xasset": {MaxAmount: "1000.5", DailyCap: "20000"},
+			},
+		},
+		Routes: []parser.RoutePolicy{
+			{SourceChainId: 296, TargetChainId: 80001, HourlyUsdCap: "100000"},
+		},
+		Originator: parser.OriginatorPolicy{MaxTransfers: 5},
+	})
+
+	assert.True(t, actual.Enabled)
+	assert.Equal(t, "1000.5", actual.Assets[80001]["0xasset"].MaxAmount.String())
+	assert.True(t, actual.Assets[80001]["0xasset"].MaxUsd.IsZero())
+	assert.Equal(t, "20000", actual.Assets[80001]["0xasset"].DailyCap.String())
+	assert.Equal(t, uint64(296), actual.Routes[0].SourceChainId)
+	assert.Equal(t, "100000", actual.Routes[0].HourlyUsdCap.String())
+	assert.True(t, actual.Routes[0].DailyUsdCap.IsZero())
+	assert.Equal(t, uint64(5), actual.Originator.MaxTransfers)
+	assert.Equal(t, defaultOriginatorWindow*time.Second, actual.Originator.Window)
+}
+
 func Test_RetryPolicy_DefaultOrConfig(t *testing.T) {
 	expected := RetryPolicy{
 		MaxRetry:  defaultMaxRetry,
@@ -222,3 +388,68 @@ func Test_RetryPolicy_DefaultOrConfig_WithPassedMaxJitter(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func Test_EvmPool_DefaultOrConfig_WithKeystore(t *testing.T) {
+	privateKey := "7b2e9a4bbd0c5f4c6c7f8e28ef5a3b1c0a8f6fa2d6c5d3f7a0f1e9c0b2a4d6e8"
+	keyJson, err := keystore.EncryptEvm(privateKey, "some-passphrase")
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	assert.Nil(t, os.WriteFile(path, keyJson, 0600))
+	t.Setenv("EVM_KEYSTORE_PASSPHRASE", "some-passphrase")
+
+	actual := EvmPool{}
+	actual.DefaultOrConfig(&parser.EvmPool{
+		Keystore: parser.Keystore{
+			Path:          path,
+			PassphraseEnv: "EVM_KEYSTORE_PASSPHRASE",
+		},
+	})
+
+	assert.Equal(t, privateKey, actual.PrivateKey)
+}
+
+func Test_EvmPool_DefaultOrConfig_WithNextKeystore(t *testing.T) {
+	nextPrivateKey := "3c1d8f2a6b4e0c9d7a5f3e1b9c7d5a3f1e9b7c5d3a1f9e7c5b3d1a9f7e5c3b1d"
+	keyJson, err := keystore.EncryptEvm(nextPrivateKey, "some-passphrase")
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "next-keystore.json")
+	assert.Nil(t, os.WriteFile(path, keyJson, 0600))
+	t.Setenv("EVM_NEXT_KEYSTORE_PASSPHRASE", "some-passphrase")
+
+	actual := EvmPool{}
+	actual.DefaultOrConfig(&parser.EvmPool{
+		PrivateKey:     "some-private-key",
+		NextPrivateKey: "ignored-next-private-key",
+		NextKeystore: parser.Keystore{
+			Path:          path,
+			PassphraseEnv: "EVM_NEXT_KEYSTORE_PASSPHRASE",
+		},
+	})
+
+	assert.Equal(t, "some-private-key", actual.PrivateKey)
+	assert.Equal(t, nextPrivateKey, actual.NextPrivateKey)
+}
+
+func Test_Hedera_DefaultOrConfig_WithKeystore(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	content, err := keystore.EncryptHedera(privateKey.String(), "some-passphrase")
+	assert.Nil(t, err)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "operator.json")
+	passphraseFile := filepath.Join(dir, "passphrase")
+	assert.Nil(t, os.WriteFile(path, content, 0600))
+	assert.Nil(t, os.WriteFile(passphraseFile, []byte("some-passphrase\n"), 0600))
+
+	actual := Hedera{}
+	actual.DefaultOrConfig(&parser.Hedera{
+		Operator: parser.Operator{
+			AccountId: "0.0.1001",
+			Keystore: parser.Keystore{
+				Path:           path,
+				PassphraseFile: passphraseFile,
+			},
+		},
+	})
+
+	assert.Equal(t, privateKey.String(), actual.Operator.PrivateKey)
+}
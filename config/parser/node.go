@@ -22,15 +22,25 @@ import "time"
 Structs used to parse the node YAML configuration
 */
 type Node struct {
-	Database            Database   `yaml:"database"`
-	Clients             Clients    `yaml:"clients"`
-	LogLevel            string     `yaml:"log_level"`
-	LogFormat           string     `yaml:"log_format"`
-	Port                string     `yaml:"port"`
-	Validator           bool       `yaml:"validator"`
-	Monitoring          Monitoring `yaml:"monitoring"`
-	BridgeConfigTopicId Monitoring `yaml:"bridge_config_topic_id"`
-	GaugeResetPassword  string     `yaml:"gauge_reset_pass"`
+	Database            Database       `yaml:"database"`
+	Clients             Clients        `yaml:"clients"`
+	LogLevel            string         `yaml:"log_level"`
+	LogFormat           string         `yaml:"log_format"`
+	Port                string         `yaml:"port"`
+	Validator           bool           `yaml:"validator"`
+	Monitoring          Monitoring     `yaml:"monitoring"`
+	Handlers            Handlers       `yaml:"handlers"`
+	LeaderElection      LeaderElection `yaml:"leader_election"`
+	Health              Health         `yaml:"health"`
+	SigningPolicy       SigningPolicy  `yaml:"signing_policy"`
+	SigningJournal      SigningJournal `yaml:"signing_journal"`
+	Relayer             Relayer        `yaml:"relayer"`
+	TopicEnvelope       bool           `yaml:"topic_envelope"`
+	SignatureBatch      SignatureBatch `yaml:"signature_batch"`
+	Peers               Peers          `yaml:"peers"`
+	BridgeConfigTopicId Monitoring     `yaml:"bridge_config_topic_id"`
+	GaugeResetPassword  string         `yaml:"gauge_reset_pass"`
+	AdminApiKey         string         `yaml:"admin_api_key"`
 }
 
 type Database struct {
@@ -47,6 +57,7 @@ type Clients struct {
 	MirrorNode    MirrorNode         `yaml:"mirror_node"`
 	CoinGecko     CoinGecko          `yaml:"coingecko"`
 	CoinMarketCap CoinMarketCap      `yaml:"coin_market_cap"`
+	Hsm           Hsm                `yaml:"hsm"`
 }
 
 // Evm //
@@ -64,9 +75,26 @@ type EvmPool struct {
 	BlockConfirmations uint64        `yaml:"block_confirmations"`
 	NodeUrls           []string      `yaml:"node_url"`
 	PrivateKey         string        `yaml:"private_key"`
+	Keystore           Keystore      `yaml:"keystore"`
+	NextPrivateKey     string        `yaml:"next_private_key"`
+	NextKeystore       Keystore      `yaml:"next_keystore"`
 	StartBlock         int64         `yaml:"start_block"`
 	PollingInterval    time.Duration `yaml:"polling_interval"`
 	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	MaxReorgDepth      int64         `yaml:"max_reorg_depth"`
+	SubscribeLogs      bool          `yaml:"subscribe_logs"`
+	Signer             EvmSigner     `yaml:"signer"`
+}
+
+type EvmSigner struct {
+	Type           string `yaml:"type"`
+	Url            string `yaml:"url"`
+	PublicKey      string `yaml:"public_key"`
+	RequestTimeout int    `yaml:"request_timeout"`
+	TlsCert        string `yaml:"tls_cert"`
+	TlsKey         string `yaml:"tls_key"`
+	TlsCa          string `yaml:"tls_ca"`
+	KeyLabel       string `yaml:"key_label"`
 }
 
 // Hedera //
@@ -80,8 +108,10 @@ type Hedera struct {
 }
 
 type Operator struct {
-	AccountId  string `yaml:"account_id"`
-	PrivateKey string `yaml:"private_key"`
+	AccountId  string   `yaml:"account_id"`
+	PrivateKey string   `yaml:"private_key"`
+	Keystore   Keystore `yaml:"keystore"`
+	KeyLabel   string   `yaml:"key_label"`
 }
 
 // MirrorNode //
@@ -94,6 +124,7 @@ type MirrorNode struct {
 	QueryDefaultLimit int64         `yaml:"query_default_limit"`
 	RetryPolicy       RetryPolicy   `yaml:"retry_policy"`
 	RequestTimeout    int           `yaml:"request_timeout" default:"15"`
+	StreamMessages    bool          `yaml:"stream_messages"`
 }
 
 type RetryPolicy struct {
@@ -103,6 +134,23 @@ type RetryPolicy struct {
 	MaxJitter int `yaml:"max_jitter"`
 }
 
+// Keystore //
+
+// Keystore references an encrypted key file and the source of its passphrase
+type Keystore struct {
+	Path           string `yaml:"path"`
+	PassphraseFile string `yaml:"passphrase_file"`
+	PassphraseEnv  string `yaml:"passphrase_env"`
+}
+
+// Hsm //
+
+type Hsm struct {
+	ModulePath string `yaml:"module_path"`
+	TokenLabel string `yaml:"token_label"`
+	Pin        string `yaml:"pin" env:"VALIDATOR_HSM_PIN"`
+}
+
 // CoinGecko //
 
 type CoinGecko struct {
@@ -116,6 +164,75 @@ type CoinMarketCap struct {
 	ApiAddress string `yaml:"api_address" json:"apiAddress,omitempty"`
 }
 
+type Handlers struct {
+	Workers         int            `yaml:"workers"`
+	TopicWorkers    map[string]int `yaml:"topic_workers"`
+	Buffer          int            `yaml:"buffer"`
+	ShutdownTimeout int            `yaml:"shutdown_timeout"`
+}
+
+type LeaderElection struct {
+	Enable    bool `yaml:"enable"`
+	LeaseTime int  `yaml:"lease_time"`
+}
+
+type Health struct {
+	HeartbeatTimeout int     `yaml:"heartbeat_timeout"`
+	MaxErrorRate     float64 `yaml:"max_error_rate"`
+}
+
+type Relayer struct {
+	Enabled          bool `yaml:"enabled"`
+	Deadline         int  `yaml:"deadline"`
+	StuckTimeout     int  `yaml:"stuck_timeout"`
+	GasPriceBump     int  `yaml:"gas_price_bump"`
+	MaxGasPriceBumps int  `yaml:"max_gas_price_bumps"`
+}
+
+type SignatureBatch struct {
+	Enabled bool `yaml:"enabled"`
+	Window  int  `yaml:"window"`
+	MaxSize int  `yaml:"max_size"`
+}
+
+type Peers struct {
+	Window      int `yaml:"window"`
+	GracePeriod int `yaml:"grace_period"`
+}
+
+type SigningJournal struct {
+	Path string `yaml:"path"`
+}
+
+// SigningPolicy //
+
+type SigningPolicy struct {
+	Enabled    bool                              `yaml:"enabled"`
+	Assets     map[uint64]map[string]AssetPolicy `yaml:"assets"`
+	Routes     []RoutePolicy                     `yaml:"routes"`
+	Originator OriginatorPolicy                  `yaml:"originator"`
+}
+
+// AssetPolicy holds the limits of an asset on its target chain, in whole token units and in USD
+type AssetPolicy struct {
+	MaxAmount string `yaml:"max_amount"`
+	MaxUsd    string `yaml:"max_usd"`
+	HourlyCap string `yaml:"hourly_cap"`
+	DailyCap  string `yaml:"daily_cap"`
+}
+
+type RoutePolicy struct {
+	SourceChainId uint64 `yaml:"source_chain_id"`
+	TargetChainId uint64 `yaml:"target_chain_id"`
+	HourlyUsdCap  string `yaml:"hourly_usd_cap"`
+	DailyUsdCap   string `yaml:"daily_usd_cap"`
+}
+
+type OriginatorPolicy struct {
+	MaxTransfers uint64 `yaml:"max_transfers"`
+	Window       int    `yaml:"window"`
+}
+
 type Monitoring struct {
 	Enable           bool          `yaml:"enable"`
 	DashboardPolling time.Duration `yaml:"dashboard_polling"`
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package constants
+
+// Names of the components reported by the health endpoints
+const (
+	HealthMirrorNodeClient      = "mirror-node"
+	HealthHederaNodeClient      = "hedera-node"
+	HealthEvmClientFormat       = "evm-%d-%s"
+	HealthTransferWatcherFormat = "transfer-watcher-%s"
+	HealthMessageWatcherFormat  = "message-watcher-%s"
+	HealthEvmWatcherFormat      = "evm-watcher-%s"
+	HealthPriceWatcher          = "price-watcher"
+	HealthAssetsWatcher         = "assets-watcher"
+	HealthBridgeConfigWatcher   = "bridge-config-watcher"
+)
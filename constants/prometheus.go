@@ -62,6 +62,44 @@ const (
 	FeeTransferredHelp         = "Fee transferred to the bridge account."
 	UserGetHisTokensNameSuffix = "user_get_his_tokens"
 	UserGetHisTokensHelp       = "The user get his tokens after bridging."
+
+	// Handler Metrics //
+
+	HandlerTopicMetricLabelKey         = "topic"
+	HandlerQueueDepthGaugeNameFormat   = "handler_%s_queue_depth"
+	HandlerQueueDepthGaugeHelp         = "Number of messages waiting for a free worker of the handler."
+	HandlerInFlightGaugeNameFormat     = "handler_%s_in_flight"
+	HandlerInFlightGaugeHelp           = "Number of messages currently being handled."
+	HandlerDurationHistogramNameFormat = "handler_%s_duration_seconds"
+	HandlerDurationHistogramHelp       = "Time spent handling a single message."
+
+	// Leader Election Metrics //
+
+	LeaderGaugeName            = "leader"
+	LeaderGaugeHelp            = "Whether the node is the leader (1) or a standby replica (0)."
+	LeaderElectionsCounterName = "leader_elections"
+	LeaderElectionsCounterHelp = "Number of times the node acquired the leadership."
+
+	// EVM Watcher Metrics //
+
+	EvmReorgDepthGaugeNameFormat = "evm_%d_reorg_depth"
+	EvmReorgDepthGaugeHelp       = "Depth in blocks of the latest chain reorganisation detected by the EVM watcher."
+	EvmReorgsCounterNameFormat   = "evm_%d_reorgs"
+	EvmReorgsCounterHelp         = "Number of chain reorganisations detected by the EVM watcher."
+
+	// Signing Journal Metrics //
+
+	DoubleSignAttemptsCounterName = "double_sign_attempts"
+	DoubleSignAttemptsCounterHelp = "Number of refused attempts to sign different contents for an already signed transfer."
+
+	// Peer Metrics //
+
+	PeerRejectedMessagesCounterNameFormat = "peer_%s_rejected_%s"
+	PeerRejectedMessagesCounterHelp       = "Number of rejected topic messages of the peer validator with the given reason."
+	PeerMissedTransfersGaugeNameFormat    = "peer_%s_missed_transfers"
+	PeerMissedTransfersGaugeHelp          = "Number of transfers within the report window, except for held and reorged ones, which the peer validator has not signed."
+	PeerSigningLatencyGaugeNameFormat     = "peer_%s_signing_latency_seconds"
+	PeerSigningLatencyGaugeHelp           = "Average time between a transfer and the signature of the peer validator within the report window."
 )
 
 var (
@@ -235,7 +235,7 @@ func newClients(config Config) (*clients, error) {
 	EVM := make(map[uint64]evmSetup.Utils)
 	evmClients := make(map[uint64]client.EVM)
 	for configChainId, conf := range config.EVM {
-		evmClient := evm.NewClient(conf, configChainId)
+		evmClient := evm.NewClient(conf, configChainId, nil)
 		evmClients[configChainId] = evmClient
 
 		clientChainId, err := evmClient.ChainID(context.Background())
@@ -292,7 +292,7 @@ func newClients(config Config) (*clients, error) {
 
 	validatorClient := e2eClients.NewValidatorClient(config.ValidatorUrl)
 
-	mirrorNode := mirror_node.NewClient(config.Hedera.MirrorNode)
+	mirrorNode := mirror_node.NewClient(config.Hedera.MirrorNode, nil)
 
 	return &clients{
 		Hedera:          hederaClient,
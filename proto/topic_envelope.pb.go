@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.13.0
+// source: topic_envelope.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of the payload carried by the envelope
+type TopicMessageType int32
+
+const (
+	TopicMessageType_TOPIC_MESSAGE_TYPE_UNSPECIFIED        TopicMessageType = 0
+	TopicMessageType_TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE TopicMessageType = 1 // TopicEthSignatureMessage
+	TopicMessageType_TOPIC_MESSAGE_TYPE_NFT_SIGNATURE      TopicMessageType = 2 // TopicEthNftSignatureMessage
+	TopicMessageType_TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH    TopicMessageType = 3 // TopicEnvelopeBatch
+)
+
+// Enum value maps for TopicMessageType.
+var (
+	TopicMessageType_name = map[int32]string{
+		0: "TOPIC_MESSAGE_TYPE_UNSPECIFIED",
+		1: "TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE",
+		2: "TOPIC_MESSAGE_TYPE_NFT_SIGNATURE",
+		3: "TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH",
+	}
+	TopicMessageType_value = map[string]int32{
+		"TOPIC_MESSAGE_TYPE_UNSPECIFIED":        0,
+		"TOPIC_MESSAGE_TYPE_FUNGIBLE_SIGNATURE": 1,
+		"TOPIC_MESSAGE_TYPE_NFT_SIGNATURE":      2,
+		"TOPIC_MESSAGE_TYPE_SIGNATURE_BATCH":    3,
+	}
+)
+
+func (x TopicMessageType) Enum() *TopicMessageType {
+	p := new(TopicMessageType)
+	*p = x
+	return p
+}
+
+func (x TopicMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_topic_envelope_proto_enumTypes[0].Descriptor()
+}
+
+func (TopicMessageType) Type() protoreflect.EnumType {
+	return &file_topic_envelope_proto_enumTypes[0]
+}
+
+func (x TopicMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicMessageType.Descriptor instead.
+func (TopicMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_topic_envelope_proto_rawDescGZIP(), []int{0}
+}
+
+// Versioned message submitted to the bridge topic. The field numbers do not overlap with the ones of TopicMessage
+// and TopicEthSignatureMessage, so messages without a version are decoded in the legacy format.
+type TopicEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   uint32           `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`                       // Version of the protocol
+	Validator string           `protobuf:"bytes,17,opt,name=validator,proto3" json:"validator,omitempty"`                    // EVM address of the validator, which submitted the message
+	CreatedAt int64            `protobuf:"varint,18,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                   // Creation time of the message in nanoseconds since the epoch
+	Type      TopicMessageType `protobuf:"varint,19,opt,name=type,proto3,enum=proto.TopicMessageType" json:"type,omitempty"` // Type of the payload
+	Payload   []byte           `protobuf:"bytes,20,opt,name=payload,proto3" json:"payload,omitempty"`                        // The encoded message of the given type
+}
+
+func (x *TopicEnvelope) Reset() {
+	*x = TopicEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topic_envelope_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicEnvelope) ProtoMessage() {}
+
+func (x *TopicEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_topic_envelope_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicEnvelope.ProtoReflect.Descriptor instead.
+func (*TopicEnvelope) Descriptor() ([]byte, []int) {
+	return file_topic_envelope_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TopicEnvelope) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TopicEnvelope) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *TopicEnvelope) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TopicEnvelope) GetType() TopicMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return TopicMessageType_TOPIC_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *TopicEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Batch of signature messages, submitted as a single message to the bridge topic
+type TopicEnvelopeBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Envelopes []*TopicEnvelope `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"` // The batched messages. Each one is a signature message
+}
+
+func (x *TopicEnvelopeBatch) Reset() {
+	*x = TopicEnvelopeBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topic_envelope_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicEnvelopeBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicEnvelopeBatch) ProtoMessage() {}
+
+func (x *TopicEnvelopeBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_topic_envelope_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicEnvelopeBatch.ProtoReflect.Descriptor instead.
+func (*TopicEnvelopeBatch) Descriptor() ([]byte, []int) {
+	return file_topic_envelope_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TopicEnvelopeBatch) GetEnvelopes() []*TopicEnvelope {
+	if x != nil {
+		return x.Envelopes
+	}
+	return nil
+}
+
+var File_topic_envelope_proto protoreflect.FileDescriptor
+
+var file_topic_envelope_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x01,
+	0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x48, 0x0a, 0x12,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x2a, 0xaf, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x54,
+	0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x29, 0x0a, 0x25, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f,
+	0x50, 0x49, 0x43, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x46, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x12, 0x26, 0x0a, 0x22, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x68, 0x65, 0x64, 0x65, 0x72, 0x61, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_topic_envelope_proto_rawDescOnce sync.Once
+	file_topic_envelope_proto_rawDescData = file_topic_envelope_proto_rawDesc
+)
+
+func file_topic_envelope_proto_rawDescGZIP() []byte {
+	file_topic_envelope_proto_rawDescOnce.Do(func() {
+		file_topic_envelope_proto_rawDescData = protoimpl.X.CompressGZIP(file_topic_envelope_proto_rawDescData)
+	})
+	return file_topic_envelope_proto_rawDescData
+}
+
+var file_topic_envelope_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_topic_envelope_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_topic_envelope_proto_goTypes = []interface{}{
+	(TopicMessageType)(0),      // 0: proto.TopicMessageType
+	(*TopicEnvelope)(nil),      // 1: proto.TopicEnvelope
+	(*TopicEnvelopeBatch)(nil), // 2: proto.TopicEnvelopeBatch
+}
+var file_topic_envelope_proto_depIdxs = []int32{
+	0, // 0: proto.TopicEnvelope.type:type_name -> proto.TopicMessageType
+	1, // 1: proto.TopicEnvelopeBatch.envelopes:type_name -> proto.TopicEnvelope
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_topic_envelope_proto_init() }
+func file_topic_envelope_proto_init() {
+	if File_topic_envelope_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_topic_envelope_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topic_envelope_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicEnvelopeBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_topic_envelope_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_topic_envelope_proto_goTypes,
+		DependencyIndexes: file_topic_envelope_proto_depIdxs,
+		EnumInfos:         file_topic_envelope_proto_enumTypes,
+		MessageInfos:      file_topic_envelope_proto_msgTypes,
+	}.Build()
+	File_topic_envelope_proto = out.File
+	file_topic_envelope_proto_rawDesc = nil
+	file_topic_envelope_proto_goTypes = nil
+	file_topic_envelope_proto_depIdxs = nil
+}
@@ -92,7 +92,7 @@ func main() {
 		hederaNetworkId = HederaMainnetNetworkId
 	}
 
-	mirrorNodeClient := mirrorNode.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId])
+	mirrorNodeClient := mirrorNode.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId], nil)
 	extendedBridgeCfg := parseExtendedBridge(configPath)
 	updateAdditionalFieldsToCfg(extendedBridgeCfg, evmPrivateKey, mirrorNodeClient)
 	createOutputFile(extendedBridgeCfg)
@@ -124,7 +124,7 @@ func updateAdditionalFieldsToCfg(extendedBridgeCfg *parser.ExtendedBridge, evmPr
 				BlockConfirmations: evmBlockConfirmations,
 				NodeUrl:            nodeUrl,
 				PrivateKey:         *evmPrivateKey,
-			}, networkId)
+			}, networkId, nil)
 		}
 
 		// Fungible Tokens
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	signer "github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
 )
@@ -74,7 +75,7 @@ func main() {
 		Amount:        *amount,
 	}
 
-	authMsgHash, err := auth_message.EncodeFungibleBytesFrom(
+	authMsgData, err := auth_message.EncodeFungibleDataFrom(
 		tm.SourceChainId,
 		tm.TargetChainId,
 		tm.TransactionId,
@@ -86,7 +87,7 @@ func main() {
 		panic(err)
 	}
 
-	fmt.Println(hex.EncodeToString(authMsgHash))
+	fmt.Println(hex.EncodeToString(crypto.Keccak256(authMsgData)))
 
 	prKeysSlice := strings.Split(*privateKeys, ",")
 	var signers []*signer.Signer
@@ -100,7 +101,7 @@ func main() {
 	}
 
 	for _, s := range signers {
-		signature, err := s.Sign(authMsgHash)
+		signature, err := s.Sign(authMsgData)
 		if err != nil {
 			panic(err)
 		}
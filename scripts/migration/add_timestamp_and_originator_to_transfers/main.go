@@ -72,14 +72,14 @@ func main() {
 		evmClients[k] = evm.NewClient(validatorCfg.Evm{
 			NodeUrl:            v,
 			BlockConfirmations: 5,
-		}, k)
+		}, k, nil)
 	}
 
 	mnc := mirror_node.NewClient(validatorCfg.MirrorNode{
 		ClientAddress:   cfg.Hedera.MirrorNode.ClientAddress,
 		ApiAddress:      cfg.Hedera.MirrorNode.ApiAddress,
 		PollingInterval: cfg.Hedera.MirrorNode.PollingInterval,
-	})
+	}, nil)
 
 	migrator := newMigrator(nodes, evmClients, mnc)
 
@@ -84,7 +84,7 @@ func main() {
 		hederaNetworkId = HederaTestnetNetworkId
 	}
 
-	mirrorNodeClient := mirrorNode.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId])
+	mirrorNodeClient := mirrorNode.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId], nil)
 	membersSlice := strings.Split(*memberPrKeys, ",")
 
 	var custodianKey []hedera.PrivateKey
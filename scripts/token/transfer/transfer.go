@@ -178,7 +178,7 @@ func main() {
 		},
 	}
 
-	mirrorClient := mirror_node.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId])
+	mirrorClient := mirror_node.NewClient(mirrorNodeConfigByNetwork[hederaNetworkId], nil)
 
 	_, err = transferTokens(client, mirrorClient, parsedSenderAccountId, parsedRecipientAccountId, hederaTokenIDs, hbarAmountHedera)
 
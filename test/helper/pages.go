@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"strconv"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
+)
+
+// MakePages returns an iterator over the given pages
+func MakePages[T any](pages ...[]T) *pagination.Pages[T] {
+	if len(pages) == 0 {
+		pages = [][]T{{}}
+	}
+
+	return pagination.New("0", func(url string) ([]T, string, error) {
+		i, _ := strconv.Atoi(url)
+		next := ""
+		if i+1 < len(pages) {
+			next = strconv.Itoa(i + 1)
+		}
+		return pages[i], next, nil
+	})
+}
+
+// MakeFailingPages returns an iterator which fails to fetch its first page with the given error
+func MakeFailingPages[T any](err error) *pagination.Pages[T] {
+	return pagination.New("0", func(url string) ([]T, string, error) {
+		return nil, "", err
+	})
+}
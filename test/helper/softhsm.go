@@ -0,0 +1,149 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/miekg/pkcs11"
+)
+
+const (
+	SoftHsmSecp256k1KeyLabel = "secp256k1-key"
+	SoftHsmEd25519KeyLabel   = "ed25519-key"
+
+	softHsmTokenLabel = "validator"
+	softHsmSoPin      = "5678"
+	softHsmPin        = "1234"
+	// CKM_EC_EDWARDS_KEY_PAIR_GEN of PKCS#11 v3.0
+	ckmEcEdwardsKeyPairGen = 0x00001055
+)
+
+var softHsmModulePaths = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// SetupSoftHsm initialises a SoftHSM token in a temporary directory, holding a secp256k1 and an Ed25519 key pair.
+// The module is looked up in SOFTHSM2_MODULE and the default install paths. The test is skipped if it is missing
+func SetupSoftHsm(t *testing.T) config.Hsm {
+	modulePath := findSoftHsmModule()
+	if modulePath == "" {
+		t.Skip("SoftHSM module not found. Set SOFTHSM2_MODULE to run the test.")
+	}
+
+	tokenDir := t.TempDir()
+	configPath := filepath.Join(tokenDir, "softhsm2.conf")
+	content := fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)
+	if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SOFTHSM2_CONF", configPath)
+
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		t.Fatalf("failed to load SoftHSM module [%s]", modulePath)
+	}
+	defer ctx.Destroy()
+	must(t, ctx.Initialize())
+	defer ctx.Finalize()
+
+	slots, err := ctx.GetSlotList(false)
+	must(t, err)
+	must(t, ctx.InitToken(slots[0], softHsmSoPin, softHsmTokenLabel))
+
+	// SoftHSM assigns a new slot to the initialised token
+	slot := findSoftHsmSlot(t, ctx)
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	must(t, err)
+	defer ctx.CloseSession(session)
+
+	must(t, ctx.Login(session, pkcs11.CKU_SO, softHsmSoPin))
+	must(t, ctx.InitPIN(session, softHsmPin))
+	must(t, ctx.Logout(session))
+	must(t, ctx.Login(session, pkcs11.CKU_USER, softHsmPin))
+	defer ctx.Logout(session)
+
+	generateSoftHsmKeyPair(t, ctx, session, pkcs11.CKM_EC_KEY_PAIR_GEN, asn1.ObjectIdentifier{1, 3, 132, 0, 10}, SoftHsmSecp256k1KeyLabel)
+	generateSoftHsmKeyPair(t, ctx, session, ckmEcEdwardsKeyPairGen, asn1.ObjectIdentifier{1, 3, 101, 112}, SoftHsmEd25519KeyLabel)
+
+	return config.Hsm{
+		ModulePath: modulePath,
+		TokenLabel: softHsmTokenLabel,
+		Pin:        softHsmPin,
+	}
+}
+
+func generateSoftHsmKeyPair(t *testing.T, ctx *pkcs11.Ctx, session pkcs11.SessionHandle, mechanism uint, curve asn1.ObjectIdentifier, label string) {
+	params, err := asn1.Marshal(curve)
+	must(t, err)
+
+	public := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	private := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	_, _, err = ctx.GenerateKeyPair(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, public, private)
+	must(t, err)
+}
+
+func findSoftHsmSlot(t *testing.T, ctx *pkcs11.Ctx) uint {
+	slots, err := ctx.GetSlotList(true)
+	must(t, err)
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		must(t, err)
+		if strings.TrimSpace(info.Label) == softHsmTokenLabel {
+			return slot
+		}
+	}
+	t.Fatalf("SoftHSM token [%s] not found", softHsmTokenLabel)
+	return 0
+}
+
+func findSoftHsmModule() string {
+	if path := os.Getenv("SOFTHSM2_MODULE"); path != "" {
+		return path
+	}
+	for _, path := range softHsmModulePaths {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+func must(t *testing.T, err error) {
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -92,7 +92,8 @@ func (m *MockBridgeContract) ParseBurnERC721Log(log types.Log) (*router.RouterBu
 }
 
 func (m *MockBridgeContract) IsMember(address string) bool {
-	panic("implement me")
+	args := m.Called(address)
+	return args.Get(0).(bool)
 }
 
 func (m *MockBridgeContract) HasValidSignaturesLength(signaturesLength *big.Int) (bool, error) {
@@ -118,8 +118,8 @@ func (m *MockEVM) WaitForTransactionCallback(hex string, onSuccess, onRevert fun
 	m.Called(hex, onSuccess, onRevert, onError)
 }
 
-func (m *MockEVM) WaitForConfirmations(raw types.Log) error {
-	args := m.Called(raw)
+func (m *MockEVM) WaitForConfirmations(ctx context.Context, raw types.Log) error {
+	args := m.Called(ctx, raw)
 
 	if args.Get(0) == nil {
 		return nil
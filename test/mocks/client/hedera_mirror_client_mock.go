@@ -17,9 +17,12 @@
 package client
 
 import (
+	"context"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/shopspring/decimal"
@@ -135,6 +138,11 @@ func (m *MockHederaMirror) GetMessagesAfterTimestamp(topicId hedera.TopicID, fro
 	return args.Get(0).([]message.Message), args.Get(1).(error)
 }
 
+func (m *MockHederaMirror) IterateMessagesAfterTimestamp(topicId hedera.TopicID, from int64, limit int64) *pagination.Pages[message.Message] {
+	args := m.Called(topicId, from, limit)
+	return args.Get(0).(*pagination.Pages[message.Message])
+}
+
 func (m *MockHederaMirror) GetMessageBySequenceNumber(topicId hedera.TopicID, sequenceNumber int64) (*message.Message, error) {
 	args := m.Called(topicId, sequenceNumber)
 
@@ -153,8 +161,8 @@ func (m *MockHederaMirror) GetLatestMessages(topicId hedera.TopicID, limit int64
 	return args.Get(0).([]message.Message), args.Get(1).(error)
 }
 
-func (m *MockHederaMirror) WaitForTransaction(txId string, onSuccess, onFailure func()) {
-	m.Called(txId, onSuccess, onFailure)
+func (m *MockHederaMirror) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
+	m.Called(ctx, txId, onSuccess, onFailure)
 }
 
 func (m *MockHederaMirror) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, milestoneTimestamp int64) (*transaction.Response, error) {
@@ -166,6 +174,26 @@ func (m *MockHederaMirror) GetAccountCreditTransactionsAfterTimestamp(accountId
 	return args.Get(0).(*transaction.Response), args.Get(1).(error)
 }
 
+func (m *MockHederaMirror) IterateAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, milestoneTimestamp int64) *pagination.Pages[transaction.Transaction] {
+	args := m.Called(accountId, milestoneTimestamp)
+	return args.Get(0).(*pagination.Pages[transaction.Transaction])
+}
+
+func (m *MockHederaMirror) IterateAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	args := m.Called(accountId, from)
+	return args.Get(0).(*pagination.Pages[transaction.Transaction])
+}
+
+func (m *MockHederaMirror) IterateAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	args := m.Called(accountId, from)
+	return args.Get(0).(*pagination.Pages[transaction.Transaction])
+}
+
+func (m *MockHederaMirror) IterateAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) *pagination.Pages[transaction.Transaction] {
+	args := m.Called(accountId, from)
+	return args.Get(0).(*pagination.Pages[transaction.Transaction])
+}
+
 func (m *MockHederaMirror) GetStateProof(transactionID string) ([]byte, error) {
 	args := m.Called(transactionID)
 
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -115,3 +117,8 @@ func (m *MockHederaNode) SubmitScheduledNftApproveTransaction(payer hedera.Accou
 	}
 	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
 }
+
+func (m *MockHederaNode) SubscribeToTopic(topicId hedera.TopicID, startTime time.Time, onNext func(hedera.TopicMessage), onError func(error)) (hedera.SubscriptionHandle, error) {
+	args := m.Called(topicId, startTime, onNext, onError)
+	return args.Get(0).(hedera.SubscriptionHandle), args.Error(1)
+}
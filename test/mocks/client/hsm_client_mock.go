@@ -0,0 +1,48 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"crypto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHsmClient struct {
+	mock.Mock
+}
+
+func (m *MockHsmClient) SignECDSA(keyLabel string, digest []byte) ([]byte, error) {
+	args := m.Called(keyLabel, digest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockHsmClient) SignEdDSA(keyLabel string, message []byte) ([]byte, error) {
+	args := m.Called(keyLabel, message)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockHsmClient) PublicKey(keyLabel string) (crypto.PublicKey, error) {
+	args := m.Called(keyLabel)
+	return args.Get(0), args.Error(1)
+}
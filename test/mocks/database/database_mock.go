@@ -30,6 +30,6 @@ func (m *MockDatabase) Connection() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockDatabase) Migrate() {
+func (m *MockDatabase) VerifySchema() {
 	return
 }
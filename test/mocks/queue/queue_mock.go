@@ -17,6 +17,8 @@
 package queue
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/stretchr/testify/mock"
 )
@@ -33,3 +35,11 @@ func (m *MockQueue) Channel() chan *queue.Message {
 func (m *MockQueue) Push(message *queue.Message) {
 	m.Called(message)
 }
+
+func (m *MockQueue) Ack(message *queue.Message) {
+	m.Called(message)
+}
+
+func (m *MockQueue) Redeliver(ctx context.Context) {
+	m.Called(ctx)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package repository
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEvmBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockEvmBlockRepository) Create(entityID string, number int64, hash string) error {
+	args := m.Called(entityID, number, hash)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockEvmBlockRepository) Get(entityID string, number int64) (*entity.EvmBlock, error) {
+	args := m.Called(entityID, number)
+	if args.Get(1) == nil {
+		if args.Get(0) == nil {
+			return nil, nil
+		}
+		return args.Get(0).(*entity.EvmBlock), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockEvmBlockRepository) GetAll(entityID string) ([]*entity.EvmBlock, error) {
+	args := m.Called(entityID)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.EvmBlock), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockEvmBlockRepository) DeleteAfter(entityID string, number int64) error {
+	args := m.Called(entityID, number)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockEvmBlockRepository) DeleteBefore(entityID string, number int64) error {
+	args := m.Called(entityID, number)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
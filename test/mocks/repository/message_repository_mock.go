@@ -35,10 +35,10 @@ func (m *MockMessageRepository) Create(message *entity.Message) error {
 
 func (m *MockMessageRepository) Exist(transferID, signature, hash string) (bool, error) {
 	args := m.Called(transferID, signature, hash)
-	if args[0] == nil {
+	if args[1] == nil {
 		return args[0].(bool), nil
 	}
-	return args[0].(bool), args[0].(error)
+	return args[0].(bool), args[1].(error)
 }
 
 func (m *MockMessageRepository) Get(transferID string) ([]entity.Message, error) {
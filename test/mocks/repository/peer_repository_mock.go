@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPeerRepository struct {
+	mock.Mock
+}
+
+func (m *MockPeerRepository) CreateRejection(rejection *entity.PeerRejection) error {
+	args := m.Called(rejection)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockPeerRepository) GetRejectionsSince(since time.Time) ([]*entity.PeerRejection, error) {
+	args := m.Called(since)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.PeerRejection), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockPeerRepository) GetSigningStatsSince(since int64) ([]*entity.PeerSigningStats, error) {
+	args := m.Called(since)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.PeerSigningStats), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockPeerRepository) CountMissed(signer string, targetChainId uint64, from, to int64) (int64, error) {
+	args := m.Called(signer, targetChainId, from, to)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
@@ -87,3 +87,35 @@ func (m *MockTransferRepository) UpdateStatusCompleted(txId string) error {
 func (m *MockTransferRepository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error) {
 	panic("implement me")
 }
+
+func (m *MockTransferRepository) UpdateStatusReorged(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusHeld(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetBySourceChainFromTimestamp(sourceChainId uint64, timestamp int64) ([]*entity.Transfer, error) {
+	args := m.Called(sourceChainId, timestamp)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetUncompletedSigned() ([]*entity.Transfer, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
@@ -36,6 +36,10 @@ func (m *MockBurnService) TransactionID(id string) (string, error) {
 	return args[0].(string), args[1].(error)
 }
 
-func (m *MockBurnService) ProcessEvent(event payload.Transfer) {
-	m.Called(event)
+func (m *MockBurnService) ProcessEvent(event payload.Transfer) error {
+	args := m.Called(event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	deadLetterModel "github.com/limechain/hedera-eth-bridge-validator/app/model/dead-letter"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDeadLettersService struct {
+	mock.Mock
+}
+
+func (m *MockDeadLettersService) Capture(message *queue.Message, err error) {
+	m.Called(message, err)
+}
+
+func (m *MockDeadLettersService) Resolve(message *queue.Message) {
+	m.Called(message)
+}
+
+func (m *MockDeadLettersService) DeadLetters() ([]*deadLetterModel.DeadLetter, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*deadLetterModel.DeadLetter), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockDeadLettersService) DeadLetter(id uint64) (*deadLetterModel.DeadLetter, error) {
+	args := m.Called(id)
+	if args.Get(1) == nil {
+		return args.Get(0).(*deadLetterModel.DeadLetter), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockDeadLettersService) Replay(id uint64) error {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
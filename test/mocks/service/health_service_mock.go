@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHealthService struct {
+	mock.Mock
+}
+
+func (m *MockHealthService) RegisterWatcher(watcher string, interval time.Duration) {
+	m.Called(watcher, interval)
+}
+
+func (m *MockHealthService) Heartbeat(watcher string, err error) {
+	m.Called(watcher, err)
+}
+
+func (m *MockHealthService) Progress(watcher string, position, lag int64) {
+	m.Called(watcher, position, lag)
+}
+
+func (m *MockHealthService) ReportCall(client string, err error) {
+	m.Called(client, err)
+}
+
+func (m *MockHealthService) Liveness() *health.Status {
+	args := m.Called()
+	return args.Get(0).(*health.Status)
+}
+
+func (m *MockHealthService) Readiness() *health.Status {
+	args := m.Called()
+	return args.Get(0).(*health.Status)
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLeaderService struct {
+	mock.Mock
+}
+
+func (m *MockLeaderService) Campaign(ctx context.Context) (context.Context, error) {
+	args := m.Called(ctx)
+	if args.Get(1) == nil {
+		return args.Get(0).(context.Context), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockLeaderService) Resign() {
+	m.Called()
+}
@@ -25,6 +25,10 @@ type MockLockService struct {
 	mock.Mock
 }
 
-func (m *MockLockService) ProcessEvent(event payload.Transfer) {
-	m.Called(event)
+func (m *MockLockService) ProcessEvent(event payload.Transfer) error {
+	args := m.Called(event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
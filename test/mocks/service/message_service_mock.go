@@ -63,8 +63,8 @@ func (m *MockMessageService) SanityCheckNftSignature(tm *proto.TopicEthNftSignat
 }
 
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-func (m *MockMessageService) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
-	args := m.Called(transferID, signature, targetChainId, timestamp, authMsg)
+func (m *MockMessageService) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte, validator string) error {
+	args := m.Called(transferID, signature, targetChainId, timestamp, authMsg, validator)
 	if args[0] == nil {
 		return nil
 	}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/peer"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPeersService struct {
+	mock.Mock
+}
+
+func (m *MockPeersService) Reject(transferID, signer, signature, reason string) {
+	m.Called(transferID, signer, signature, reason)
+}
+
+func (m *MockPeersService) Report() (*peer.Report, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(*peer.Report), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockPeersService) Rejections(address string) ([]*peer.Rejection, error) {
+	args := m.Called(address)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*peer.Rejection), nil
+	}
+	return nil, args.Get(1).(error)
+}
@@ -64,6 +64,25 @@ func (mps *MockPrometheusService) GetCounter(name string) prometheus.Counter {
 	return result
 }
 
+// CreateHistogramIfNotExists creates new Histogram Metric and registers it in Prometheus if not exists
+func (mps *MockPrometheusService) CreateHistogramIfNotExists(opts prometheus.HistogramOpts) prometheus.Histogram {
+	args := mps.Called(opts)
+	result := args.Get(0).(prometheus.Histogram)
+	return result
+}
+
+// GetHistogram retrieves Histogram by name
+func (mps *MockPrometheusService) GetHistogram(name string) prometheus.Histogram {
+	args := mps.Called(name)
+	result := args.Get(0).(prometheus.Histogram)
+	return result
+}
+
+// DeleteHistogram unregisters and deletes Histogram with the passed name
+func (mps *MockPrometheusService) DeleteHistogram(name string) {
+	_ = mps.Called(name)
+}
+
 // ConstructMetricName constructing name for metric
 func (mps *MockPrometheusService) ConstructMetricName(sourceNetworkId, targetNetworkId uint64, asset, transactionId, metricTarget string) (string, error) {
 	args := mps.Called(sourceNetworkId, targetNetworkId, asset, transactionId, metricTarget)
@@ -18,6 +18,7 @@ package service
 
 import (
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/pagination"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -32,12 +33,12 @@ func (m *MockReadOnlyService) FindNftTransfer(transferID string, tokenID string,
 	m.Called(transferID, tokenID, serialNum, sender, receiver, save)
 }
 
-func (m *MockReadOnlyService) FindTransfer(transferID string, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, fetch, save)
+func (m *MockReadOnlyService) FindTransfer(transferID string, pages func() *pagination.Pages[mirrorNodeTransaction.Transaction], save func(transactionID, scheduleID, status string) error) {
+	m.Called(transferID, pages, save)
 }
 
-func (m *MockReadOnlyService) FindAssetTransfer(transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, asset, transfers, fetch, save)
+func (m *MockReadOnlyService) FindAssetTransfer(transferID string, asset string, transfers []transfer.Hedera, pages func() *pagination.Pages[mirrorNodeTransaction.Transaction], save func(transactionID, scheduleID, status string) error) {
+	m.Called(transferID, asset, transfers, pages, save)
 }
 
 func (m *MockReadOnlyService) FindScheduledNftAllowanceApprove(t *payload.Transfer, sender hedera.AccountID, save func(transactionID string, scheduleID string, status string) error) {
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRelayerService struct {
+	mock.Mock
+}
+
+func (m *MockRelayerService) Relay(ctx context.Context, transferID string, targetChainId uint64, majorityTimestamp int64) {
+	m.Called(ctx, transferID, targetChainId, majorityTimestamp)
+}
+
+func (m *MockRelayerService) Resume(ctx context.Context) {
+	m.Called(ctx)
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	reprocessModel "github.com/limechain/hedera-eth-bridge-validator/app/model/reprocess"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReprocessService struct {
+	mock.Mock
+}
+
+func (m *MockReprocessService) Register(source string, reprocessor service.Reprocessor) {
+	m.Called(source, reprocessor)
+}
+
+func (m *MockReprocessService) Reprocess(ctx context.Context, request *reprocessModel.Request) (*reprocessModel.Report, error) {
+	args := m.Called(ctx, request)
+	if args.Get(1) == nil {
+		return args.Get(0).(*reprocessModel.Report), nil
+	}
+	return nil, args.Get(1).(error)
+}
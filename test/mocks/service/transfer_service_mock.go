@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
@@ -55,30 +56,42 @@ func (mts *MockTransferService) UpdateStatusFailed(txId string) error {
 	panic("implement me")
 }
 
-func (mts *MockTransferService) ProcessNativeTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessNativeNftTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessWrappedTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mts *MockTransferService) SubmitSignatureMessage(ctx context.Context, transferID string, signatureMessage []byte) error {
+	args := mts.Called(ctx, transferID, signatureMessage)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
+func (mts *MockTransferService) UseLeaderContext(ctx context.Context) {
+	mts.Called(ctx)
+}
+
 func (mts *MockTransferService) SanityCheckTransfer(tx transaction.Transaction) transfer.SanityCheckResult {
 	args := mts.Called(tx)
 
@@ -108,6 +121,15 @@ func (mts *MockTransferService) TransferData(txId string) (interface{}, error) {
 	return args.Get(0).(service.TransferData), args.Error(1)
 }
 
+func (mts *MockTransferService) ClaimData(txId string) (*service.ClaimData, error) {
+	args := mts.Called(txId)
+	if args.Get(1) == nil {
+		return args.Get(0).(*service.ClaimData), nil
+	}
+
+	return nil, args.Get(1).(error)
+}
+
 func (mts *MockTransferService) Paged(filter *transfer.PagedRequest) (*transfer.Paged, error) {
 	panic("implement me")
 }
@@ -42,6 +42,12 @@ var MMessageRepository *repository.MockMessageRepository
 var MFeeRepository *repository.MockFeeRepository
 var MScheduleRepository *repository.MockScheduleRepository
 var MStatusRepository *repository.MockStatusRepository
+var MQueueMessageRepository *repository.MockQueueMessageRepository
+var MDeadLetterRepository *repository.MockDeadLetterRepository
+var MLockRepository *repository.MockLockRepository
+var MEvmBlockRepository *repository.MockEvmBlockRepository
+var MPolicyDecisionRepository *repository.MockPolicyDecisionRepository
+var MPeerRepository *repository.MockPeerRepository
 var MHederaMirrorClient *client.MockHederaMirror
 var MHederaNodeClient *client.MockHederaNode
 var MEVMCoreClient *client.MockEVMCore
@@ -52,6 +58,7 @@ var MEVMClient *client.MockEVM
 var MEvmFungibleTokenClient *client.MockEvmFungibleToken
 var MEvmNftClient *client.MockEvmNonFungibleToken
 var MPricingClient *client.MockPricingClient
+var MHsmClient *client.MockHsmClient
 var MSignerService *service.MockSignerService
 var MDatabase *database.MockDatabase
 var MConnector *database.MockConnector
@@ -65,6 +72,14 @@ var MHandler *handlers.MockHandler
 var MHttpHandler *http.MockHandler
 var MUtilsService *service.MockUtilsService
 var MBridgeConfigService *service.MockBridgeConfigService
+var MDeadLettersService *service.MockDeadLettersService
+var MReprocessService *service.MockReprocessService
+var MLeaderService *service.MockLeaderService
+var MHealthService *service.MockHealthService
+var MPolicyService *service.MockPolicyService
+var MSigningJournalService *service.MockSigningJournalService
+var MRelayerService *service.MockRelayerService
+var MPeersService *service.MockPeersService
 
 func Setup() {
 	MDatabase = &database.MockDatabase{}
@@ -82,6 +97,12 @@ func Setup() {
 	MMessageRepository = &repository.MockMessageRepository{}
 	MScheduleRepository = &repository.MockScheduleRepository{}
 	MStatusRepository = &repository.MockStatusRepository{}
+	MQueueMessageRepository = &repository.MockQueueMessageRepository{}
+	MDeadLetterRepository = &repository.MockDeadLetterRepository{}
+	MLockRepository = &repository.MockLockRepository{}
+	MEvmBlockRepository = &repository.MockEvmBlockRepository{}
+	MPolicyDecisionRepository = &repository.MockPolicyDecisionRepository{}
+	MPeerRepository = &repository.MockPeerRepository{}
 	MDistributorService = &service.MockDistrubutorService{}
 	MReadOnlyService = &service.MockReadOnlyService{}
 	MMessageService = &service.MockMessageService{}
@@ -98,10 +119,19 @@ func Setup() {
 	MAssetsService = &service.MockAssetsService{}
 	MPricingService = &service.MockPricingService{}
 	MPricingClient = &client.MockPricingClient{}
+	MHsmClient = &client.MockHsmClient{}
 	MResponseWriter = &http.MockResponseWriter{}
 	MWatcher = &watchers.MockWatcher{}
 	MHandler = &handlers.MockHandler{}
 	MHttpHandler = &http.MockHandler{}
 	MUtilsService = &service.MockUtilsService{}
 	MBridgeConfigService = &service.MockBridgeConfigService{}
+	MDeadLettersService = &service.MockDeadLettersService{}
+	MReprocessService = &service.MockReprocessService{}
+	MLeaderService = &service.MockLeaderService{}
+	MHealthService = &service.MockHealthService{}
+	MPolicyService = &service.MockPolicyService{}
+	MSigningJournalService = &service.MockSigningJournalService{}
+	MRelayerService = &service.MockRelayerService{}
+	MPeersService = &service.MockPeersService{}
 }